@@ -456,7 +456,7 @@ func (*s3bp) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, ecode int, _ error) {
 // HEAD OBJECT
 //
 
-func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *cmn.ObjAttrs, ecode int, err error) {
+func (*s3bp) HeadObj(ctx context.Context, lom *core.LOM, oreq *http.Request) (oa *cmn.ObjAttrs, ecode int, err error) {
 	const tag = "[head_object]"
 	var (
 		svc        *s3.Client
@@ -483,7 +483,7 @@ func (*s3bp) HeadObj(_ context.Context, lom *core.LOM, oreq *http.Request) (oa *
 	if err != nil {
 		return nil, 0, err
 	}
-	headOutput, err = svc.HeadObject(context.Background(), &s3.HeadObjectInput{
+	headOutput, err = svc.HeadObject(ctx, &s3.HeadObjectInput{
 		Bucket: aws.String(cloudBck.Name),
 		Key:    aws.String(lom.ObjName),
 	})
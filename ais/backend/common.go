@@ -19,7 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/stats"
 )
 
-const numBackendMetricks = 12
+const numBackendMetricks = 14
 
 type base struct {
 	metrics  cos.StrKVs // this backend's metric names (below)
@@ -54,6 +54,7 @@ func (b *base) init(snode *meta.Snode, tr stats.Tracker, startingUp bool) {
 	b.metrics[stats.GetCount] = prefix + "." + stats.GetCount
 	b.metrics[stats.GetLatencyTotal] = prefix + "." + stats.GetLatencyTotal
 	b.metrics[stats.GetSize] = prefix + "." + stats.GetSize
+	b.metrics[stats.GetLatencyHist] = prefix + "." + stats.GetLatencyHist
 
 	if regExt {
 		tr.RegExtMetric(snode,
@@ -86,6 +87,15 @@ func (b *base) init(snode *meta.Snode, tr stats.Tracker, startingUp bool) {
 				VarLabs: stats.BckXlabs,
 			},
 		)
+		tr.RegExtMetric(snode,
+			b.metrics[stats.GetLatencyHist],
+			stats.KindHistogram,
+			&stats.Extra{
+				Help:    "GET: distribution of the time (seconds) to execute remote requests and store, copy, or transform objects",
+				StrName: "remote_get_latency_seconds",
+				Labels:  labels,
+			},
+		)
 	}
 
 	// PUT
@@ -93,6 +103,7 @@ func (b *base) init(snode *meta.Snode, tr stats.Tracker, startingUp bool) {
 	b.metrics[stats.PutLatencyTotal] = prefix + "." + stats.PutLatencyTotal
 	b.metrics[stats.PutE2ELatencyTotal] = prefix + "." + stats.PutE2ELatencyTotal
 	b.metrics[stats.PutSize] = prefix + "." + stats.PutSize
+	b.metrics[stats.PutLatencyHist] = prefix + "." + stats.PutLatencyHist
 
 	if regExt {
 		tr.RegExtMetric(snode,
@@ -136,6 +147,15 @@ func (b *base) init(snode *meta.Snode, tr stats.Tracker, startingUp bool) {
 				VarLabs: stats.BckXlabs,
 			},
 		)
+		tr.RegExtMetric(snode,
+			b.metrics[stats.PutLatencyHist],
+			stats.KindHistogram,
+			&stats.Extra{
+				Help:    "PUT: distribution of the time (seconds) to execute remote requests",
+				StrName: "remote_put_latency_seconds",
+				Labels:  labels,
+			},
+		)
 	}
 
 	// HEAD
@@ -41,6 +41,10 @@ type dpq struct {
 	fltPresence string // QparamFltPresence
 	binfo       string // bucket info, with or without requirement to summarize remote obj-s
 	objto       string // uname of the destination object
+	user        string // authenticated user (QparamUser), if signed by the proxy (see verifiedUser)
+	usig        string // QparamUserSig
+	readAhead   string // QparamReadAhead
+	cksumType   string // QparamCksumType
 
 	skipVC        bool // QparamSkipVC (skip loading existing object's metadata)
 	isGFN         bool // QparamIsGFNRequest
@@ -52,6 +56,7 @@ type dpq struct {
 
 var _except = map[string]bool{
 	apc.QparamPID:            false,
+	apc.QparamTraceparent:    false, // (see tracing.NewTraceableHandler)
 	apc.QparamDontHeadRemote: false,
 
 	// flows that utilize the following query parameters perform conventional r.URL.Query()
@@ -152,6 +157,13 @@ func (dpq *dpq) parse(rawQuery string) error {
 			}
 		case apc.QparamOWT:
 			dpq.owt = value
+		case apc.QparamUser:
+			var err error
+			if dpq.user, err = url.QueryUnescape(value); err != nil {
+				return err
+			}
+		case apc.QparamUserSig:
+			dpq.usig = value
 
 		case apc.QparamFltPresence:
 			dpq.fltPresence = value
@@ -168,6 +180,10 @@ func (dpq *dpq) parse(rawQuery string) error {
 			dpq.silent = cos.IsParseBool(value)
 		case apc.QparamLatestVer:
 			dpq.latestVer = cos.IsParseBool(value)
+		case apc.QparamReadAhead:
+			dpq.readAhead = value
+		case apc.QparamCksumType:
+			dpq.cksumType = value
 
 		default: // the key must be known or `_except`-ed
 			if strings.HasPrefix(key, s3.HeaderPrefix) {
@@ -183,7 +199,9 @@ func (dpq *dpq) parse(rawQuery string) error {
 	if iters >= maxNumQparams {
 		return errors.New("exceeded max number of dpq iterations: " + strconv.Itoa(iters))
 	}
-
+	if dpq.user != "" {
+		dpq.user = verifiedUser(dpq.user, dpq.usig, dpq.ptime)
+	}
 	return nil
 }
 
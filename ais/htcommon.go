@@ -6,8 +6,11 @@ package ais
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -25,6 +28,7 @@ import (
 
 	"github.com/NVIDIA/aistore/3rdparty/golang/mux"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/certloader"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -929,9 +933,10 @@ func _reMirror(bprops, nprops *cmn.Bprops) bool {
 		return true
 	}
 	if bprops.Mirror.Enabled && nprops.Mirror.Enabled {
-		return bprops.Mirror.Copies != nprops.Mirror.Copies
+		return bprops.Mirror.Copies != nprops.Mirror.Copies || bprops.Mirror.Placement != nprops.Mirror.Placement
 	}
-	return false
+	// remove (across targets) replicas
+	return bprops.Mirror.Enabled && bprops.Mirror.AcrossTargets() && !nprops.Mirror.Enabled
 }
 
 func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt int, yes bool) {
@@ -952,3 +957,42 @@ func _reEC(bprops, nprops *cmn.Bprops, bck *meta.Bck, smap *smapX) (targetCnt in
 	}
 	return
 }
+
+//
+// per-user traffic (stats.BckTraffic): proxies sign the user (apc.QparamUser) along
+// with the redirect time (apc.QparamUnixTime), if any, using the cluster's auth secret;
+// targets disregard the user unless the signature checks out
+//
+
+func authSecret(config *cmn.Config) string {
+	return cos.Right(config.Auth.Secret, os.Getenv(env.AisAuthSecretKey)) // environment override
+}
+
+func userSig(secret, user, ptime string) string {
+	mac := hmac.New(sha256.New, cos.UnsafeB(secret))
+	mac.Write(cos.UnsafeB(user))
+	mac.Write([]byte{'\n'})
+	mac.Write(cos.UnsafeB(ptime))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func setQueryUser(q url.Values, secret, user, ptime string) {
+	q.Set(apc.QparamUser, user)
+	q.Set(apc.QparamUserSig, userSig(secret, user, ptime))
+}
+
+// returns empty string when not signed (or signed by someone else)
+func verifiedUser(user, sig, ptime string) string {
+	if user == "" || sig == "" || !cmn.Rom.AuthEnabled() {
+		return ""
+	}
+	secret := authSecret(cmn.GCO.Get())
+	if secret == "" || !hmac.Equal(cos.UnsafeB(sig), cos.UnsafeB(userSig(secret, user, ptime))) {
+		return ""
+	}
+	return user
+}
+
+func queryUser(q url.Values) string {
+	return verifiedUser(q.Get(apc.QparamUser), q.Get(apc.QparamUserSig), q.Get(apc.QparamUnixTime))
+}
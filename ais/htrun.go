@@ -1863,8 +1863,8 @@ func (h *htrun) extractRevokedTokenList(payload msPayload, caller string) (*toke
 		err = fmt.Errorf(cmn.FmtErrUnmarshal, h, "blocked token list", cos.BHead(bytes), err)
 		return nil, err
 	}
-	nlog.Infof("extract token list from %q (count: %d, action: %q, uuid: %q)", caller,
-		len(tokenList.Tokens), msg.Action, msg.UUID)
+	nlog.Infof("extract token list from %q (count: %d, IDs: %d, action: %q, uuid: %q)", caller,
+		len(tokenList.Tokens), len(tokenList.IDs), msg.Action, msg.UUID)
 	return tokenList, nil
 }
 
@@ -20,6 +20,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/tracing"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	"github.com/NVIDIA/aistore/xact/xs"
@@ -136,6 +137,10 @@ func (p *proxy) httpmlget(w http.ResponseWriter, r *http.Request) {
 			Body:   body,
 		}
 	)
+	if tracing.IsEnabled() {
+		hreq.Header = make(http.Header, 1)
+		tracing.Inject(r.Context(), hreq.Header) // phases 1 and 2
+	}
 	cargs := allocCargs()
 	{
 		cargs.si = tsi
@@ -143,9 +148,16 @@ func (p *proxy) httpmlget(w http.ResponseWriter, r *http.Request) {
 	}
 	res := p.call(cargs, smap)
 	xid = res.header.Get(apc.HdrXactionID)
+	err = res.err
+	if err != nil && res.status == http.StatusServiceUnavailable {
+		// DT is overloaded (see target's admission control)
+		if ra := res.header.Get(cos.HdrRetryAfter); ra != "" {
+			w.Header().Set(cos.HdrRetryAfter, ra)
+		}
+	}
 	freeCargs(cargs)
 	freeCR(res)
-	if err := res.err; err != nil {
+	if err != nil {
 		p.writeErr(w, r, err)
 		return
 	}
@@ -233,6 +245,10 @@ func (t *target) mlHandler(w http.ResponseWriter, r *http.Request) {
 		if designated {
 			// phase 1.
 			debug.Assert(xid == "noxid", xid) // placeholder
+			if err := t.admitCtrl(w, r, prioBatch); err != nil {
+				t.writeErr(w, r, err, http.StatusServiceUnavailable, Silent)
+				return
+			}
 			xid = cos.GenUUID()
 
 			rns := xreg.RenewGetBatch(ctx.bck, xid, true /*designated*/)
@@ -271,7 +287,9 @@ func (t *target) mlHandler(w http.ResponseWriter, r *http.Request) {
 		if designated {
 			err = xmoss.PrepRx(ctx.req, &smap.Smap, ctx.wid, nat > 1 /*receiving*/)
 		} else {
-			err = xmoss.Send(ctx.req, &smap.Smap, tsi, ctx.wid)
+			sctx, span := tracing.StartSpan(r.Context(), "x-moss send", "xid", xmoss.ID(), "wid", ctx.wid)
+			err = xmoss.Send(sctx, ctx.req, &smap.Smap, tsi, ctx.wid)
+			span.End(err)
 			if err != nil {
 				xmoss.BcastAbort(err)
 			}
@@ -300,7 +318,10 @@ func (t *target) mlHandler(w http.ResponseWriter, r *http.Request) {
 		xmoss, ok := xctn.(*xs.XactMoss)
 		debug.Assert(ok, xctn.Name())
 
-		if err := xmoss.Assemble(ctx.req, w, ctx.wid); err != nil {
+		_, span := tracing.StartSpan(r.Context(), "x-moss assemble", "xid", xmoss.ID(), "wid", ctx.wid)
+		err = xmoss.Assemble(ctx.req, w, ctx.wid)
+		span.End(err)
+		if err != nil {
 			xmoss.BcastAbort(err)
 			xmoss.Abort(err)
 			if err == cmn.ErrGetTxBenign {
@@ -58,7 +58,7 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 
 	// do page
 	beg := mono.NanoTime()
-	lst, err := p.lsPage(bck, amsg, lsmsg, r.Header, p.owner.smap.get())
+	lst, err := p.lsPage(bck, amsg, lsmsg, r.Header, p.owner.smap.get(), p.reqUser(r))
 	if err != nil {
 		p.statsT.IncBck(stats.ErrListCount, bck.Bucket())
 		p.writeErr(w, r, err)
@@ -102,7 +102,8 @@ func _checkVerChanged(bck *meta.Bck, lsmsg *apc.LsoMsg) error {
 }
 
 // one page; common code (native, s3 api)
-func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header, smap *smapX) (*cmn.LsoRes, error) {
+// (user: authenticated user, if any - to account for per-user traffic)
+func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header, smap *smapX, user string) (*cmn.LsoRes, error) {
 	var (
 		nl             nl.Listener
 		err            error
@@ -151,14 +152,14 @@ func (p *proxy) lsPage(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr h
 		}
 
 		config := cmn.GCO.Get()
-		lst, err = p.lsObjsR(bck, lsmsg, hdr, smap, tsi, config, wantOnlyRemote)
+		lst, err = p.lsObjsR(bck, lsmsg, hdr, smap, tsi, config, wantOnlyRemote, user)
 
 		// TODO: `status == http.StatusGone`: at this point we know that this
 		// remote bucket exists and is offline. We should somehow try to list
 		// cached objects. This isn't easy as we basically need to start a new
 		// xaction and return a new `UUID`.
 	} else {
-		lst, err = p.lsObjsA(bck, lsmsg)
+		lst, err = p.lsObjsA(bck, lsmsg, user)
 	}
 
 	return lst, err
@@ -230,7 +231,7 @@ func (p *proxy) _lsofc(bck *meta.Bck, lsmsg *apc.LsoMsg, smap *smapX) (_ *meta.S
 // lsObjsA reads object list from all targets, combines, sorts and returns
 // the final list. Excess of object entries from each target is remembered in the
 // buffer (see: `queryBuffers`) so we won't request the same objects again.
-func (p *proxy) lsObjsA(bck *meta.Bck, lsmsg *apc.LsoMsg) (allEntries *cmn.LsoRes, err error) {
+func (p *proxy) lsObjsA(bck *meta.Bck, lsmsg *apc.LsoMsg, user string) (allEntries *cmn.LsoRes, err error) {
 	var (
 		actMsgExt *actMsgExt
 		args      *bcastArgs
@@ -249,6 +250,9 @@ func (p *proxy) lsObjsA(bck *meta.Bck, lsmsg *apc.LsoMsg) (allEntries *cmn.LsoRe
 		Query:  bck.NewQuery(),
 		Body:   cos.MustMarshal(actMsgExt),
 	}
+	if user != "" {
+		setQueryUser(args.req.Query, p.authn.secret, user, "")
+	}
 	args.timeout = apc.LongTimeout
 	args.smap = smap
 	args.cresv = cresmGeneric[cmn.LsoRes]{}
@@ -279,7 +283,7 @@ func (p *proxy) lsObjsA(bck *meta.Bck, lsmsg *apc.LsoMsg) (allEntries *cmn.LsoRe
 }
 
 func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header, smap *smapX, tsi *meta.Snode, config *cmn.Config,
-	wantOnlyRemote bool) (*cmn.LsoRes, error) {
+	wantOnlyRemote bool, user string) (*cmn.LsoRes, error) {
 	var (
 		results   sliceResults
 		actMsgExt = p.newAmsgActVal(apc.ActList, &lsmsg)
@@ -313,6 +317,9 @@ func (p *proxy) lsObjsR(bck *meta.Bck, lsmsg *apc.LsoMsg, hdr http.Header, smap
 		Query:  bck.NewQuery(),
 		Body:   cos.MustMarshal(actMsgExt),
 	}
+	if user != "" {
+		setQueryUser(args.req.Query, p.authn.secret, user, "")
+	}
 	if wantOnlyRemote {
 		cargs := allocCargs()
 		{
@@ -527,7 +534,7 @@ func (c *lstcx) do() (string, error) {
 
 	// 2. ls 1st page
 	var lst *cmn.LsoRes
-	lst, err = c.p.lsObjsR(c.bckFrom, &c.lsmsg, c.hdr, c.smap, tsi /*designated target*/, c.config, true)
+	lst, err = c.p.lsObjsR(c.bckFrom, &c.lsmsg, c.hdr, c.smap, tsi /*designated target*/, c.config, true, "")
 	if err != nil {
 		return "", err
 	}
@@ -597,7 +604,7 @@ func (c *lstcx) pages(s string, cnt int) {
 
 // next page
 func (c *lstcx) _page() (int, error) {
-	lst, err := c.p.lsObjsR(c.bckFrom, &c.lsmsg, c.hdr, c.smap, c.tsi, c.config, true)
+	lst, err := c.p.lsObjsR(c.bckFrom, &c.lsmsg, c.hdr, c.smap, c.tsi, c.config, true, "")
 	if err != nil {
 		return 0, err
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/audit"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/feat"
@@ -38,6 +39,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/dsort"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/tracing"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 
@@ -245,6 +247,13 @@ func (p *proxy) Run() error {
 		// S3 compatibility, depending on feature flag
 		{r: "/", h: p.rootHandler, net: accessNetPublic},
 	}
+	// audit trail of client requests (when enabled, see prxaudit.go)
+	audit.Init(p.SID(), config.LogDir, func() *cmn.AuditConf { return &cmn.GCO.Get().Audit })
+	for i := range networkHandlers {
+		if nh := &networkHandlers[i]; nh.net.isSet(accessNetPublic) && nh.r != apc.Health {
+			nh.h = p.audited(nh.h)
+		}
+	}
 	p.regNetHandlers(networkHandlers)
 
 	nlog.Infoln(cmn.NetPublic+":", "\t\t", p.si.PubNet.URL)
@@ -664,9 +673,12 @@ func (p *proxy) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		if qbck.IsRemoteAIS() {
 			qbck.Ns.UUID = p.a2u(qbck.Ns.UUID)
 		}
-		if err := p.checkAccess(w, r, nil, apc.AceListBuckets); err == nil {
-			p.listBuckets(w, r, qbck, msg, dpq)
+		flt, err := p.lsbAccess(r)
+		if err != nil {
+			p.writeErr(w, r, err, aceErrToCode(err))
+			return
 		}
+		p.listBuckets(w, r, qbck, msg, dpq, flt)
 		return
 	}
 
@@ -761,8 +773,16 @@ func (p *proxy) httpobjget(w http.ResponseWriter, r *http.Request, origURLBck ..
 		return
 	}
 
-	// 4. redirect
-	tsi, netPub, err := smap.HrwMultiHome(bck.MakeUname(objName))
+	// 4. redirect (when mirroring across targets, spread reads across replicas)
+	var (
+		tsi    *meta.Snode
+		netPub string
+	)
+	if mirror := &bck.Props.Mirror; mirror.Enabled && mirror.AcrossTargets() {
+		tsi, netPub, err = smap.HrwMultiHomeN(bck.MakeUname(objName), int(mirror.Copies), uint64(started.UnixNano()))
+	} else {
+		tsi, netPub, err = smap.HrwMultiHome(bck.MakeUname(objName))
+	}
 	if err != nil {
 		p.statsT.IncBck(stats.ErrGetCount, bck.Bucket())
 		p.writeErr(w, r, err)
@@ -1406,7 +1426,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			if p.forwardCP(w, r, msg, bucket) { // to create
 				return
 			}
-			if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+			if err := p.checkCreateAccess(w, r, bckTo); err != nil {
 				return
 			}
 			nlog.Infof(warnDstNotExist, p, bckTo, bckFrom)
@@ -1484,7 +1504,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 				if p.forwardCP(w, r, msg, bucket) { // to create
 					return
 				}
-				if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+				if err := p.checkCreateAccess(w, r, bckTo); err != nil {
 					return
 				}
 				nlog.Infof(warnDstNotExist, p, bckTo, bck)
@@ -1497,7 +1517,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			return
 		}
 	case apc.ActAddRemoteBck:
-		if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+		if err := p.checkCreateAccess(w, r, bck); err != nil {
 			return
 		}
 		if err := p.createBucket(msg, bck, nil); err != nil {
@@ -1570,7 +1590,7 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 		remoteHdr http.Header
 		bucket    = bck.Name
 	)
-	if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+	if err := p.checkCreateAccess(w, r, bck); err != nil {
 		return
 	}
 	if err := bck.Validate(); err != nil {
@@ -1978,6 +1998,23 @@ func (p *proxy) httpbckpatch(w http.ResponseWriter, r *http.Request, apireq *api
 			return
 		}
 	}
+	if nprops.Repl.Enabled {
+		// replication destination must exist (and will be added to the BMD if need be)
+		dst, err := nprops.Repl.DstBck()
+		if err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		if bck.Equal(meta.CloneBck(dst), false, true) {
+			p.writeErrf(w, r, "cannot replicate bucket %q onto itself", bck.Cname(""))
+			return
+		}
+		args := bctx{p: p, w: w, r: r, bck: meta.CloneBck(dst), msg: msg, dpq: apireq.dpq, query: apireq.query}
+		args.createAIS = false
+		if _, err = args.initAndTry(); err != nil {
+			return
+		}
+	}
 	if xid, err = p.setBprops(msg, bck, nprops); err != nil {
 		p.writeErr(w, r, err)
 		return
@@ -2054,13 +2091,15 @@ func (p *proxy) httpobjpatch(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 }
 
-func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq) {
+// (flt: tenant's view - see p.lsbAccess)
+func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.QueryBcks, msg *apc.ActMsg, dpq *dpq,
+	flt func(*cmn.Bck) bool) {
 	var (
 		bmd     = p.owner.bmd.get()
 		present bool
 	)
 	if qbck.IsAIS() || qbck.IsHT() {
-		bcks := bmd.Select(qbck)
+		bcks := fltBcks(bmd.Select(qbck), flt)
 		p.writeJSON(w, r, bcks, "list-buckets")
 		return
 	}
@@ -2072,7 +2111,7 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 		}
 	}
 	if present {
-		bcks := bmd.Select(qbck)
+		bcks := fltBcks(bmd.Select(qbck), flt)
 		p.writeJSON(w, r, bcks, "list-buckets")
 		return
 	}
@@ -2105,6 +2144,15 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 		p.writeErr(w, r, err, res.status, Silent) // always silent
 		return
 	}
+	if flt != nil {
+		var bcks cmn.Bcks
+		if err := jsoniter.Unmarshal(res.bytes, &bcks); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
+		p.writeJSON(w, r, fltBcks(bcks, flt), "list-buckets")
+		return
+	}
 
 	hdr := w.Header()
 	hdr.Set(cos.HdrContentType, res.header.Get(cos.HdrContentType))
@@ -2114,6 +2162,19 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 	}
 }
 
+func fltBcks(bcks cmn.Bcks, flt func(*cmn.Bck) bool) cmn.Bcks {
+	if flt == nil {
+		return bcks
+	}
+	out := bcks[:0]
+	for i := range bcks {
+		if flt(&bcks[i]) {
+			out = append(out, bcks[i])
+		}
+	}
+	return out
+}
+
 func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netIntra string, netPubs ...string) string {
 	var (
 		nodeURL string
@@ -2139,14 +2200,28 @@ func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netIn
 			nodeURL = si.URL(netPub)
 		}
 	}
-	// fast path
-	if !cmn.HasSpecialSymbols(r.URL.Path) {
+	user := p.reqUser(r) // (per-user traffic)
+	var traceparent string
+	if tracing.IsEnabled() {
+		traceparent = tracing.Traceparent(r.Context()) // (see tracing.NewTraceableHandler)
+	}
+
+	ptime := cos.UnixNano2S(ts.UnixNano())
+
+	// fast path (unless the client attempts to pass signed user - see setQueryUser)
+	if !cmn.HasSpecialSymbols(r.URL.Path) && !strings.Contains(r.URL.RawQuery, apc.QparamUserSig+"=") {
 		var (
 			q = url.Values{
 				apc.QparamPID:      []string{p.SID()},
-				apc.QparamUnixTime: []string{cos.UnixNano2S(ts.UnixNano())},
+				apc.QparamUnixTime: []string{ptime},
 			}
 		)
+		if user != "" {
+			setQueryUser(q, p.authn.secret, user, ptime)
+		}
+		if traceparent != "" {
+			q.Set(apc.QparamTraceparent, traceparent)
+		}
 		debug.Assertf(!strings.Contains(r.URL.Path, "%"), "path %q contains %%", r.URL.Path)
 		if r.URL.RawQuery != "" {
 			return nodeURL + r.URL.Path + "?" + r.URL.RawQuery + "&" + q.Encode()
@@ -2169,7 +2244,15 @@ func (p *proxy) redirectURL(r *http.Request, si *meta.Snode, ts time.Time, netIn
 	}
 	q := r.URL.Query()
 	q.Set(apc.QparamPID, p.SID())
-	q.Set(apc.QparamUnixTime, cos.UnixNano2S(ts.UnixNano()))
+	q.Set(apc.QparamUnixTime, ptime)
+	q.Del(apc.QparamUser)
+	q.Del(apc.QparamUserSig)
+	if user != "" {
+		setQueryUser(q, p.authn.secret, user, ptime)
+	}
+	if traceparent != "" {
+		q.Set(apc.QparamTraceparent, traceparent)
+	}
 	u := url.URL{
 		Scheme:   scheme,
 		Host:     host,
@@ -2684,7 +2767,7 @@ func (p *proxy) dsortHandler(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 			if ecode == http.StatusNotFound {
-				if err := p.checkAccess(w, r, nil, apc.AceCreateBucket); err != nil {
+				if err := p.checkCreateAccess(w, r, bckTo); err != nil {
 					return
 				}
 				naction := "dsort-create-output-bck"
@@ -0,0 +1,170 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/audit"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// audit trail of client requests (see cmn/audit and cmn.AuditConf)
+// - records are made upon (and in addition to) handling a request
+// - GET and HEAD object requests are redirected to targets and, therefore,
+//   recorded latency is the time to redirect
+// - intra-cluster requests (validated via checkIntraCall) are not recorded
+// - the principal is the one validated by the handler (see validateToken) - or, when
+//   validation fails, the unverified user the token claims (audit.Record.Claimed)
+// - records dropped when the queue is full are counted (stats.AuditDroppedCount)
+//   and periodically logged
+
+const maxAuditPeek = 64 * cos.KiB // max size of the request body to look for action message
+
+type (
+	// request's principal, as per token validation while handling the request
+	reqPrincipal struct {
+		user      string // validated
+		claimed   string // unverified (invalid token)
+		validated bool   // validateToken called
+	}
+	ctxPrincipal struct{}
+)
+
+func withPrincipal(r *http.Request) (*http.Request, *reqPrincipal) {
+	rp := &reqPrincipal{}
+	return r.WithContext(context.WithValue(r.Context(), ctxPrincipal{}, rp)), rp
+}
+
+func principal(r *http.Request) *reqPrincipal {
+	rp, _ := r.Context().Value(ctxPrincipal{}).(*reqPrincipal)
+	return rp
+}
+
+func (p *proxy) audited(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conf := &cmn.GCO.Get().Audit
+		if !conf.Enabled || (r.Header.Get(apc.HdrCallerID) != "" && p.checkIntraCall(r.Header, false /*from primary*/) == nil) {
+			h(w, r)
+			return
+		}
+		var (
+			aw      = &audit.Writer{ResponseWriter: w}
+			rec     = &audit.Record{Time: time.Now(), Node: p.SID(), Remote: r.RemoteAddr, Method: r.Method, Path: r.URL.Path}
+			started = mono.NanoTime()
+		)
+		// (before the handler that may rewrite URL path)
+		isObj := p.auditBckObj(rec, r)
+		rec.Action = auditAction(r, isObj)
+
+		r, rp := withPrincipal(r)
+		h(aw, r)
+
+		rec.Latency = mono.Since(started)
+		rec.Status = aw.StatusCode()
+		if isObj && (r.Method == http.MethodGet || r.Method == http.MethodHead) && rec.Status < http.StatusBadRequest {
+			if !audit.Sample(conf, rec.Bucket) {
+				return
+			}
+		}
+		if cmn.Rom.AuthEnabled() {
+			if !rp.validated {
+				_, _ = p.validateToken(r) // (not validated by the handler)
+			}
+			rec.User, rec.Claimed = rp.user, rp.claimed
+		}
+		if !audit.Log(rec) {
+			p.statsT.Inc(stats.AuditDroppedCount)
+		}
+	}
+}
+
+// fills in bucket and object name, if any; returns true for object requests
+// supported URL paths:
+// - native API:      /v1/buckets/bucket-name, /v1/objects/bucket-name/object-name
+// - S3 API:          /s3/bucket-name/object-name (or /bucket-name/object-name, see feat.S3APIviaRoot)
+// - "easy URL":      /gs/bucket-name/object-name, etc.
+func (p *proxy) auditBckObj(rec *audit.Record, r *http.Request) bool {
+	var (
+		bck     cmn.Bck
+		objName string
+		s3      bool
+		path    = strings.TrimPrefix(r.URL.Path, "/")
+	)
+	first, rest, _ := strings.Cut(path, "/")
+	switch first {
+	case apc.Version:
+		var res string
+		res, rest, _ = strings.Cut(rest, "/")
+		if res != apc.Buckets && res != apc.Objects {
+			return false
+		}
+		q := r.URL.Query()
+		bck.Provider, bck.Ns = q.Get(apc.QparamProvider), cmn.ParseNsUname(q.Get(apc.QparamNamespace))
+		bck.Name, objName, _ = strings.Cut(rest, "/")
+		if res == apc.Buckets {
+			objName = ""
+		}
+	case apc.S3:
+		bck.Name, objName, _ = strings.Cut(rest, "/")
+		s3 = true
+	case apc.GSScheme, apc.AZScheme, apc.AISScheme:
+		bck.Provider = first
+		bck.Name, objName, _ = strings.Cut(rest, "/")
+	default:
+		if !cmn.Rom.Features().IsSet(feat.S3APIviaRoot) {
+			return false
+		}
+		bck.Name, objName, _ = strings.Cut(path, "/")
+		s3 = true
+	}
+	if bck.Name == "" {
+		return false
+	}
+	if s3 {
+		// S3 API addresses buckets by name only
+		if b, _, err := meta.InitByNameOnly(bck.Name, p.owner.bmd); err == nil {
+			bck = *b.Bucket()
+		}
+	}
+	bck.Provider = cos.Right(apc.AIS, apc.NormalizeProvider(bck.Provider))
+	rec.Bucket, rec.Object = bck.Cname(""), objName
+	return objName != ""
+}
+
+// action message, if any: peek into (small) request body and restore it
+func auditAction(r *http.Request, isObj bool) string {
+	if r.ContentLength <= 0 || r.ContentLength > maxAuditPeek {
+		return ""
+	}
+	if !strings.HasPrefix(r.URL.Path, "/"+apc.Version+"/") || (isObj && r.Method == http.MethodPut) {
+		return ""
+	}
+	b, err := cos.ReadAllN(r.Body, r.ContentLength)
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return ""
+	}
+	var msg struct {
+		Action string `json:"action"`
+	}
+	if jsoniter.Unmarshal(b, &msg) != nil {
+		return ""
+	}
+	return msg.Action
+}
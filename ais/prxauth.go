@@ -8,17 +8,16 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"os"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
-	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
-	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/memsys"
@@ -33,9 +32,13 @@ type (
 		// list of invalid tokens(revoked or of deleted users)
 		// Authn sends these tokens to primary for broadcasting
 		revokedTokens map[string]bool
-		version       int64
+		// revoked token IDs (see tok.Token.ID) => token expiration time (Unix seconds)
+		revokedIDs map[string]int64
+		version    int64
 		// signing key secret
 		secret string
+		// OIDC public keys (see cmn.OIDCConf)
+		oidc oidcKeys
 		// lock
 		sync.Mutex
 	}
@@ -49,13 +52,22 @@ func newAuthManager(config *cmn.Config) *authManager {
 	return &authManager{
 		tkList:        make(tkList),
 		revokedTokens: make(map[string]bool), // TODO: preallocate
+		revokedIDs:    make(map[string]int64),
 		version:       1,
-		secret:        cos.Right(config.Auth.Secret, os.Getenv(env.AisAuthSecretKey)), // environment override
+		secret:        authSecret(config),
 	}
 }
 
 // Add tokens to the list of invalid ones and clean up the list from expired tokens.
 func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *tokenList) {
+	// (decrypting outside the lock)
+	withIDs := make(map[string]*tok.Token, len(newRevoked.Tokens))
+	for _, token := range newRevoked.Tokens {
+		if tk, err := a.decrypt(token, ""); err == nil && tk.ID != "" {
+			withIDs[token] = tk
+		}
+	}
+
 	a.Lock()
 	defer a.Unlock()
 
@@ -70,9 +82,25 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 	}
 
 	// Add new revoked tokens and remove them from the valid token list.
+	// (tokens that have IDs are kept as (compact) IDs)
+	now := time.Now()
 	for _, token := range newRevoked.Tokens {
-		a.revokedTokens[token] = true
 		delete(a.tkList, token)
+		if tk, ok := withIDs[token]; ok {
+			a.revokedIDs[tk.ID] = tk.Expires.Unix()
+			continue
+		}
+		a.revokedTokens[token] = true
+	}
+	for id, exp := range newRevoked.IDs {
+		a.revokedIDs[id] = exp
+	}
+	if len(newRevoked.IDs) > 0 {
+		for token, tk := range a.tkList {
+			if _, ok := a.revokedIDs[tk.ID]; ok && tk.ID != "" {
+				delete(a.tkList, token)
+			}
+		}
 	}
 
 	allRevoked = &tokenList{
@@ -80,19 +108,28 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 		Version: a.version,
 	}
 
-	// Clean up expired tokens from the revoked list.
-	now := time.Now()
-
+	// Clean up expired tokens and IDs from the revoked lists.
 	for token := range a.revokedTokens {
-		tk, err := tok.DecryptToken(token, a.secret)
-		debug.AssertNoErr(err)
-		if tk.Expires.Before(now) {
+		tk, err := a.decryptCached(token)
+		if err != nil || tk.Expires.Before(now) {
 			delete(a.revokedTokens, token)
 		} else {
 			allRevoked.Tokens = append(allRevoked.Tokens, token)
 		}
 	}
-	if len(allRevoked.Tokens) == 0 {
+	nowUnix := now.Unix()
+	for id, exp := range a.revokedIDs {
+		if exp < nowUnix {
+			delete(a.revokedIDs, id)
+		}
+	}
+	if len(a.revokedIDs) > 0 {
+		allRevoked.IDs = make(map[string]int64, len(a.revokedIDs))
+		for id, exp := range a.revokedIDs {
+			allRevoked.IDs[id] = exp
+		}
+	}
+	if len(allRevoked.Tokens) == 0 && len(allRevoked.IDs) == 0 {
 		allRevoked = nil
 	}
 	return allRevoked
@@ -101,7 +138,7 @@ func (a *authManager) updateRevokedList(newRevoked *tokenList) (allRevoked *toke
 func (a *authManager) revokedTokenList() (allRevoked *tokenList) {
 	a.Lock()
 	l := len(a.revokedTokens)
-	if l == 0 {
+	if l == 0 && len(a.revokedIDs) == 0 {
 		a.Unlock()
 		return
 	}
@@ -109,6 +146,12 @@ func (a *authManager) revokedTokenList() (allRevoked *tokenList) {
 	for token := range a.revokedTokens {
 		allRevoked.Tokens = append(allRevoked.Tokens, token)
 	}
+	if len(a.revokedIDs) > 0 {
+		allRevoked.IDs = make(map[string]int64, len(a.revokedIDs))
+		for id, exp := range a.revokedIDs {
+			allRevoked.IDs[id] = exp
+		}
+	}
 	a.Unlock()
 	return
 }
@@ -119,36 +162,102 @@ func (a *authManager) revokedTokenList() (allRevoked *tokenList) {
 //   - must have all mandatory fields: userID, creds, issued, expires
 //
 // Returns decrypted token information if it is valid
-func (a *authManager) validateToken(token string) (tk *tok.Token, err error) {
-	a.Lock()
-	if _, ok := a.revokedTokens[token]; ok {
-		tk, err = nil, fmt.Errorf("%v: %s", tok.ErrTokenRevoked, tk)
-	} else {
-		tk, err = a.validateAddRm(token, time.Now())
+func (a *authManager) validateToken(token, clusterID string) (tk *tok.Token, err error) {
+	tk, err = a.validateAddRm(token, clusterID, time.Now())
+	if err == nil {
+		switch {
+		case tk.AccessKey:
+			tk, err = nil, tok.ErrAccessKey
+		case tk.Refresh:
+			tk, err = nil, tok.ErrRefreshToken
+		}
 	}
-	a.Unlock()
 	return
 }
 
+// Validates S3 request signed with AuthN-issued access key (see tok.S3KeyJWT):
+// the access key ID is itself a token (hence, revocation and expiration apply) and the
+// corresponding secret key is derived from the shared secret (see tok.S3Secret).
+// Requests signed with other (e.g., AWS) access keys are treated as having no token.
+func (a *authManager) validateSigV4(r *http.Request, clusterID string) (*tok.Token, error) {
+	sig, err := s3.ParseSigV4(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", tok.ErrInvalidToken, err)
+	}
+	if strings.Count(sig.AccessKey, ".") != 2 {
+		return nil, tok.ErrNoToken
+	}
+	now := time.Now()
+	tk, err := a.validateAddRm(sig.AccessKey, clusterID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !tk.AccessKey {
+		return nil, fmt.Errorf("%w: not an S3 access key", tok.ErrInvalidToken)
+	}
+	if err := sig.Verify(r, tok.S3Secret(sig.AccessKey, a.secret), now); err != nil {
+		return nil, fmt.Errorf("%w: %v", tok.ErrInvalidToken, err)
+	}
+	return tk, nil
+}
+
 // Decrypts and validates token. Adds it to authManager.token if not found. Removes if expired.
-// Must be called under lock.
-func (a *authManager) validateAddRm(token string, now time.Time) (*tok.Token, error) {
+// Takes the lock only to check and update the lists: decrypting may need to fetch
+// OIDC keys (see oidcKeys.get) and is done outside the lock.
+func (a *authManager) validateAddRm(token, clusterID string, now time.Time) (*tok.Token, error) {
+	a.Lock()
+	if _, ok := a.revokedTokens[token]; ok {
+		a.Unlock()
+		return nil, tok.ErrTokenRevoked
+	}
 	tk, ok := a.tkList[token]
+	a.Unlock()
 	if !ok || tk == nil {
 		var err error
-		if tk, err = tok.DecryptToken(token, a.secret); err != nil {
+		if tk, err = a.decrypt(token, clusterID); err != nil {
 			nlog.Errorln(err)
 			return nil, tok.ErrInvalidToken
 		}
-		a.tkList[token] = tk
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	if _, ok := a.revokedTokens[token]; ok { // (revoked in the meantime)
+		return nil, tok.ErrTokenRevoked
 	}
 	if tk.Expires.Before(now) {
 		delete(a.tkList, token)
 		return nil, fmt.Errorf("%v: %s", tok.ErrTokenExpired, tk)
 	}
+	if tk.ID != "" {
+		if _, ok := a.revokedIDs[tk.ID]; ok {
+			delete(a.tkList, token)
+			return nil, fmt.Errorf("%v: %s", tok.ErrTokenRevoked, tk)
+		}
+	}
+	a.tkList[token] = tk
 	return tk, nil
 }
 
+// AuthN-issued (HMAC) tokens are always accepted; when OIDC is configured
+// tokens signed by the external identity provider are accepted as well
+func (a *authManager) decrypt(token, clusterID string) (*tok.Token, error) {
+	conf := &cmn.GCO.Get().Auth.OIDC
+	if !conf.IsEnabled() || tok.IsHMAC(token) {
+		return tok.DecryptToken(token, a.secret)
+	}
+	return a.oidc.decrypt(token, conf, clusterID)
+}
+
+// same as above using only the keys at hand (no fetching under lock)
+func (a *authManager) decryptCached(token string) (*tok.Token, error) {
+	conf := &cmn.GCO.Get().Auth.OIDC
+	if !conf.IsEnabled() || tok.IsHMAC(token) {
+		return tok.DecryptToken(token, a.secret)
+	}
+	return a.oidc.decryptCached(token, conf)
+}
+
 ///////////////
 // tokenList //
 ///////////////
@@ -214,20 +323,58 @@ func (p *proxy) delToken(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Validates a token from the request header
-func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
-	token, err := tok.ExtractToken(hdr)
+// Validates a token from the request header or, for S3 clients,
+// the request's SigV4 signature made with AuthN-issued access key
+// (records the principal of the audited request - see withPrincipal)
+func (p *proxy) validateToken(r *http.Request) (*tok.Token, error) {
+	clusterID := p.owner.smap.get().UUID
+	rp := principal(r)
+	token, err := tok.ExtractToken(r.Header)
 	if err != nil {
-		return nil, err
+		var tk *tok.Token
+		if (err == tok.ErrNoToken || err == tok.ErrNoBearerToken) && s3.IsSigV4(r) {
+			tk, err = p.authn.validateSigV4(r, clusterID)
+		}
+		rp.set(tk, "")
+		return tk, err
 	}
-	tk, err := p.authn.validateToken(token)
+	tk, err := p.authn.validateToken(token, clusterID)
 	if err != nil {
 		nlog.Errorf("invalid token: %v", err)
+		rp.set(nil, tok.ClaimedUser(token, &cmn.GCO.Get().Auth.OIDC))
 		return nil, err
 	}
+	rp.set(tk, "")
 	return tk, nil
 }
 
+func (rp *reqPrincipal) set(tk *tok.Token, claimed string) {
+	if rp == nil {
+		return
+	}
+	rp.validated = true
+	rp.user, rp.claimed = "", claimed
+	if tk != nil {
+		rp.user = tk.UserID
+	}
+}
+
+// authenticated user (principal), if any
+// (used for per-user traffic accounting; validated tokens are cached)
+func (p *proxy) reqUser(r *http.Request) string {
+	if !cmn.Rom.AuthEnabled() {
+		return ""
+	}
+	if rp := principal(r); rp != nil && rp.validated {
+		return rp.user
+	}
+	tk, err := p.validateToken(r)
+	if err != nil {
+		return ""
+	}
+	return tk.UserID
+}
+
 // When AuthN is on, accessing a bucket requires two permissions:
 //   - access to the bucket is granted to a user
 //   - bucket ACL allows the required operation
@@ -239,12 +386,49 @@ func (p *proxy) validateToken(hdr http.Header) (*tok.Token, error) {
 //	- read-only access to a bucket is always granted
 //	- PATCH cannot be forbidden
 func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
-	if err = p.access(r.Header, bck, ace); err != nil {
+	if err = p.access(r, bck, ace); err != nil {
 		p.writeErr(w, r, err, aceErrToCode(err))
 	}
 	return
 }
 
+// create bucket: cluster-wide or namespace-scoped (tenant) permission
+// (compare with p.access - the bucket does not exist yet, no bucket props to check)
+func (p *proxy) checkCreateAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bck) (err error) {
+	if err = p.accessCreate(r, bck); err != nil {
+		p.writeErr(w, r, err, aceErrToCode(err))
+	}
+	return
+}
+
+func (p *proxy) accessCreate(r *http.Request, bck *meta.Bck) error {
+	if !cmn.Rom.AuthEnabled() || p.checkIntraCall(r.Header, false /*from primary*/) == nil {
+		return nil
+	}
+	tk, err := p.validateToken(r)
+	if err != nil {
+		return err
+	}
+	return tk.CheckPermissions(p.owner.smap.Get().UUID, bck.Bucket(), apc.AceCreateBucket)
+}
+
+// list buckets: tenants (users with namespace-scoped permissions) list only their namespaces
+// and explicitly granted buckets (see tok.Token.CanList); returns nil filter when there's nothing to filter
+func (p *proxy) lsbAccess(r *http.Request) (func(*cmn.Bck) bool, error) {
+	if !cmn.Rom.AuthEnabled() || p.checkIntraCall(r.Header, false /*from primary*/) == nil {
+		return nil, nil
+	}
+	tk, err := p.validateToken(r)
+	if err != nil {
+		return nil, err
+	}
+	uid := p.owner.smap.Get().UUID
+	if !tk.IsTenant() {
+		return nil, tk.CheckPermissions(uid, nil, apc.AceListBuckets)
+	}
+	return func(bck *cmn.Bck) bool { return tk.CanList(uid, bck) }, nil
+}
+
 func aceErrToCode(err error) (status int) {
 	switch {
 	case err == nil:
@@ -256,16 +440,16 @@ func aceErrToCode(err error) (status int) {
 	return status
 }
 
-func (p *proxy) access(hdr http.Header, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
+func (p *proxy) access(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (err error) {
 	var (
 		tk     *tok.Token
 		bucket *cmn.Bck
 	)
-	if p.checkIntraCall(hdr, false /*from primary*/) == nil {
+	if p.checkIntraCall(r.Header, false /*from primary*/) == nil {
 		return nil
 	}
 	if cmn.Rom.AuthEnabled() { // config.Auth.Enabled
-		tk, err = p.validateToken(hdr)
+		tk, err = p.validateToken(r)
 		if err != nil {
 			// NOTE: making exception to allow 3rd party clients read remote ht://bucket
 			if err == tok.ErrNoToken && bck != nil && bck.IsHT() {
@@ -0,0 +1,177 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestRevokedTokenIDs(t *testing.T) {
+	const secret = "test-secret"
+	var (
+		a   = newAuthManager(&cmn.Config{})
+		now = time.Now()
+	)
+	a.secret = secret
+
+	token, err := tok.JWT(now.Add(time.Hour), "user", "token-id", nil, nil, nil, secret)
+	tassert.CheckFatal(t, err)
+	legacy, err := tok.JWT(now.Add(time.Hour), "user", "", nil, nil, nil, secret)
+	tassert.CheckFatal(t, err)
+	refresh, err := tok.RefreshJWT(now.Add(time.Hour), "user", "refresh-id", secret)
+	tassert.CheckFatal(t, err)
+
+	_, err = a.validateToken(token, "")
+	tassert.CheckFatal(t, err) // (and cached)
+	_, err = a.validateToken(refresh, "")
+	tassert.Errorf(t, errors.Is(err, tok.ErrRefreshToken), "expected %v, got %v", tok.ErrRefreshToken, err)
+
+	// revoke by ID (and drop expired IDs)
+	all := a.updateRevokedList(&tokenList{IDs: map[string]int64{"token-id": now.Add(time.Hour).Unix(), "expired-id": now.Add(-time.Hour).Unix()}})
+	tassert.Fatalf(t, all != nil && len(all.IDs) == 1 && len(all.Tokens) == 0, "expected one revoked ID, got %+v", all)
+	_, err = a.validateToken(token, "")
+	tassert.Errorf(t, err != nil, "revoked (by ID) token must be invalid")
+
+	// tokens that have IDs are kept as IDs
+	token2, err := tok.JWT(now.Add(time.Hour), "user", "token-id2", nil, nil, nil, secret)
+	tassert.CheckFatal(t, err)
+	all = a.updateRevokedList(&tokenList{Tokens: []string{token2, legacy}})
+	tassert.Fatalf(t, all != nil && len(all.IDs) == 2 && len(all.Tokens) == 1, "expected 2 IDs and 1 token, got %+v", all)
+	_, err = a.validateToken(token2, "")
+	tassert.Errorf(t, err != nil, "revoked token must be invalid")
+	_, err = a.validateToken(legacy, "")
+	tassert.Errorf(t, err != nil, "revoked token must be invalid")
+}
+
+func TestQueryUser(t *testing.T) {
+	const secret = "test-secret"
+	config := cmn.GCO.BeginUpdate()
+	config.Auth.Enabled, config.Auth.Secret = true, secret
+	cmn.GCO.CommitUpdate(config)
+	cmn.Rom.Set(&config.ClusterConfig)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Auth.Enabled, config.Auth.Secret = false, ""
+		cmn.GCO.CommitUpdate(config)
+		cmn.Rom.Set(&config.ClusterConfig)
+	}()
+
+	q := url.Values{apc.QparamUnixTime: []string{"12345"}}
+	setQueryUser(q, secret, "alice", "12345")
+	tassert.Errorf(t, queryUser(q) == "alice", "expected signed user, got %q", queryUser(q))
+
+	// spoofed user, replayed signature, different time, wrong secret
+	q2 := url.Values{apc.QparamUnixTime: []string{"12345"}, apc.QparamUser: []string{"bob"}, apc.QparamUserSig: q[apc.QparamUserSig]}
+	tassert.Errorf(t, queryUser(q2) == "", "expected no user, got %q", queryUser(q2))
+	q.Set(apc.QparamUnixTime, "12346")
+	tassert.Errorf(t, queryUser(q) == "", "expected no user, got %q", queryUser(q))
+	q = url.Values{}
+	setQueryUser(q, "another-secret", "alice", "")
+	tassert.Errorf(t, queryUser(q) == "", "expected no user, got %q", queryUser(q))
+	q = url.Values{apc.QparamUser: []string{"alice"}}
+	tassert.Errorf(t, queryUser(q) == "", "expected no user (unsigned), got %q", queryUser(q))
+}
+
+// periodic JWKS refresh must not stall requests: cached keys are served while refreshing
+func TestOIDCKeysRefresh(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	tassert.CheckFatal(t, err)
+	var (
+		b64     = func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+		jwks    = cos.MustMarshal(map[string]any{"keys": []map[string]string{{"kty": "RSA", "kid": "rsa-1", "n": b64(key.N), "e": b64(big.NewInt(int64(key.E)))}}})
+		release = make(chan struct{})
+		fetches atomic.Int32
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fetches.Inc() > 1 {
+			<-release // slow identity provider
+		}
+		w.Write(jwks)
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	var (
+		o    oidcKeys
+		conf = &cmn.OIDCConf{JWKSURL: srv.URL, Refresh: cos.Duration(time.Minute)}
+	)
+	ks, err := o.get(conf, false)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, ks.Len() == 1, "expected 1 key, got %d", ks.Len())
+
+	// due for refresh
+	o.mu.Lock()
+	o.loaded = mono.NanoTime() - int64(time.Hour)
+	o.mu.Unlock()
+	started := mono.NanoTime()
+	for range 3 {
+		ks2, err := o.get(conf, false)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, ks2 == ks, "expected cached keys")
+	}
+	tassert.Errorf(t, mono.Since(started) < time.Second, "waited for refresh: %v", mono.Since(started))
+	tassert.Errorf(t, o.loading.Load(), "expected background refresh in progress")
+}
+
+// principal of the audited request: validated by the handler, or claimed by an invalid token
+func TestReqPrincipal(t *testing.T) {
+	const secret = "test-secret"
+	config := cmn.GCO.BeginUpdate()
+	config.Auth.Enabled, config.Auth.Secret = true, secret
+	cmn.GCO.CommitUpdate(config)
+	cmn.Rom.Set(&config.ClusterConfig)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Auth.Enabled, config.Auth.Secret = false, ""
+		cmn.GCO.CommitUpdate(config)
+		cmn.Rom.Set(&config.ClusterConfig)
+	}()
+	p := &proxy{}
+	p.owner.smap = newSmapOwner(cmn.GCO.Get())
+	p.owner.smap.put(newSmap())
+	p.authn = newAuthManager(cmn.GCO.Get())
+	p.authn.secret = secret
+
+	newReq := func(token string) (*http.Request, *reqPrincipal) {
+		r := httptest.NewRequest(http.MethodGet, "/v1/buckets/abc", http.NoBody)
+		r.Header.Set(apc.HdrAuthorization, apc.AuthenticationTypeBearer+" "+token)
+		return withPrincipal(r)
+	}
+
+	valid, err := tok.JWT(time.Now().Add(time.Hour), "alice", "", nil, nil, nil, secret)
+	tassert.CheckFatal(t, err)
+	r, rp := newReq(valid)
+	tassert.Errorf(t, p.reqUser(r) == "alice", "expected alice, got %q", p.reqUser(r))
+	tassert.Errorf(t, rp.validated && rp.user == "alice" && rp.claimed == "", "unexpected %+v", rp)
+
+	// (validated once - revoking in the meantime does not change the principal)
+	p.authn.updateRevokedList(&tokenList{Tokens: []string{valid}})
+	tassert.Errorf(t, p.reqUser(r) == "alice", "expected alice, got %q", p.reqUser(r))
+
+	forged, err := tok.JWT(time.Now().Add(time.Hour), "mallory", "", nil, nil, nil, "another-secret")
+	tassert.CheckFatal(t, err)
+	r, rp = newReq(forged)
+	_, err = p.validateToken(r)
+	tassert.Errorf(t, err != nil, "expected invalid token")
+	tassert.Errorf(t, rp.validated && rp.user == "" && rp.claimed == "mallory", "unexpected %+v", rp)
+	tassert.Errorf(t, p.reqUser(r) == "", "expected no user, got %q", p.reqUser(r))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 
@@ -82,6 +83,10 @@ func (p *proxy) httpcluget(w http.ResponseWriter, r *http.Request) {
 		p.qcluSysinfo(w, r, what, query)
 	case apc.WhatMountpaths:
 		p.qcluMountpaths(w, r, what, query)
+	case apc.WhatRebPlan:
+		p.qcluRebPlan(w, r, what, query)
+	case apc.WhatBckTraffic:
+		p.qcluBckTraffic(w, r, what, query)
 	case apc.WhatBackends:
 		config := cmn.GCO.Get()
 		out := make([]string, 0, len(config.Backend.Providers))
@@ -308,6 +313,81 @@ func (p *proxy) qcluMountpaths(w http.ResponseWriter, r *http.Request, what stri
 	p.writeJSON(w, r, out, what)
 }
 
+// rebalance dry-run: validate the (hypothetical) membership change and
+// have each target count its objects that would migrate
+func (p *proxy) qcluRebPlan(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	var msg apc.RebPlanMsg
+	if err := cmn.ReadJSON(w, r, &msg); err != nil {
+		return
+	}
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		p.writeErrf(w, r, "%s: expecting targets to add and/or remove", what)
+		return
+	}
+	var (
+		config = cmn.GCO.Get()
+		smap   = p.owner.smap.get()
+	)
+	nsmap, err := reb.NewPlanSmap(&smap.Smap, &msg)
+	if err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query, Body: cos.MustMarshal(&msg)}
+	args.smap = smap
+	args.to = core.Targets
+	args.timeout = config.Client.TimeoutLong.D() // (walking all mountpaths)
+	args.cresv = cresjGeneric[apc.RebPlan]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	plan := &apc.RebPlan{SmapVer: smap.Version, NumTargets: nsmap.CountActiveTs()}
+	plan.Init()
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+		plan.Merge(res.v.(*apc.RebPlan))
+	}
+	freeBcastRes(results)
+
+	if bw := int64(config.Rebalance.Bandwidth); bw > 0 {
+		var maxBytes int64
+		for _, cnt := range plan.Sources {
+			maxBytes = max(maxBytes, cnt.Bytes)
+		}
+		plan.EstTime = time.Duration(float64(maxBytes) / float64(bw) * float64(time.Second))
+	}
+	p.writeJSON(w, r, plan, what)
+}
+
+// per-bucket (and per-user) traffic: sum over all targets
+func (p *proxy) qcluBckTraffic(w http.ResponseWriter, r *http.Request, what string, query url.Values) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodGet, Path: apc.URLPathDae.S, Query: query}
+	args.smap = p.owner.smap.get()
+	args.to = core.Targets
+	args.cresv = cresjGeneric[stats.BckTrafficList]{}
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+
+	out := stats.BckTrafficList{}
+	for _, res := range results {
+		if res.err != nil {
+			p.writeErr(w, r, res.toErr())
+			freeBcastRes(results)
+			return
+		}
+		out.Merge(*res.v.(*stats.BckTrafficList))
+	}
+	freeBcastRes(results)
+	p.writeJSON(w, r, out, what)
+}
+
 // helper methods for querying targets
 
 func (p *proxy) _queryTs(w http.ResponseWriter, r *http.Request, query url.Values) (cos.JSONRawMsgs, bool) {
@@ -0,0 +1,153 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// OIDC public keys: loaded from a local PEM file or fetched from JWKS URL
+// (and then periodically refreshed); see also cmn.OIDCConf
+
+const (
+	oidcRefetchMin = 30 * time.Second // min interval between (unknown key ID triggered) JWKS refetches
+	oidcTimeout    = 30 * time.Second
+	oidcMaxJWKS    = 1024 * 1024
+)
+
+type oidcKeys struct {
+	ks      *tok.KeySet
+	client  *http.Client
+	src     string      // the source (JWKS URL or PEM file) `ks` was loaded from
+	loaded  int64       // mono
+	mu      sync.Mutex  // protects the above
+	lmu     sync.Mutex  // serializes loading (network I/O is done without holding `mu`)
+	loading atomic.Bool // background refresh in progress
+}
+
+func (o *oidcKeys) decrypt(token string, conf *cmn.OIDCConf, clusterID string) (*tok.Token, error) {
+	ks, err := o.get(conf, false /*unknown kid*/)
+	if err != nil {
+		return nil, err
+	}
+	tk, err := tok.DecryptOIDC(token, ks, conf, clusterID)
+	if err == tok.ErrUnknownKey && conf.JWKSURL != "" {
+		// (keys rotated?)
+		if ks, err = o.get(conf, true); err == nil {
+			tk, err = tok.DecryptOIDC(token, ks, conf, clusterID)
+		}
+	}
+	return tk, err
+}
+
+// cached keys only
+func (o *oidcKeys) decryptCached(token string, conf *cmn.OIDCConf) (*tok.Token, error) {
+	ks, _ := o.cached(cos.Left(conf.JWKSURL, conf.PubKeyFile))
+	if ks == nil {
+		return nil, errors.New("OIDC: no keys")
+	}
+	return tok.DecryptOIDC(token, ks, conf, "")
+}
+
+func (o *oidcKeys) cached(src string) (*tok.KeySet, time.Duration) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.ks == nil || o.src != src {
+		return nil, 0
+	}
+	return o.ks, time.Duration(mono.NanoTime() - o.loaded)
+}
+
+// periodic refresh runs in the background while cached keys continue to be served;
+// requests wait only when there are no keys yet, or for a token signed with an unknown key
+func (o *oidcKeys) get(conf *cmn.OIDCConf, unknownKid bool) (*tok.KeySet, error) {
+	src := cos.Left(conf.JWKSURL, conf.PubKeyFile)
+	if ks, elapsed := o.cached(src); ks != nil {
+		switch {
+		case unknownKid:
+			if elapsed < oidcRefetchMin {
+				return ks, nil
+			}
+		case conf.JWKSURL == "" || elapsed < conf.Refresh.D():
+			return ks, nil
+		default:
+			if o.loading.CAS(false, true) {
+				go func() {
+					_, _ = o.reload(conf, src)
+					o.loading.Store(false)
+				}()
+			}
+			return ks, nil
+		}
+	}
+	return o.reload(conf, src)
+}
+
+func (o *oidcKeys) reload(conf *cmn.OIDCConf, src string) (*tok.KeySet, error) {
+	o.lmu.Lock()
+	defer o.lmu.Unlock()
+	if ks, elapsed := o.cached(src); ks != nil && elapsed < oidcRefetchMin {
+		return ks, nil // (loaded in the meantime)
+	}
+
+	ks, err := o.load(conf)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := mono.NanoTime()
+	if err != nil {
+		if o.ks != nil && o.src == src {
+			nlog.Errorln("OIDC: failed to refresh keys (using cached):", err)
+			o.loaded = now // (not to retry on every request)
+			return o.ks, nil
+		}
+		return nil, err
+	}
+	nlog.Infoln("OIDC: loaded", ks.Len(), "key(s) from", src)
+	o.ks, o.src, o.loaded = ks, src, now
+	return ks, nil
+}
+
+func (o *oidcKeys) load(conf *cmn.OIDCConf) (*tok.KeySet, error) {
+	if conf.PubKeyFile != "" {
+		b, err := os.ReadFile(conf.PubKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return tok.ParsePEM(b)
+	}
+	if o.client == nil {
+		o.client = cmn.NewClient(cmn.TransportArgs{Timeout: oidcTimeout, UseHTTPProxyEnv: true})
+	}
+	resp, err := o.client.Get(conf.JWKSURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: status %d", conf.JWKSURL, resp.StatusCode)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxJWKS))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("GET " + conf.JWKSURL + ": empty response")
+	}
+	return tok.ParseJWKS(b)
+}
@@ -46,6 +46,11 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	// reserved (see s3Redirect)
+	if r.URL.Query().Has(apc.QparamNamespace) {
+		s3.WriteErr(w, r, fmt.Errorf("%w: query parameter %q is not supported", errS3Req, apc.QparamNamespace), 0)
+		return
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -65,11 +70,12 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			// list all buckets; NOTE: compare with `p.easyURLHandler` and see
 			// "list buckets for a given provider" comment there
 			// perms: apc.AceListBuckets
-			if err := p.access(r.Header, nil, apc.AceListBuckets); err != nil {
+			flt, err := p.lsbAccess(r)
+			if err != nil {
 				s3.WriteErr(w, r, err, http.StatusForbidden)
 				return
 			}
-			p.bckNamesFromBMD(w)
+			p.bckNamesFromBMD(w, r, flt)
 			return
 		}
 
@@ -158,13 +164,17 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 
 // GET /s3
 // NOTE: unlike native API, this one is limited to list only those that are currently present in the BMD.
-func (p *proxy) bckNamesFromBMD(w http.ResponseWriter) {
+// Tenants (see p.s3Tenant) list only their own namespace.
+func (p *proxy) bckNamesFromBMD(w http.ResponseWriter, r *http.Request, flt func(*cmn.Bck) bool) {
 	var (
-		bmd  = p.owner.bmd.get()
-		resp = s3.NewListBucketResult() // https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListBuckets.html
+		bmd    = p.owner.bmd.get()
+		resp   = s3.NewListBucketResult() // https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListBuckets.html
+		tenant = p.s3Tenant(r)
 	)
-	bmd.Range(nil /*any provider*/, nil /*any namespace*/, func(bck *meta.Bck) bool {
-		resp.Add(bck)
+	bmd.Range(nil /*any provider*/, tenant, func(bck *meta.Bck) bool {
+		if flt == nil || flt(bck.Bucket()) {
+			resp.Add(bck)
+		}
 		return false
 	})
 	sgl := p.gmm.NewSGL(0)
@@ -176,7 +186,12 @@ func (p *proxy) bckNamesFromBMD(w http.ResponseWriter) {
 
 // PUT /s3/<bucket-name> (i.e., create bucket)
 func (p *proxy) putBckS3(w http.ResponseWriter, r *http.Request, bucket string) {
-	if err := p.access(r.Header, nil, apc.AceCreateBucket); err != nil {
+	ns := cmn.NsGlobal
+	if tenant := p.s3Tenant(r); tenant != nil {
+		ns = *tenant
+	}
+	bck := meta.NewBck(bucket, apc.AIS, ns)
+	if err := p.accessCreate(r, bck); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -184,7 +199,6 @@ func (p *proxy) putBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
 		return
 	}
-	bck := meta.NewBck(bucket, apc.AIS, cmn.NsGlobal)
 	if err := bck.Validate(); err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
@@ -200,7 +214,7 @@ func (p *proxy) delBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceDestroyBucket); err != nil {
+	if err := p.access(r, bck, apc.AceDestroyBucket); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -228,7 +242,7 @@ func (p *proxy) handleMptUpload(w http.ResponseWriter, r *http.Request, parts []
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AcePUT); err != nil {
+	if err := p.access(r, bck, apc.AcePUT); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -256,7 +270,7 @@ func (p *proxy) delMultipleObjs(w http.ResponseWriter, r *http.Request, bucket s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjDELETE); err != nil {
+	if err := p.access(r, bck, apc.AceObjDELETE); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -320,7 +334,7 @@ func (p *proxy) headBckS3(w http.ResponseWriter, r *http.Request, bucket string)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+	if err := p.access(r, bck, apc.AceBckHEAD); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -344,7 +358,7 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjLIST); err != nil {
+	if err := p.access(r, bck, apc.AceObjLIST); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -375,7 +389,7 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	// - "encoding-type"
 	s3.FillLsoMsg(q, lsmsg)
 
-	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg, r.Header)
+	lst, err := p.lsAllPagesS3(bck, amsg, lsmsg, r.Header, p.reqUser(r))
 	if cmn.Rom.FastV(5, cos.SmoduleS3) {
 		nlog.Infoln("lsoS3", bck.Cname(""), len(lst.Entries), err)
 	}
@@ -407,11 +421,11 @@ func (p *proxy) listObjectsS3(w http.ResponseWriter, r *http.Request, bucket str
 	lst.Entries = nil
 }
 
-func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header) (lst *cmn.LsoRes, _ error) {
+func (p *proxy) lsAllPagesS3(bck *meta.Bck, amsg *apc.ActMsg, lsmsg *apc.LsoMsg, hdr http.Header, user string) (lst *cmn.LsoRes, _ error) {
 	smap := p.owner.smap.get()
 	for pageNum := 1; ; pageNum++ {
 		beg := mono.NanoTime()
-		page, err := p.lsPage(bck, amsg, lsmsg, hdr, smap)
+		page, err := p.lsPage(bck, amsg, lsmsg, hdr, smap, user)
 		if err != nil {
 			return lst, err
 		}
@@ -464,7 +478,7 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bckSrc == nil {
 		return
 	}
-	if err := p.access(r.Header, bckSrc, apc.AceGET); err != nil {
+	if err := p.access(r, bckSrc, apc.AceGET); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -500,7 +514,7 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AcePUT); err != nil {
+	if err := p.access(r, bck, apc.AcePUT); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -535,7 +549,7 @@ func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string,
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceGET); err != nil {
+	if err := p.access(r, bck, apc.AceGET); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -625,7 +639,7 @@ func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjHEAD); err != nil {
+	if err := p.access(r, bck, apc.AceObjHEAD); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -657,7 +671,7 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	if bck == nil {
 		return
 	}
-	if err := p.access(r.Header, bck, apc.AceObjDELETE); err != nil {
+	if err := p.access(r, bck, apc.AceObjDELETE); err != nil {
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
@@ -697,7 +711,7 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 
 // GET /s3/<bucket-name>?lifecycle|cors|policy|acl
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
-	if _, ecode, err := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
+	if _, ecode, err := meta.InitByNameNs(bucket, p.s3Tenant(r), p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
 	}
@@ -740,7 +754,7 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 //
 
 func (p *proxy) initByNameOnly(w http.ResponseWriter, r *http.Request, bucket string) *meta.Bck {
-	bck, ecode, err := meta.InitByNameOnly(bucket, p.owner.bmd)
+	bck, ecode, err := meta.InitByNameNs(bucket, p.s3Tenant(r), p.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return nil
@@ -749,9 +763,31 @@ func (p *proxy) initByNameOnly(w http.ResponseWriter, r *http.Request, bucket st
 	return bck
 }
 
+// S3 access key may carry tenant (namespace) to resolve S3 bucket names (see tok.Token.Tenant)
+func (p *proxy) s3Tenant(r *http.Request) *cmn.Ns {
+	if !cmn.Rom.AuthEnabled() {
+		return nil
+	}
+	tk, err := p.validateToken(r)
+	if err != nil || tk.Tenant == "" {
+		return nil
+	}
+	return &cmn.Ns{Name: tk.Tenant}
+}
+
 // either reverse-proxy call _or_ HTTP-redirect to a designated node
 // see also: docs/s3compat.md
 func (p *proxy) s3Redirect(w http.ResponseWriter, r *http.Request, si *meta.Snode, redirectURL, bucket string) {
+	// convey tenant's namespace to the target (see also: target's s3Ns)
+	if tenant := p.s3Tenant(r); tenant != nil {
+		qns := apc.QparamNamespace + "=" + url.QueryEscape(tenant.Uname())
+		redirectURL += "&" + qns
+		if r.URL.RawQuery == "" {
+			r.URL.RawQuery = qns
+		} else {
+			r.URL.RawQuery += "&" + qns
+		}
+	}
 	if cmn.Rom.Features().IsSet(feat.S3ReverseProxy) {
 		// [intra-cluster communications]
 		// instead of regular HTTP redirect (below) reverse-proxy S3 API call to a designated target
@@ -42,6 +42,16 @@ const (
 
 	HeaderCredentials = "X-Amz-Credential" //nolint:gosec // This is just a header name definition...
 
+	// checksums: request (GET, HEAD) and response headers
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/checking-object-integrity.html
+	HdrChecksumMode   = "X-Amz-Checksum-Mode"
+	HdrChecksumType   = "X-Amz-Checksum-Type"
+	HdrChecksumCRC32C = "X-Amz-Checksum-Crc32c"
+	HdrChecksumSHA256 = "X-Amz-Checksum-Sha256"
+
+	cksumModeEnabled = "ENABLED"
+	cksumFullObject  = "FULL_OBJECT"
+
 	versioningEnabled  = "Enabled"
 	versioningDisabled = "Suspended"
 
@@ -73,6 +73,8 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, ecode int) {
 		out.Code = "BucketAlreadyExists"
 	case cmn.IsErrBckNotFound(err):
 		out.Code = "NoSuchBucket"
+	case cmn.IsErrTooManyRequests(err) && ecode == http.StatusServiceUnavailable:
+		out.Code = "SlowDown"
 	case in.TypeCode != "":
 		out.Code = in.TypeCode
 	default:
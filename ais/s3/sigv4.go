@@ -0,0 +1,399 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// AWS Signature Version 4 verification - both authorization header and presigned (query string) variants
+// (with AIS acting as the service, given the access key's secret)
+// See:
+// - https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-auth-using-authorization-header.html
+// - https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+
+const (
+	QparamAlgorithm     = "X-Amz-Algorithm"
+	QparamCredential    = HeaderCredentials
+	QparamDate          = "X-Amz-Date"
+	QparamSignedHeaders = "X-Amz-SignedHeaders"
+	QparamSignature4    = "X-Amz-Signature"
+	QparamExpires4      = "X-Amz-Expires"
+	QparamContentSHA256 = "X-Amz-Content-Sha256"
+
+	HeaderDate = "X-Amz-Date"
+
+	unsignedPayload = cos.S3UnsignedPayload
+	emptySHA256     = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	amzDateFormat   = "20060102T150405Z"
+	scopeTerminator = "aws4_request"
+
+	sigMaxSkew    = 15 * time.Minute
+	sigMaxExpires = 7 * 24 * time.Hour
+)
+
+var ErrSignature = errors.New("the request signature does not match") // cf. "SignatureDoesNotMatch"
+
+type SigV4 struct {
+	AccessKey     string
+	Date          string // YYYYMMDD (credential scope)
+	Region        string
+	Service       string
+	AmzDate       string // ISO 8601 basic, e.g. "20250101T000000Z"
+	SignedHeaders []string
+	Signature     string
+	Expires       time.Duration // presigned only
+	Presigned     bool
+	payload       string // x-amz-content-sha256 (hex) or UNSIGNED-PAYLOAD
+}
+
+// verifies request body against the signed x-amz-content-sha256 as the body is being read
+type payloadReader struct {
+	r        io.ReadCloser
+	h        hash.Hash
+	expected string
+	size     int64 // content length, or -1 if unknown
+	off      int64
+	done     bool
+}
+
+// IsSigV4 returns true if the request is signed with AWS Signature Version 4
+func IsSigV4(r *http.Request) bool {
+	if strings.HasPrefix(r.Header.Get(apc.HdrAuthorization), signatureV4) {
+		return true
+	}
+	return r.URL.Query().Get(QparamAlgorithm) == signatureV4
+}
+
+// ParseSigV4 parses SigV4 authorization header or presigned query
+func ParseSigV4(r *http.Request) (*SigV4, error) {
+	if hdr := r.Header.Get(apc.HdrAuthorization); strings.HasPrefix(hdr, signatureV4) {
+		return parseSigV4Header(r, strings.TrimSpace(strings.TrimPrefix(hdr, signatureV4)))
+	}
+	return parseSigV4Query(r.URL.Query())
+}
+
+// e.g.: "Credential=AKID/20250101/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-date, Signature=abcd"
+func parseSigV4Header(r *http.Request, s string) (*SigV4, error) {
+	sig := &SigV4{}
+	for _, kv := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(kv), "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed SigV4 authorization header: %q", kv)
+		}
+		switch k {
+		case "Credential":
+			if err := sig.parseCredential(v); err != nil {
+				return nil, err
+			}
+		case "SignedHeaders":
+			sig.SignedHeaders = strings.Split(v, ";")
+		case "Signature":
+			sig.Signature = v
+		}
+	}
+	sig.AmzDate = r.Header.Get(HeaderDate)
+	if sig.AmzDate == "" {
+		// (the "Date" header, if present, must be signed - see canonical headers)
+		if t, err := http.ParseTime(r.Header.Get("Date")); err == nil {
+			sig.AmzDate = t.UTC().Format(amzDateFormat)
+		}
+	}
+	return sig, sig.validate()
+}
+
+func parseSigV4Query(q url.Values) (*SigV4, error) {
+	if alg := q.Get(QparamAlgorithm); alg != signatureV4 {
+		return nil, fmt.Errorf("unsupported signature algorithm %q", alg)
+	}
+	sig := &SigV4{Presigned: true, AmzDate: q.Get(QparamDate), Signature: q.Get(QparamSignature4)}
+	if err := sig.parseCredential(q.Get(QparamCredential)); err != nil {
+		return nil, err
+	}
+	if sh := q.Get(QparamSignedHeaders); sh != "" {
+		sig.SignedHeaders = strings.Split(sh, ";")
+	}
+	secs, err := strconv.ParseInt(q.Get(QparamExpires4), 10, 64)
+	if err != nil || secs <= 0 || time.Duration(secs)*time.Second > sigMaxExpires {
+		return nil, fmt.Errorf("invalid %s %q", QparamExpires4, q.Get(QparamExpires4))
+	}
+	sig.Expires = time.Duration(secs) * time.Second
+	return sig, sig.validate()
+}
+
+// <access-key>/<date>/<region>/<service>/aws4_request
+func (sig *SigV4) parseCredential(cred string) error {
+	parts := strings.Split(cred, "/")
+	if len(parts) != 5 || parts[4] != scopeTerminator || parts[0] == "" {
+		return fmt.Errorf("malformed SigV4 credential %q", cred)
+	}
+	sig.AccessKey, sig.Date, sig.Region, sig.Service = parts[0], parts[1], parts[2], parts[3]
+	return nil
+}
+
+func (sig *SigV4) validate() error {
+	switch {
+	case sig.AccessKey == "":
+		return errors.New("SigV4: missing credential")
+	case sig.Signature == "":
+		return errors.New("SigV4: missing signature")
+	case len(sig.SignedHeaders) == 0:
+		return errors.New("SigV4: missing signed headers")
+	case !slices.Contains(sig.SignedHeaders, "host"):
+		return errors.New("SigV4: 'host' header must be signed")
+	case sig.AmzDate == "":
+		return errors.New("SigV4: missing date")
+	case !strings.HasPrefix(sig.AmzDate, sig.Date):
+		return fmt.Errorf("SigV4: date %q does not match credential scope %q", sig.AmzDate, sig.Date)
+	}
+	return nil
+}
+
+func (sig *SigV4) scope() string {
+	return sig.Date + "/" + sig.Region + "/" + sig.Service + "/" + scopeTerminator
+}
+
+// Verify checks request timing and recomputes the signature given the secret key.
+// The signature covers the client-provided x-amz-content-sha256 that, in turn, must match
+// the payload: empty body is checked right away; otherwise, Verify wraps r.Body to hash
+// it as it's being read (and fail the read upon mismatch). The same header is verified
+// by the target when the request is redirected (see NewPayloadReader).
+func (sig *SigV4) Verify(r *http.Request, secretKey string, now time.Time) error {
+	signed, err := time.Parse(amzDateFormat, sig.AmzDate)
+	if err != nil {
+		return fmt.Errorf("SigV4: invalid date %q", sig.AmzDate)
+	}
+	if sig.Presigned {
+		if now.After(signed.Add(sig.Expires)) {
+			return errors.New("SigV4: request has expired")
+		}
+		if signed.Sub(now) > sigMaxSkew {
+			return errors.New("SigV4: request is not yet valid")
+		}
+	} else if d := now.Sub(signed); d > sigMaxSkew || d < -sigMaxSkew {
+		return fmt.Errorf("SigV4: request time %s is too skewed", sig.AmzDate)
+	}
+
+	creq, err := sig.canonicalRequest(r)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256(cos.UnsafeB(creq))
+	sts := signatureV4 + "\n" + sig.AmzDate + "\n" + sig.scope() + "\n" + hex.EncodeToString(digest[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), sig.Date)
+	key = hmacSHA256(key, sig.Region)
+	key = hmacSHA256(key, sig.Service)
+	key = hmacSHA256(key, scopeTerminator)
+	expected := hex.EncodeToString(hmacSHA256(key, sts))
+
+	if !hmac.Equal([]byte(expected), []byte(sig.Signature)) {
+		return ErrSignature
+	}
+
+	if sig.payload == unsignedPayload {
+		return nil
+	}
+	if r.ContentLength == 0 || r.Body == nil || r.Body == http.NoBody {
+		if sig.payload != emptySHA256 {
+			return fmt.Errorf("SigV4: %s mismatch (empty payload)", cos.S3HdrContentSHA256)
+		}
+		return nil
+	}
+	r.Body = NewPayloadReader(r.Body, sig.payload, r.ContentLength)
+	return nil
+}
+
+// the request as originally sent by the client (and signed)
+// - path: from RequestURI (r.URL.Path may have been rewritten, e.g., to prepend "/s3")
+// - host: from r.Host (for virtual-hosted style requests, includes the bucket name)
+func (sig *SigV4) canonicalRequest(r *http.Request) (string, error) {
+	uri := r.RequestURI
+	if uri == "" {
+		uri = r.URL.RequestURI()
+	}
+	u, err := url.ParseRequestURI(uri)
+	if err != nil {
+		return "", err
+	}
+	q, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.Grow(256)
+	sb.WriteString(r.Method)
+	sb.WriteByte('\n')
+	sb.WriteString(uriEncode(cos.Left(u.Path, "/"), false))
+	sb.WriteByte('\n')
+	sb.WriteString(sig.canonicalQuery(q))
+	sb.WriteByte('\n')
+	for _, name := range sig.SignedHeaders {
+		sb.WriteString(name)
+		sb.WriteByte(':')
+		sb.WriteString(canonicalHeader(r, name))
+		sb.WriteByte('\n')
+	}
+	sb.WriteByte('\n')
+	sb.WriteString(strings.Join(sig.SignedHeaders, ";"))
+	sb.WriteByte('\n')
+
+	payload, err := sig.payloadHash(r, q)
+	if err != nil {
+		return "", err
+	}
+	sig.payload = payload
+	sb.WriteString(payload)
+	return sb.String(), nil
+}
+
+// UNSIGNED-PAYLOAD is accepted only when explicitly stated - with a single exception:
+// presigned URLs with no X-Amz-Content-Sha256 (the AWS default) and no body
+func (sig *SigV4) payloadHash(r *http.Request, q url.Values) (string, error) {
+	var payload string
+	if sig.Presigned {
+		payload = q.Get(QparamContentSHA256)
+		if payload == "" {
+			if r.ContentLength != 0 {
+				return "", fmt.Errorf("SigV4: presigned request with payload must specify %s", QparamContentSHA256)
+			}
+			return unsignedPayload, nil
+		}
+	} else if payload = r.Header.Get(cos.S3HdrContentSHA256); payload == "" {
+		return "", fmt.Errorf("SigV4: missing %s header", cos.S3HdrContentSHA256)
+	}
+	if payload != unsignedPayload && !IsPayloadHash(payload) {
+		return "", fmt.Errorf("SigV4: unsupported %s %q", cos.S3HdrContentSHA256, payload)
+	}
+	return payload, nil
+}
+
+// IsPayloadHash returns true if the x-amz-content-sha256 value is an actual (hex-encoded) hash
+// (rather than UNSIGNED-PAYLOAD or one of the streaming variants)
+func IsPayloadHash(v string) bool {
+	if len(v) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(v)
+	return err == nil
+}
+
+///////////////////
+// payloadReader //
+///////////////////
+
+func NewPayloadReader(r io.ReadCloser, expected string, size int64) io.ReadCloser {
+	return &payloadReader{r: r, h: sha256.New(), expected: strings.ToLower(expected), size: size}
+}
+
+func (pr *payloadReader) Read(p []byte) (n int, err error) {
+	n, err = pr.r.Read(p)
+	if pr.done {
+		return n, err
+	}
+	pr.h.Write(p[:n])
+	pr.off += int64(n)
+	if err == io.EOF || (pr.size >= 0 && pr.off >= pr.size) {
+		pr.done = true
+		if actual := hex.EncodeToString(pr.h.Sum(nil)); actual != pr.expected {
+			return n, cos.NewErrDataCksum(cos.NewCksum(cos.ChecksumSHA256, actual), cos.NewCksum(cos.ChecksumSHA256, pr.expected), cos.S3HdrContentSHA256)
+		}
+	}
+	return n, err
+}
+
+func (pr *payloadReader) Close() error { return pr.r.Close() }
+
+func (sig *SigV4) canonicalQuery(q url.Values) string {
+	type kv struct{ k, v string }
+	all := make([]kv, 0, len(q))
+	for k, vs := range q {
+		if sig.Presigned && k == QparamSignature4 {
+			continue
+		}
+		ek := uriEncode(k, true)
+		for _, v := range vs {
+			all = append(all, kv{ek, uriEncode(v, true)})
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].k != all[j].k {
+			return all[i].k < all[j].k
+		}
+		return all[i].v < all[j].v
+	})
+	var sb strings.Builder
+	for i, e := range all {
+		if i > 0 {
+			sb.WriteByte('&')
+		}
+		sb.WriteString(e.k)
+		sb.WriteByte('=')
+		sb.WriteString(e.v)
+	}
+	return sb.String()
+}
+
+func canonicalHeader(r *http.Request, name string) string {
+	if name == "host" {
+		return r.Host
+	}
+	vals := r.Header.Values(name)
+	if len(vals) == 0 && name == "content-length" && r.ContentLength >= 0 {
+		return strconv.FormatInt(r.ContentLength, 10)
+	}
+	out := make([]string, len(vals))
+	for i, v := range vals {
+		out[i] = strings.Join(strings.Fields(v), " ") // trim and collapse sequential spaces
+	}
+	return strings.Join(out, ",")
+}
+
+// URI-encode every byte except the unreserved characters: 'A'-'Z', 'a'-'z', '0'-'9', '-', '.', '_', and '~'
+// (and except '/' in object key names)
+func uriEncode(s string, encodeSlash bool) string {
+	const hexdigits = "0123456789ABCDEF"
+	var sb strings.Builder
+	sb.Grow(len(s) + 16)
+	for i := range len(s) {
+		c := s[i]
+		switch {
+		case (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9'),
+			c == '-', c == '.', c == '_', c == '~':
+			sb.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte('%')
+			sb.WriteByte(hexdigits[c>>4])
+			sb.WriteByte(hexdigits[c&15])
+		}
+	}
+	return sb.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(cos.UnsafeB(data))
+	return h.Sum(nil)
+}
@@ -5,6 +5,8 @@
 package s3
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/xml"
 	"net/http"
 	"net/url"
@@ -237,6 +239,35 @@ func SetS3Headers(hdr http.Header, lom *core.LOM) {
 	}
 }
 
+// checksum mode (x-amz-checksum-mode: ENABLED)
+func IsCksumMode(hdr http.Header) bool {
+	return strings.EqualFold(hdr.Get(HdrChecksumMode), cksumModeEnabled)
+}
+
+// the object's (primary or additional) checksums of the types that S3 supports,
+// base64-encoded (see core/lcksum.go)
+func SetCksumHeaders(hdr http.Header, lom *core.LOM) {
+	var found bool
+	for _, ck := range []struct{ ty, name string }{
+		{cos.ChecksumCRC32C, HdrChecksumCRC32C},
+		{cos.ChecksumSHA256, HdrChecksumSHA256},
+	} {
+		cksum := lom.CksumOf(ck.ty)
+		if cksum == nil {
+			continue
+		}
+		b, err := hex.DecodeString(cksum.Val())
+		if err != nil {
+			continue
+		}
+		hdr.Set(ck.name, base64.StdEncoding.EncodeToString(b))
+		found = true
+	}
+	if found {
+		hdr.Set(HdrChecksumType, cksumFullObject)
+	}
+}
+
 func (r *CopyObjectResult) MustMarshal(sgl *memsys.SGL) {
 	sgl.Write(cos.UnsafeB(xml.Header))
 	err := xml.NewEncoder(sgl).Encode(r)
@@ -38,11 +38,15 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/health"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
+	"github.com/NVIDIA/aistore/quota"
 	"github.com/NVIDIA/aistore/reb"
+	"github.com/NVIDIA/aistore/repl"
 	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/tracing"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/volume"
@@ -73,6 +77,9 @@ type (
 		res      *res.Res
 		txns     txns
 		regstate regstate
+		slowreqs stats.SlowReqs // (see tgtdbg)
+		admit    admission      // (see tgtadmit)
+		ra       readAhead      // (see tgtra)
 	}
 )
 
@@ -377,6 +384,8 @@ func (t *target) Run() error {
 	config := cmn.GCO.Get()
 	t.htrun.init(config)
 	t.setusr1()
+	t.admit.init(t)
+	t.ra.init(t)
 
 	core.Tinit(t, config, true /*run hk*/)
 
@@ -395,6 +404,8 @@ func (t *target) Run() error {
 	// register object type and workfile type
 	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	fs.CSM.Reg(fs.ChunkType, &fs.ChunkContentResolver{})
+	fs.CSM.Reg(fs.PackType, &fs.PackContentResolver{})
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -461,6 +472,8 @@ func (t *target) Run() error {
 
 	ec.Init()
 	mirror.Init()
+	repl.Init(config, t.statsT)
+	quota.Init(t.quotaPeers)
 
 	xreg.RegWithHK()
 
@@ -469,6 +482,8 @@ func (t *target) Run() error {
 		go t.goresilver(config, marked.Interrupted)
 	}
 
+	hk.Reg("tier"+hk.NameSuffix, t.hkTier, config.Tier.IntervalX()) // (see tgtspace.go)
+
 	etl.Tinit()
 	dsort.Tinit(db, config)
 	dload.Init(db, &config.Client)
@@ -579,6 +594,9 @@ func (t *target) initRecvHandlers() {
 		// machine learning
 		{r: apc.ML, h: t.mlHandler, net: accessNetPublicControl},
 
+		// diagnostics
+		{r: apc.Debug, h: t.debugHandler, net: accessNetPublicControl},
+
 		{r: "/" + apc.S3, h: t.s3Handler, net: accessNetPublicData},
 		{r: "/", h: t.errURL, net: accessNetAll},
 	}
@@ -695,14 +713,23 @@ func (t *target) bucketHandler(w http.ResponseWriter, r *http.Request) {
 func (t *target) objectHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
+		if t.notAdmitted(w, r, prioInteractive, false /*S3*/) {
+			return
+		}
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, true /*dpq*/)
 		t.httpobjget(w, r, apireq)
 		apiReqFree(apireq)
 	case http.MethodHead:
+		if t.notAdmitted(w, r, prioInteractive, false) {
+			return
+		}
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, false)
 		t.httpobjhead(w, r, apireq)
 		apiReqFree(apireq)
 	case http.MethodPut:
+		if t.notAdmitted(w, r, prioBatch, false) {
+			return
+		}
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, true /*dpq*/)
 		if err := t.parseReq(w, r, apireq); err == nil {
 			lom := core.AllocLOM(apireq.items[1])
@@ -715,6 +742,9 @@ func (t *target) objectHandler(w http.ResponseWriter, r *http.Request) {
 		t.httpobjdelete(w, r, apireq)
 		apiReqFree(apireq)
 	case http.MethodPost:
+		if t.notAdmitted(w, r, prioBatch, false) {
+			return
+		}
 		apireq := apiReqAlloc(2, apc.URLPathObjects.L, false /*useDpq*/)
 		t.httpobjpost(w, r, apireq)
 		apiReqFree(apireq)
@@ -822,7 +852,7 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.dpq = dpq
 		goi.req = r
 		goi.w = w
-		goi.ctx = context.Background()
+		goi.ctx = tracing.Detached(r.Context()) // (backend calls to continue the trace)
 		goi.ranges = byteRanges{Range: r.Header.Get(cos.HdrRange), Size: 0}
 		goi.latestVer = _validateWarmGet(goi.lom, dpq.latestVer) // apc.QparamLatestVer || versioning.*_warm_get
 	}
@@ -846,8 +876,17 @@ func (t *target) getObject(w http.ResponseWriter, r *http.Request, dpq *dpq, bck
 		goi.ctx = context.WithValue(goi.ctx, cos.CtxOriginalURL, originalURL)
 	}
 
+	// read-ahead (see tgtra)
+	if k := raCount(lom, dpq); k > 0 {
+		t.ra.get(lom, k)
+	}
+
 	// do
-	if ecode, err := goi.getObject(); err != nil {
+	ecode, err := goi.getObject()
+	if total := mono.SinceNano(goi.ltime); total > int64(cmn.GCO.Get().Log.SlowReqTimeX()) {
+		goi.slow(total, ecode, err)
+	}
+	if err != nil {
 		// stats
 		vlabs := map[string]string{stats.VlabBucket: bck.Cname("")}
 		if goi.isIOErr {
@@ -1033,6 +1072,15 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 	objName := apireq.items[1]
+	if isT2TPut(r.Header) {
+		// (mirror.placement = "targets") the owner is deleting replicas
+		if err := t.checkIntraCall(r.Header, false /*from primary*/); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.delReplica(w, r, apireq.bck, objName)
+		return
+	}
 	if isRedirect(apireq.query) == "" {
 		t.writeErrf(w, r, "%s: %s(obj) is expected to be redirected", t.si, r.Method)
 		return
@@ -1050,6 +1098,9 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 	if err == nil && ecode == 0 {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
+		if !evict {
+			t.statsT.AddBckTraffic(stats.TrafficDel, lom.Bucket(), queryUser(apireq.query), 0)
+		}
 	} else {
 		if ecode == http.StatusNotFound {
 			t.writeErrSilentf(w, r, http.StatusNotFound, "%s doesn't exist", lom.Cname())
@@ -1060,6 +1111,31 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 	core.FreeLOM(lom)
 }
 
+// remove local replica in place (compare with t.DeleteObject)
+func (t *target) delReplica(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	lom.Lock(true)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	if err == nil {
+		err = lom.RemoveObj()
+	}
+	lom.Unlock(true)
+	switch {
+	case err == nil:
+		vlabs := map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
+		t.statsT.IncWith(stats.DeleteCount, vlabs)
+	case cos.IsNotExist(err):
+		t.writeErrSilentf(w, r, http.StatusNotFound, "%s doesn't exist", lom.Cname())
+	default:
+		t.writeErr(w, r, err)
+	}
+}
+
 // POST /v1/objects/bucket-name/object-name
 func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiRequest) {
 	msg, err := t.readActionMsg(w, r)
@@ -1270,6 +1346,13 @@ func (t *target) objHead(r *http.Request, whdr http.Header, q url.Values, bck *m
 		}
 	}
 
+	// apc.QparamCksumType (see core/lcksum.go)
+	if ty := q.Get(apc.QparamCksumType); ty != "" && exists {
+		if cksum := lom.CksumOf(ty); cksum != nil {
+			op.ObjAttrs.Cksum = cksum
+		}
+	}
+
 	// to header
 	cmn.ToHeader(&op.ObjAttrs, whdr, op.ObjAttrs.Size)
 	if op.ObjAttrs.Cksum == nil {
@@ -1373,6 +1456,7 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 		r:        r.Body,
 		filename: filename,
 		mime:     mime,
+		prev:     -1,
 		put:      false, // below
 	}
 	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
@@ -1385,6 +1469,7 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 		a.put = true
 	} else {
 		a.put = (flags == 0)
+		a.prev = lom.Lsize()
 	}
 	if s := r.Header.Get(cos.HdrContentLength); s != "" {
 		if size, err := strconv.ParseInt(s, 10, 64); err == nil {
@@ -1395,6 +1480,9 @@ func (t *target) putApndArch(r *http.Request, lom *core.LOM, started int64, dpq
 		return http.StatusBadRequest, fmt.Errorf("failed to archive %s: missing %q in the request",
 			lom.Cname(), cos.HdrContentLength)
 	}
+	if err := quota.Check(lom.Bck(), a.size, a.prev); err != nil {
+		return http.StatusInsufficientStorage, err
+	}
 	return a.do()
 }
 
@@ -1421,6 +1509,10 @@ func (t *target) DeleteObject(lom *core.LOM, evict bool) (code int, err error) {
 	switch {
 	case err == nil:
 		t.statsT.IncWith(stats.DeleteCount, vlabs)
+		if !evict {
+			repl.Enqueue(lom.Bck(), lom.ObjName, repl.OpDel)
+		}
+		mirror.DelReplicas(lom)
 	case cos.IsNotExist(err, code) || cmn.IsErrObjNought(err):
 		if !evict {
 			t.statsT.IncWith(stats.ErrDeleteCount, vlabs)
@@ -1525,10 +1617,13 @@ func (t *target) delobj(lom *core.LOM, evict bool) (int, error, bool) {
 				return 0, aisErr, false
 			}
 			debug.Assert(aisErr == nil) // expecting lom.RemoveObj() to return nil when IsNotExist
-		} else if evict {
-			debug.Assert(lom.Bck().IsRemote())
-			t.statsT.Inc(stats.LruEvictCount)
-			t.statsT.Add(stats.LruEvictSize, size)
+		} else {
+			quota.Del(lom)
+			if evict {
+				debug.Assert(lom.Bck().IsRemote())
+				t.statsT.Inc(stats.LruEvictCount)
+				t.statsT.Add(stats.LruEvictSize, size)
+			}
 		}
 	}
 	if backendErr != nil {
@@ -1573,6 +1668,14 @@ func (t *target) objMv(lom *core.LOM, msg *apc.ActMsg) error {
 		nlog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
 	}
 	lom.Unlock(true)
+	mirror.DelReplicas(lom)
+
+	// local copy bypasses PUT; otherwise, the receiving target journals the new name
+	smap := t.owner.smap.get()
+	if tsi, err := smap.HrwName2T(lom.Bck().MakeUname(msg.Name)); err == nil && tsi.ID() == t.SID() {
+		repl.Enqueue(lom.Bck(), msg.Name, repl.OpPut)
+	}
+	repl.Enqueue(lom.Bck(), lom.ObjName, repl.OpDel)
 	return nil
 }
 
@@ -1623,22 +1726,15 @@ func (t *target) blobdl(params *core.BlobParams, oa *cmn.ObjAttrs) (string, *xs.
 }
 
 // returns an empty xid ("") if nothing to do
+// (the workfile or chunk writer - params.Lmfh - gets created by xs.RenewBlobDl)
 func _blobdl(params *core.BlobParams, oa *cmn.ObjAttrs) (string, *xs.XactBlobDl, error) {
-	if params.WriteSGL == nil {
-		// regular lom save (custom writer not present)
-		wfqn := fs.CSM.Gen(params.Lom, fs.WorkfileType, "blob-dl")
-		lmfh, err := params.Lom.CreateWork(wfqn)
-		if err != nil {
-			return "", nil, err
-		}
-		params.Lmfh = lmfh
-		params.Wfqn = wfqn
-	}
 	// new
 	xid := cos.GenUUID()
 	rns := xs.RenewBlobDl(xid, params, oa)
 	if rns.Err != nil || rns.IsRunning() { // cmn.IsErrXactUsePrev(rns.Err): single blob-downloader per blob
-		if params.Lmfh != nil {
+		if cw, ok := params.Lmfh.(*core.ChunkWriter); ok {
+			cw.Abort()
+		} else if params.Lmfh != nil {
 			cos.Close(params.Lmfh)
 		}
 		if params.Wfqn != "" {
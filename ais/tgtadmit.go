@@ -0,0 +1,241 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/ais/s3"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Adaptive admission control (target), see cmn.AdmissionConf
+// - requests are classified by priority: interactive (object GET and HEAD),
+//   batch (PUT, APPEND, promote, GetBatch, batch xactions), and background (LRU,
+//   storage cleanup, and similar)
+// - target's load level is computed from max disk utilization (vs. disk_util_high_wm
+//   and disk_util_max_wm) and memory pressure, and refreshed at most every `admitRefresh`
+// - given load level and priority, a request is either admitted, queued (waits for the
+//   load to subside, up to `queue_timeout`), or rejected with 503 and Retry-After
+// - intra-cluster data-path requests (e.g., rebalance, replication) are never throttled
+//   (provided they pass intra-call validation - see checkIntraCall)
+
+// priority classes
+const (
+	prioInteractive = iota
+	prioBatch
+	prioBackground
+)
+
+// load levels
+const (
+	loadNormal = iota
+	loadHigh   // disk utilization above high watermark and/or high memory pressure
+	loadMax    // disk utilization above max watermark and/or extreme memory pressure
+	loadOOM    // out of memory
+)
+
+const (
+	admitRefresh = 250 * time.Millisecond // recompute load level at most this often
+	admitPoll    = 50 * time.Millisecond  // queued request: check again
+)
+
+// per priority class: queue when load >= queue; reject when load >= reject
+var admitRules = [...]struct{ queue, reject int }{
+	prioInteractive: {loadOOM, loadOOM}, // (never queued)
+	prioBatch:       {loadHigh, loadMax},
+	prioBackground:  {loadHigh, loadHigh}, // (ditto)
+}
+
+var (
+	prioText  = [...]string{"interactive", "batch", "background"}
+	levelText = [...]string{"normal", "high", "max", "OOM"}
+)
+
+type (
+	admitLoad struct {
+		util     int64 // max disk utilization
+		pressure int   // memory pressure (memsys)
+		level    int
+		ts       int64 // mono time
+	}
+	admission struct {
+		load   ratomic.Pointer[admitLoad]
+		getter func() (util int64, pressure int)
+		queued atomic.Int32
+		busy   atomic.Bool
+	}
+)
+
+func (a *admission) init(t *target) {
+	a.getter = func() (int64, int) { return fs.GetMaxUtil(), t.gmm.Pressure() }
+}
+
+// current load level - never blocks
+func (a *admission) level(config *cmn.Config, now int64) *admitLoad {
+	ld := a.load.Load()
+	if ld != nil && now-ld.ts < int64(admitRefresh) {
+		return ld
+	}
+	if !a.busy.CAS(false, true) {
+		if ld == nil {
+			ld = &admitLoad{}
+		}
+		return ld
+	}
+	util, pressure := a.getter()
+	nld := &admitLoad{util: util, pressure: pressure, ts: now}
+	switch {
+	case util >= config.Disk.DiskUtilMaxWM:
+		nld.level = loadMax
+	case util >= config.Disk.DiskUtilHighWM:
+		nld.level = loadHigh
+	}
+	switch pressure {
+	case memsys.OOM:
+		nld.level = loadOOM
+	case memsys.PressureExtreme:
+		nld.level = max(nld.level, loadMax)
+	case memsys.PressureHigh:
+		nld.level = max(nld.level, loadHigh)
+	}
+	a.load.Store(nld)
+	a.busy.Store(false)
+	return nld
+}
+
+// returns time spent in the queue (if any) and cmn.ErrTooManyRequests (503) when rejected;
+// queue == false: reject rather than wait (control plane)
+func (a *admission) admit(ctx context.Context, prio int, queue bool, config *cmn.Config) (waited int64, _ error) {
+	var (
+		rule = admitRules[prio]
+		ld   = a.level(config, mono.NanoTime())
+	)
+	if ld.level < rule.queue {
+		return 0, nil
+	}
+	if ld.level >= rule.reject || !queue {
+		return 0, errOverloaded(prio, ld)
+	}
+	conf := &config.Admission
+	if n := a.queued.Inc(); int(n) > conf.MaxQueuedX() {
+		a.queued.Dec()
+		return 0, errOverloaded(prio, ld)
+	}
+
+	var (
+		err      error
+		started  = mono.NanoTime()
+		deadline = started + conf.QueueTimeoutX().Nanoseconds()
+		ticker   = time.NewTicker(admitPoll)
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-ticker.C:
+		}
+		if err != nil {
+			break
+		}
+		now := mono.NanoTime()
+		ld = a.level(config, now)
+		if ld.level < rule.queue {
+			break
+		}
+		if ld.level >= rule.reject || now >= deadline {
+			err = errOverloaded(prio, ld)
+			break
+		}
+	}
+	ticker.Stop()
+	a.queued.Dec()
+	return mono.SinceNano(started), err
+}
+
+func errOverloaded(prio int, ld *admitLoad) error {
+	err := fmt.Errorf("overloaded (load %s, disk util %d%%, memory pressure %s): cannot admit %s request",
+		levelText[ld.level], ld.util, memsys.PressureText(ld.pressure), prioText[prio])
+	return cmn.NewErrTooManyRequests(err, http.StatusServiceUnavailable)
+}
+
+//
+// target
+//
+
+// client (data path) request: returns true when rejected (and the error already written)
+func (t *target) notAdmitted(w http.ResponseWriter, r *http.Request, prio int, isS3 bool) bool {
+	config := cmn.GCO.Get()
+	if !config.Admission.Enabled {
+		return false
+	}
+	// intra-cluster (and target-to-target) requests must be validated - headers alone can be spoofed
+	if (r.Header.Get(apc.HdrCallerID) != "" || isT2TPut(r.Header)) && t.checkIntraCall(r.Header, false /*from primary*/) == nil {
+		return false
+	}
+	err := t.admitReq(w, r, prio, true /*queue*/, config)
+	if err == nil {
+		return false
+	}
+	if isS3 {
+		s3.WriteErr(w, r, err, http.StatusServiceUnavailable)
+	} else {
+		t.writeErr(w, r, err, http.StatusServiceUnavailable, Silent)
+	}
+	return true
+}
+
+// control-plane request (e.g., start xaction) that starts batch or background work
+func (t *target) admitCtrl(w http.ResponseWriter, r *http.Request, prio int) error {
+	config := cmn.GCO.Get()
+	if !config.Admission.Enabled {
+		return nil
+	}
+	return t.admitReq(w, r, prio, false /*queue*/, config)
+}
+
+func (t *target) admitReq(w http.ResponseWriter, r *http.Request, prio int, queue bool, config *cmn.Config) error {
+	waited, err := t.admit.admit(r.Context(), prio, queue, config)
+	if waited > 0 {
+		t.statsT.Inc(stats.AdmitQueueCount)
+		t.statsT.Add(stats.AdmitQueueLatencyTotal, waited)
+	}
+	if err == nil || !cmn.IsErrTooManyRequests(err) {
+		return err
+	}
+	t.statsT.Inc(stats.ErrAdmitCount)
+	w.Header().Set(cos.HdrRetryAfter, retryAfter(config.Admission.RetryAfterX()))
+	return err
+}
+
+// (whole seconds, rounded up)
+func retryAfter(d time.Duration) string {
+	secs := max((d+time.Second-1)/time.Second, 1)
+	return strconv.FormatInt(int64(secs), 10)
+}
+
+// priority of a (startable) xaction; ok == false: always admit
+func xprio(kind string) (prio int, ok bool) {
+	switch kind {
+	case apc.ActLRU, apc.ActStoreCleanup, apc.ActTierMigrate, apc.ActLoadLomCache, apc.ActRecomputeCksum:
+		return prioBackground, true
+	case apc.ActPrefetchObjects, apc.ActBlobDl, apc.ActReplResync:
+		return prioBatch, true
+	default:
+		return 0, false // (e.g., resilver)
+	}
+}
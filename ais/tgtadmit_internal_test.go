@@ -0,0 +1,148 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestAdmission(t *testing.T) {
+	var (
+		util     atomic.Int64
+		pressure atomic.Int32
+		a        = &admission{getter: func() (int64, int) { return util.Load(), int(pressure.Load()) }}
+		config   = &cmn.Config{}
+		ctx      = context.Background()
+	)
+	config.Disk.DiskUtilHighWM = 80
+	config.Disk.DiskUtilMaxWM = 95
+	config.Admission.Enabled = true
+	config.Admission.QueueTimeout = cos.Duration(200 * time.Millisecond)
+
+	tests := []struct {
+		util     int64
+		pressure int
+		level    int
+	}{
+		{10, memsys.PressureLow, loadNormal},
+		{85, memsys.PressureModerate, loadHigh},
+		{10, memsys.PressureHigh, loadHigh},
+		{96, memsys.PressureLow, loadMax},
+		{85, memsys.PressureExtreme, loadMax},
+		{10, memsys.OOM, loadOOM},
+	}
+	for _, test := range tests {
+		util.Store(test.util)
+		pressure.Store(int32(test.pressure))
+		a.load.Store(nil)
+		ld := a.level(config, mono.NanoTime())
+		tassert.Errorf(t, ld.level == test.level, "util %d, pressure %d: expected level %d, got %d",
+			test.util, test.pressure, test.level, ld.level)
+	}
+
+	// admit: normal load
+	util.Store(10)
+	pressure.Store(memsys.PressureLow)
+	a.load.Store(nil)
+	for prio := prioInteractive; prio <= prioBackground; prio++ {
+		_, err := a.admit(ctx, prio, true, config)
+		tassert.CheckError(t, err)
+	}
+
+	// high load: background rejected, batch queued and eventually rejected, interactive admitted
+	util.Store(85)
+	a.load.Store(nil)
+	_, err := a.admit(ctx, prioInteractive, true, config)
+	tassert.CheckError(t, err)
+	_, err = a.admit(ctx, prioBackground, true, config)
+	tassert.Errorf(t, cmn.IsErrTooManyRequests(err), "expected background to be rejected, got %v", err)
+	_, err = a.admit(ctx, prioBatch, false /*queue*/, config)
+	tassert.Errorf(t, cmn.IsErrTooManyRequests(err), "expected batch to be rejected, got %v", err)
+	waited, err := a.admit(ctx, prioBatch, true, config)
+	tassert.Errorf(t, cmn.IsErrTooManyRequests(err), "expected batch to time out, got %v", err)
+	tassert.Errorf(t, waited >= int64(200*time.Millisecond), "expected to wait for queue_timeout, waited %v", time.Duration(waited))
+	tassert.Errorf(t, a.queued.Load() == 0, "expected empty queue, got %d", a.queued.Load())
+
+	// high load subsides while batch request is queued
+	config.Admission.QueueTimeout = cos.Duration(10 * time.Second)
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		util.Store(10)
+	}()
+	waited, err = a.admit(ctx, prioBatch, true, config)
+	tassert.CheckError(t, err)
+	tassert.Errorf(t, waited > 0 && waited < int64(5*time.Second), "unexpected wait time %v", time.Duration(waited))
+
+	// max load: batch rejected right away
+	util.Store(96)
+	a.load.Store(nil)
+	waited, err = a.admit(ctx, prioBatch, true, config)
+	tassert.Errorf(t, cmn.IsErrTooManyRequests(err) && waited == 0, "expected batch to be rejected right away, got %v (%d)", err, waited)
+	_, err = a.admit(ctx, prioInteractive, true, config)
+	tassert.CheckError(t, err)
+
+	// OOM: reject all
+	pressure.Store(memsys.OOM)
+	a.load.Store(nil)
+	_, err = a.admit(ctx, prioInteractive, true, config)
+	tassert.Errorf(t, cmn.IsErrTooManyRequests(err), "expected interactive to be rejected, got %v", err)
+
+	// Retry-After
+	tassert.Errorf(t, retryAfter(time.Second) == "1", "expected 1s, got %s", retryAfter(time.Second))
+	tassert.Errorf(t, retryAfter(1500*time.Millisecond) == "2", "expected 2s, got %s", retryAfter(1500*time.Millisecond))
+	tassert.Errorf(t, retryAfter(0) == "1", "expected (min) 1s, got %s", retryAfter(0))
+}
+
+func TestNotAdmittedIntraCall(tt *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	config.Admission.Enabled = true
+	cmn.GCO.CommitUpdate(config)
+	getter := t.admit.getter
+	t.admit.getter = func() (int64, int) { return 0, memsys.OOM }
+	t.admit.load.Store(nil)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Admission.Enabled = false
+		cmn.GCO.CommitUpdate(config)
+		t.admit.getter = getter
+		t.admit.load.Store(nil)
+	}()
+
+	tests := []struct {
+		name     string
+		hdr      map[string]string
+		admitted bool
+	}{
+		{"client", nil, false},
+		{"claimed caller", map[string]string{apc.HdrCallerID: t.SID()}, false},
+		{"claimed t2t", map[string]string{apc.HdrT2TPutterID: t.SID()}, false},
+		{"intra-call", map[string]string{apc.HdrCallerID: t.SID(), apc.HdrCallerName: t.si.Name()}, true},
+		{"t2t", map[string]string{apc.HdrT2TPutterID: t.SID(), apc.HdrCallerID: t.SID(), apc.HdrCallerName: t.si.Name()}, true},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/v1/objects/"+testBucket+"/obj", http.NoBody)
+		for k, v := range test.hdr {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		rejected := t.notAdmitted(w, r, prioInteractive, false /*isS3*/)
+		tassert.Errorf(tt, rejected != test.admitted, "%s: expected admitted=%t", test.name, test.admitted)
+		if rejected {
+			tassert.Errorf(tt, w.Code == http.StatusServiceUnavailable, "%s: expected status 503, got %d", test.name, w.Code)
+		}
+	}
+}
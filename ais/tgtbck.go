@@ -114,6 +114,7 @@ func (t *target) httpbckget(w http.ResponseWriter, r *http.Request, dpq *dpq) {
 		t.statsT.AddWith(
 			cos.NamedVal64{Name: stats.ListLatency, Value: delta, VarLabs: vlabs},
 		)
+		t.statsT.AddBckTraffic(stats.TrafficList, bck.Bucket(), dpq.user, 0)
 	case apc.ActSummaryBck:
 		var bucket, phase string // txn
 		if len(apiItems) == 0 {
@@ -435,7 +436,7 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 	if err != nil {
 		return
 	}
-	if msg.Action != apc.ActPrefetchObjects {
+	if msg.Action != apc.ActPrefetchObjects && msg.Action != apc.ActReadAhead {
 		t.writeErrAct(w, r, msg.Action)
 		return
 	}
@@ -448,6 +449,10 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 		t.writeErr(w, r, err)
 		return
 	}
+	if msg.Action == apc.ActReadAhead {
+		t.raHinted(w, r, apireq.bck, msg)
+		return
+	}
 
 	prfMsg := &apc.PrefetchMsg{}
 	if err := cos.MorphMarshal(msg.Value, prfMsg); err != nil {
@@ -27,6 +27,7 @@ import (
 	"github.com/NVIDIA/aistore/ext/etl"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/quota"
 	"github.com/NVIDIA/aistore/reb"
 	"github.com/NVIDIA/aistore/res"
 	"github.com/NVIDIA/aistore/xact"
@@ -436,6 +437,8 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		ds.Tcdf = daeStats.Tcdf
 		t.fillNsti(&ds.Cluster)
 		t.writeJSON(w, r, ds, httpdaeWhat)
+	case apc.WhatBckTraffic:
+		t.writeJSON(w, r, t.statsT.GetBckTraffic(), httpdaeWhat)
 
 	case apc.WhatMountpaths:
 		var (
@@ -463,6 +466,40 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 		fs.DiskStats(tcdfExt.AllDiskStats, &tcdfExt.Tcdf, config, true)
 		t.writeJSON(w, r, tcdfExt, httpdaeWhat)
 
+	case apc.WhatRebPlan:
+		var msg apc.RebPlanMsg
+		if err := cmn.ReadJSON(w, r, &msg); err != nil {
+			return
+		}
+		nsmap, err := reb.NewPlanSmap(&t.owner.smap.get().Smap, &msg)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		plan, err := reb.Plan(nsmap)
+		if err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		t.writeJSON(w, r, plan, httpdaeWhat)
+
+	case apc.WhatQuotaUsage:
+		var bck cmn.Bck
+		if err := cmn.ReadJSON(w, r, &bck); err != nil {
+			return
+		}
+		mbck := meta.CloneBck(&bck)
+		if err := mbck.Init(t.owner.bmd); err != nil {
+			t.writeErr(w, r, err)
+			return
+		}
+		c, ok := quota.Local(mbck)
+		if !ok {
+			t.writeErrSilentf(w, r, http.StatusServiceUnavailable, "%s: %s usage is not counted yet", t, mbck.Cname(""))
+			return
+		}
+		t.writeJSON(w, r, c, httpdaeWhat)
+
 	case apc.WhatRemoteAIS:
 		var (
 			config  = cmn.GCO.Get()
@@ -997,6 +1034,7 @@ func (t *target) _runRe(newRMD *rebMD, msg *actMsgExt, smap *smapX, oxid string)
 
 	// 2.2. "pure" metasync(newRMD) w/ no action - double-check with cluster config
 	default:
+		extArgs.Auto = true // subject to rebalance.window
 		config := cmn.GCO.Get()
 		debug.Assert(config.Version > 0 && config.UUID == smap.UUID, config.String(), " vs ", smap.StringEx())
 
@@ -1396,6 +1434,34 @@ func (t *target) headt2t(lom *core.LOM, tsi *meta.Snode, smap *smapX) (ok bool)
 	return
 }
 
+// sum of all other targets' usage of a given bucket (see quota.Init)
+func (t *target) quotaPeers(bck *meta.Bck) (quota.Counters, error) {
+	var c quota.Counters
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodGet,
+		Path:   apc.URLPathDae.S,
+		Query:  url.Values{apc.QparamWhat: []string{apc.WhatQuotaUsage}},
+		Body:   cos.MustMarshal(bck.Bucket()),
+	}
+	args.to = core.Targets
+	args.cresv = cresjGeneric[quota.Counters]{}
+	results := t.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err != nil {
+			err := res.toErr()
+			freeBcastRes(results)
+			return c, err
+		}
+		v := res.v.(*quota.Counters)
+		c.Size += v.Size
+		c.Objs += v.Objs
+	}
+	freeBcastRes(results)
+	return c, nil
+}
+
 // headObjBcast broadcasts to all targets to find out if anyone has the specified object.
 // NOTE: 1) apc.QparamCheckExistsAny to make an extra effort, 2) `ignoreMaintenance`
 func (t *target) headObjBcast(lom *core.LOM, smap *smapX) *meta.Snode {
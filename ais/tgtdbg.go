@@ -0,0 +1,78 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// per-request diagnostics: slow GET requests and their per-phase timing
+// (see stats.SlowReq and `log.slow_request_time`)
+
+// times disk reads (the remaining transmit time is then attributed to writing to the client)
+type timedReader struct {
+	r       io.Reader
+	elapsed int64
+	n       int64
+}
+
+// interface guard
+var _ io.Reader = (*timedReader)(nil)
+
+func (tr *timedReader) Read(b []byte) (n int, err error) {
+	started := mono.NanoTime()
+	n, err = tr.r.Read(b)
+	tr.elapsed += mono.SinceNano(started)
+	tr.n += int64(n)
+	return n, err
+}
+
+// GET /v1/debug/<what>
+func (t *target) debugHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		cmn.WriteErr405(w, r, http.MethodGet)
+		return
+	}
+	apiItems, err := t.parseURL(w, r, apc.URLPathDebug.L, 1, false)
+	if err != nil {
+		return
+	}
+	switch apiItems[0] {
+	case apc.SlowReqs:
+		t.writeJSON(w, r, t.slowreqs.Get(), apc.SlowReqs)
+	default:
+		t.writeErrURL(w, r)
+	}
+}
+
+// log and remember (ring buffer) GET that took longer than configured
+func (goi *getOI) slow(total int64, ecode int, err error) {
+	sr := stats.SlowReq{
+		Time:    goi.atime,
+		Method:  goi.req.Method,
+		Cname:   goi.lom.Cname(),
+		User:    goi.dpq.user,
+		Size:    goi.rd.n,
+		Total:   total,
+		Phases:  goi.phases,
+		ColdGET: goi.cold,
+	}
+	if err != nil {
+		sr.Err = err.Error()
+		sr.Status = ecode
+		if ecode == 0 {
+			sr.Status = http.StatusInternalServerError
+		}
+	}
+	nlog.Warningln(goi.t.String()+":", sr.String())
+	goi.t.slowreqs.Add(&sr)
+}
@@ -118,7 +118,7 @@ func (t *target) httpecpost(w http.ResponseWriter, r *http.Request) {
 			// - remove warning when done
 			nlog.Warningf("%s[%s] not running - proceeding to ec-recover %s anyway..", t, apc.ActECEncode, uuid, lom)
 
-			err := ec.ECM.Recover(lom)
+			err := ec.ECM.Recover(r.Context(), lom)
 			cname := lom.Cname()
 			core.FreeLOM(lom)
 			if err != nil {
@@ -34,7 +34,7 @@ func (goi *getOI) _fini(revert string, fullSize, txSize int64) error {
 	if err := ec.ECM.EncodeObject(lom, nil); err != nil && err != ec.ErrorECDisabled {
 		nlog.InfoDepth(1, ftcg, "(ec)", lom, err)
 	}
-	goi.t.putMirror(lom)
+	goi.t.putMirror(lom, true /*overwrite*/)
 
 	// load
 	if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
@@ -116,9 +116,17 @@ func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 		written   int64
 		buf, slab = t.gmm.AllocSize(_txsize(res.Size))
 		cksum     = cos.NewCksumHash(lom.CksumConf().Type)
-		mw        = cos.NewWriterMulti(goi.w, lmfh, cksum.H)
+		extra     = lom.CksumConf().ExtraTypes()
+		xhashes   = make([]*cos.CksumHash, len(extra))
+		writers   = make([]io.Writer, 0, 3+len(extra))
 		whdr      = goi.w.Header()
 	)
+	writers = append(writers, goi.w, lmfh, cksum.H)
+	for i, ty := range extra { // additional checksums (see core/lcksum.go)
+		xhashes[i] = cos.NewCksumHash(ty)
+		writers = append(writers, xhashes[i].H)
+	}
+	mw := cos.NewWriterMulti(writers...)
 
 	// response header
 	whdr.Set(cos.HdrContentType, cos.ContentBinary)
@@ -165,6 +173,12 @@ func (goi *getOI) coldStream(res *core.GetReaderResult) error {
 	lom.SetSize(written)
 	cksum.Finalize()
 	lom.SetCksum(&cksum.Cksum)
+	xcks := make([]*cos.Cksum, len(xhashes))
+	for i, h := range xhashes {
+		h.Finalize()
+		xcks[i] = &h.Cksum
+	}
+	lom.SetCksums(xcks...)
 	if lom.HasCopies() {
 		if err := lom.DelAllCopies(); err != nil {
 			nlog.Errorln(err)
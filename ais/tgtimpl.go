@@ -19,7 +19,9 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/quota"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/tracing"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact/xreg"
 	"github.com/NVIDIA/aistore/xact/xs"
@@ -72,6 +74,11 @@ func (t *target) FinalizeObj(lom *core.LOM, workFQN string, xctn core.Xact, owt
 	if err = cos.Stat(workFQN); err != nil {
 		return
 	}
+	if owt < cmn.OwtRebalance {
+		if err = quota.Check(lom.Bck(), lom.Lsize(), quota.Prev(lom, false /*locked*/)); err != nil {
+			return http.StatusInsufficientStorage, err
+		}
+	}
 	poi := allocPOI()
 	{
 		poi.t = t
@@ -81,6 +88,13 @@ func (t *target) FinalizeObj(lom *core.LOM, workFQN string, xctn core.Xact, owt
 		poi.owt = owt
 		poi.xctn = xctn
 	}
+	if lom.IsChunked(true) {
+		// (see core.ChunkWriter)
+		if poi.ufest, err = core.LoadUfest(workFQN); err != nil {
+			freePOI(poi)
+			return 0, err
+		}
+	}
 	ecode, err = poi.finalize()
 	freePOI(poi)
 	return
@@ -169,6 +183,7 @@ func (t *target) rgetstats(backend core.Backend, cname, xkind string, size, lat
 	t.statsT.AddWith(
 		cos.NamedVal64{Name: backend.MetricName(stats.GetLatencyTotal), Value: lat, VarLabs: vlabs},
 		cos.NamedVal64{Name: backend.MetricName(stats.GetSize), Value: size, VarLabs: vlabs},
+		cos.NamedVal64{Name: backend.MetricName(stats.GetLatencyHist), Value: lat},
 	)
 }
 
@@ -185,7 +200,11 @@ func (t *target) HeadCold(lom *core.LOM, origReq *http.Request) (oa *cmn.ObjAttr
 		now   = mono.NanoTime()
 		vlabs = map[string]string{stats.VlabBucket: lom.Bck().Cname("")}
 	)
-	oa, ecode, err = bp.HeadObj(context.Background(), lom, origReq)
+	ctx := context.Background()
+	if origReq != nil {
+		ctx = tracing.Detached(origReq.Context())
+	}
+	oa, ecode, err = bp.HeadObj(ctx, lom, origReq)
 	if err != nil {
 		t.statsT.IncWith(stats.ErrHeadCount, vlabs)
 	} else {
@@ -33,10 +33,14 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios/uring"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/mirror"
+	"github.com/NVIDIA/aistore/quota"
 	"github.com/NVIDIA/aistore/reb"
+	"github.com/NVIDIA/aistore/repl"
 	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/tracing"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact/xreg"
@@ -54,10 +58,12 @@ type (
 		xctn       core.Xact     // xaction that puts
 		t          *target       // this
 		lom        *core.LOM     // obj
+		ufest      *core.Ufest   // chunked obj (see core/lchunk.go)
 		cksumToUse *cos.Cksum    // if available (not `none`), can be validated and will be stored
 		config     *cmn.Config   // (during this request)
 		resphdr    http.Header   // as implied
 		workFQN    string        // temp fqn to be renamed
+		user       string        // authenticated user, if any (QparamUser)
 		atime      int64         // access time.Now()
 		ltime      int64         // mono.NanoTime, to measure latency
 		rltime     int64         // mono.NanoTime, to measure remote bucket latency
@@ -69,6 +75,7 @@ type (
 		skipVC     bool          // skip loading existing Version and skip comparing Checksums (skip VC)
 		coldGET    bool          // (one implication: proceed to write)
 		remoteErr  bool          // to exclude `putRemote` errors when counting soft IO errors
+		overwrite  bool          // (mirror.placement = "targets") the owner replaces existing object
 	}
 
 	getOI struct {
@@ -89,6 +96,10 @@ type (
 		latestVer  bool       // QparamLatestVer || 'versioning.*_warm_get'
 		isIOErr    bool       // to count GET error as a "IO error"; see `Trunner._softErrs()`
 		rget       bool       // when reading remote source via backend.GetObjReader, scenarios including: cold-GET, copy, transform, blob
+
+		// per-phase timing (see goi.slow)
+		rd     timedReader // disk read
+		phases stats.ReqPhases
 	}
 	_uplock struct {
 		timeout time.Duration
@@ -135,6 +146,7 @@ type (
 		mime     string        // format
 		started  int64         // time of receiving
 		size     int64         // aka Content-Length
+		prev     int64         // size of the existing shard, or -1 (see quota.Prev)
 		put      bool          // overwrite
 	}
 )
@@ -152,6 +164,7 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 		poi.workFQN = fs.CSM.Gen(poi.lom, fs.WorkfileType, fs.WorkfilePut)
 		poi.cksumToUse = poi.lom.ObjAttrs().FromHeader(r.Header)
 		poi.owt = cmn.OwtPut // default
+		poi.user = dpq.user
 	}
 	if dpq.owt != "" {
 		poi.owt.FromS(dpq.owt)
@@ -198,6 +211,11 @@ func (poi *putOI) putObject() (ecode int, err error) {
 			return 0, nil
 		}
 	}
+	if poi.owt < cmn.OwtRebalance && !poi.coldGET {
+		if err = quota.Check(poi.lom.Bck(), poi.size, quota.Prev(poi.lom, false /*locked*/)); err != nil {
+			return http.StatusInsufficientStorage, err
+		}
+	}
 
 	buf, slab, lmfh, erw := poi.write()
 	poi._cleanup(buf, slab, lmfh, erw)
@@ -224,6 +242,7 @@ func (poi *putOI) putObject() (ecode int, err error) {
 		// user PUT
 		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime)
 		poi.stats()
+		poi.t.statsT.AddBckTraffic(stats.TrafficPut, poi.lom.Bucket(), poi.user, poi.lom.Lsize())
 		// response header
 		if poi.resphdr != nil {
 			cmn.ToHeader(poi.lom.ObjAttrs(), poi.resphdr, 0 /*skip setting content-length*/)
@@ -276,6 +295,7 @@ func (poi *putOI) stats() {
 		cos.NamedVal64{Name: stats.PutThroughput, Value: size, VarLabs: vlabs},
 		cos.NamedVal64{Name: stats.PutLatency, Value: delta, VarLabs: vlabs},
 		cos.NamedVal64{Name: stats.PutLatencyTotal, Value: delta, VarLabs: vlabs},
+		cos.NamedVal64{Name: stats.PutLatencyHist, Value: delta},
 	)
 	if poi.rltime > 0 {
 		debug.Assert(bck.IsRemote())
@@ -285,6 +305,7 @@ func (poi *putOI) stats() {
 			cos.NamedVal64{Name: bp.MetricName(stats.PutLatencyTotal), Value: poi.rltime, VarLabs: vlabs},
 			cos.NamedVal64{Name: bp.MetricName(stats.PutE2ELatencyTotal), Value: delta, VarLabs: vlabs},
 			cos.NamedVal64{Name: bp.MetricName(stats.PutSize), Value: size, VarLabs: vlabs},
+			cos.NamedVal64{Name: bp.MetricName(stats.PutLatencyHist), Value: poi.rltime},
 		)
 	}
 }
@@ -316,6 +337,9 @@ func (poi *putOI) loghdr() string {
 }
 
 func (poi *putOI) finalize() (ecode int, err error) {
+	if poi.ufest != nil {
+		defer poi.ufest.Done()
+	}
 	if ecode, err = poi.fini(); err != nil {
 		if err1 := cos.Stat(poi.workFQN); err1 == nil || !cos.IsNotExist(err1) {
 			// cleanup: rm work-fqn
@@ -325,6 +349,12 @@ func (poi *putOI) finalize() (ecode int, err error) {
 			if err2 := cos.RemoveFile(poi.workFQN); err2 != nil && !cos.IsNotExist(err2) {
 				nlog.Errorf(fmtNested, poi.t, err1, "remove", poi.workFQN, err2)
 			}
+			// and the chunks, if any
+			if poi.ufest != nil {
+				if err2 := poi.ufest.Remove(poi.lom); err2 != nil {
+					nlog.Errorf(fmtNested, poi.t, err1, "remove chunks of", poi.lom.Cname(), err2)
+				}
+			}
 		}
 		poi.lom.UncacheDel()
 		if ecode != http.StatusInsufficientStorage && cmn.IsErrCapExceeded(err) {
@@ -341,7 +371,10 @@ func (poi *putOI) finalize() (ecode int, err error) {
 			return ecode, err
 		}
 	}
-	poi.t.putMirror(poi.lom)
+	poi.t.putMirror(poi.lom, poi.overwrite)
+	if poi.owt < cmn.OwtRebalance {
+		repl.Enqueue(poi.lom.Bck(), poi.lom.ObjName, repl.OpPut)
+	}
 	return 0, nil
 }
 
@@ -402,6 +435,14 @@ func (poi *putOI) fini() (ecode int, err error) {
 		lom.SetAtimeUnix(poi.atime)
 	}
 
+	// (mirror.placement = "targets") when replacing existing object the owner
+	// must invalidate its replicas (see putMirror)
+	if mconfig := lom.MirrorConf(); mconfig.Enabled && mconfig.AcrossTargets() && poi.owt != cmn.OwtRebalance {
+		if smap := poi.t.owner.smap.get(); mirror.IsOwner(lom, &smap.Smap) {
+			poi.overwrite = cos.Stat(lom.FQN) == nil
+		}
+	}
+
 	// ais versioning
 	if bck.IsAIS() && lom.VersionConf().Enabled {
 		switch {
@@ -418,18 +459,60 @@ func (poi *putOI) fini() (ecode int, err error) {
 	}
 
 	// done
-	if err := lom.RenameFinalize(poi.workFQN); err != nil {
-		return 0, err
+	var (
+		prev   = quota.Prev(lom, true /*locked*/)
+		pufest = lom.PrevUfest()
+	)
+	lom.SetChunked(poi.ufest != nil)
+	switch {
+	case poi.ufest == nil && lom.CanPack(lom.Lsize()):
+		// small object => pack (see core/lpack.go)
+		poi.delCopies()
+		if lom.AtimeUnix() == 0 {
+			lom.SetAtimeUnix(poi.atime)
+		}
+		if err := lom.PackFinalize(poi.workFQN); err != nil {
+			return 0, err
+		}
+	case poi.ufest == nil && !lom.IsCompressed(true) && lom.CanDedup(lom.Lsize()):
+		// content-addressed (see core/ldedup.go)
+		poi.delCopies()
+		if lom.AtimeUnix() == 0 {
+			lom.SetAtimeUnix(poi.atime)
+		}
+		if err := lom.DedupFinalize(poi.workFQN); err != nil {
+			return 0, err
+		}
+	default:
+		if err := lom.RenameFinalize(poi.workFQN); err != nil {
+			return 0, err
+		}
+		poi.delCopies()
+		if lom.AtimeUnix() == 0 { // (is set when migrating within cluster; prefetch special case)
+			lom.SetAtimeUnix(poi.atime)
+		}
+		if err := lom.PersistMain(); err != nil {
+			return 0, err
+		}
 	}
-	if lom.HasCopies() {
-		if errdc := lom.DelAllCopies(); errdc != nil {
-			nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding anyway...", poi.loghdr(), errdc)
+	quota.Put(lom, prev)
+
+	// overwrote chunked
+	if pufest != nil && (poi.ufest == nil || poi.ufest.ID != pufest.ID) {
+		if err := pufest.Remove(lom); err != nil {
+			nlog.Warningln(poi.loghdr(), "failed to remove old chunks: [", err, "]")
 		}
 	}
-	if lom.AtimeUnix() == 0 { // (is set when migrating within cluster; prefetch special case)
-		lom.SetAtimeUnix(poi.atime)
+	return 0, nil
+}
+
+func (poi *putOI) delCopies() {
+	if !poi.lom.HasCopies() {
+		return
+	}
+	if errdc := poi.lom.DelAllCopies(); errdc != nil {
+		nlog.Errorf("PUT (%s): failed to delete old copies [%v], proceeding anyway...", poi.loghdr(), errdc)
 	}
-	return 0, lom.PersistMain()
 }
 
 // via backend.PutObj()
@@ -438,8 +521,13 @@ func (poi *putOI) putRemote() (int, error) {
 		lom       = poi.lom
 		startTime = mono.NanoTime()
 	)
-	lmfh, err := cos.NewFileHandle(poi.workFQN)
-	if err != nil {
+	var (
+		lmfh cos.ReadOpenCloser
+		err  error
+	)
+	if poi.ufest != nil {
+		lmfh = core.NewUfestReader(poi.ufest, lom)
+	} else if lmfh, err = cos.NewFileHandle(poi.workFQN); err != nil {
 		return 0, cmn.NewErrFailedTo(poi.t, "open", poi.workFQN, err)
 	}
 	if poi.owt == cmn.OwtPut && !lom.Bck().IsRemoteAIS() {
@@ -449,7 +537,11 @@ func (poi *putOI) putRemote() (int, error) {
 		ecode int
 		bp    = poi.t.Backend(lom.Bck())
 	)
-	ecode, err = bp.PutObj(context.Background(), lmfh, lom, poi.oreq)
+	ctx := context.Background()
+	if poi.oreq != nil {
+		ctx = tracing.Detached(poi.oreq.Context())
+	}
+	ecode, err = bp.PutObj(ctx, lmfh, lom, poi.oreq)
 	if err == nil {
 		if !lom.Bck().IsRemoteAIS() {
 			lom.SetCustomKey(cmn.SourceObjMD, bp.Provider())
@@ -467,15 +559,33 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	var (
 		written int64
 		cksums  = struct {
-			store     *cos.CksumHash // store with LOM
-			expct     *cos.Cksum     // caller-provided (aka "end-to-end protection")
-			compt     *cos.CksumHash // compute to validate `expct` - iff provided
-			finalized bool           // to avoid computing the same checksum type twice
+			store     *cos.CksumHash   // store with LOM
+			expct     *cos.Cksum       // caller-provided (aka "end-to-end protection")
+			compt     *cos.CksumHash   // compute to validate `expct` - iff provided
+			extra     []*cos.CksumHash // additional (see core/lcksum.go)
+			finalized bool             // to avoid computing the same checksum type twice
 		}{}
 		ckconf = poi.lom.CksumConf()
+		extra  = ckconf.ExtraTypes()
+		chunks = &poi.lom.Bprops().Chunks
 	)
-	if lmfh, err = poi.lom.CreateWork(poi.workFQN); err != nil {
-		return nil, nil, nil, err
+	switch {
+	case poi.size > 0 && chunks.Chunked(poi.size) && poi.lom.CanChunk():
+		var cw *core.ChunkWriter
+		if cw, err = poi.lom.CreateChunked(poi.workFQN, chunks.ChunkSizeX()); err != nil {
+			return nil, nil, nil, err
+		}
+		lmfh = cw
+	case poi.lom.CanCompress(max(poi.size, -1)):
+		var cw *core.ComprWriter
+		if cw, err = poi.lom.CreateCompressed(poi.workFQN); err != nil {
+			return nil, nil, nil, err
+		}
+		lmfh = cw
+	default:
+		if lmfh, err = poi.lom.CreateWork(poi.workFQN); err != nil {
+			return nil, nil, nil, err
+		}
 	}
 	if poi.size <= 0 {
 		buf, slab = poi.t.gmm.Alloc()
@@ -484,21 +594,27 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 	}
 
 	switch {
-	case ckconf.Type == cos.ChecksumNone:
+	case ckconf.Type == cos.ChecksumNone && len(extra) == 0:
 		poi.lom.SetCksum(cos.NoneCksum)
 		// not using `ReadFrom` of the `*os.File` -
 		// ultimately, https://github.com/golang/go/blob/master/src/internal/poll/copy_file_range_linux.go#L100
 		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
-	case !poi.cksumToUse.IsEmpty() && !poi.validateCksum(ckconf):
+	case !poi.cksumToUse.IsEmpty() && !poi.validateCksum(ckconf) && !poi.lom.CanDedup(-1) && len(extra) == 0:
 		// if the corresponding validation is not configured/enabled we just go ahead
 		// and use the checksum that has arrived with the object
+		// (but never when deduplicating - the checksum is the content key)
 		poi.lom.SetCksum(poi.cksumToUse)
 		// (ditto)
 		written, err = cos.CopyBuffer(lmfh, poi.r, buf)
 	default:
-		writers := make([]io.Writer, 0, 3)
+		writers := make([]io.Writer, 0, 3+len(extra))
 		cksums.store = cos.NewCksumHash(ckconf.Type) // always according to the bucket
 		writers = append(writers, cksums.store.H)
+		for _, ty := range extra {
+			ck := cos.NewCksumHash(ty)
+			cksums.extra = append(cksums.extra, ck)
+			writers = append(writers, ck.H)
+		}
 		if !poi.skipVC && !poi.cksumToUse.IsEmpty() && poi.validateCksum(ckconf) {
 			cksums.expct = poi.cksumToUse
 			if poi.cksumToUse.Type() == cksums.store.Type() {
@@ -533,7 +649,19 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 		debug.AssertNoErr(err)
 	}
 
-	cos.Close(lmfh)
+	switch cw := lmfh.(type) {
+	case *core.ChunkWriter:
+		if err = cw.Close(); err != nil {
+			return buf, slab, lmfh, err
+		}
+		poi.ufest = cw.Ufest()
+	case *core.ComprWriter:
+		if err = cw.Close(); err != nil {
+			return buf, slab, nil, err
+		}
+	default:
+		cos.Close(lmfh)
+	}
 
 	poi.lom.SetSize(written) // TODO: compare with non-zero lom.Lsize() that may have been set via oa.FromHeader()
 	if cksums.store != nil {
@@ -542,6 +670,12 @@ func (poi *putOI) write() (buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 		}
 		poi.lom.SetCksum(&cksums.store.Cksum)
 	}
+	xcks := make([]*cos.Cksum, len(cksums.extra))
+	for i, ck := range cksums.extra {
+		ck.Finalize()
+		xcks[i] = &ck.Cksum
+	}
+	poi.lom.SetCksums(xcks...) // (after size and primary checksum; none - to reset)
 	return buf, slab, nil /*closed lmfh*/, err
 }
 
@@ -557,7 +691,13 @@ func (poi *putOI) _cleanup(buf []byte, slab *memsys.Slab, lmfh cos.LomWriter, er
 
 	// not ok
 	poi.r.Close()
-	if lmfh != nil {
+	switch cw := lmfh.(type) {
+	case *core.ChunkWriter:
+		cw.Abort()
+	case *core.ComprWriter:
+		cw.Abort()
+	case nil:
+	default:
 		if nerr := lmfh.Close(); nerr != nil {
 			nlog.Errorf(fmtNested, poi.t, err, "close", poi.workFQN, nerr)
 		}
@@ -588,7 +728,9 @@ func (poi *putOI) validateCksum(c *cmn.CksumConf) (v bool) {
 
 func (goi *getOI) getObject() (ecode int, err error) {
 	debug.Assert(!goi.unlocked)
+	started := mono.NanoTime()
 	goi.lom.Lock(false)
+	goi.phases.Lock = mono.SinceNano(started)
 	ecode, err = goi.get()
 	if !goi.unlocked {
 		goi.lom.Unlock(false)
@@ -604,10 +746,13 @@ func (goi *getOI) get() (ecode int, err error) {
 		doubleCheck bool
 		retried     bool
 		cold        bool
+		started     int64
 	)
 do: // retry uplock or ec-recovery, the latter only once
 
+	started = mono.NanoTime()
 	err = goi.lom.Load(true /*cache it*/, true /*locked*/)
+	goi.phases.Load += mono.SinceNano(started)
 	if err != nil {
 		cold = cos.IsNotExist(err)
 		if !cold {
@@ -657,7 +802,9 @@ do: // retry uplock or ec-recovery, the latter only once
 		// have remote backend - use it
 	case goi.latestVer:
 		// apc.QparamLatestVer or 'versioning.validate_warm_get'
+		started = mono.NanoTime()
 		res := goi.lom.CheckRemoteMD(true /* rlocked */, false /*synchronize*/, goi.req)
+		goi.phases.Backend += mono.SinceNano(started)
 		if res.Err != nil {
 			return res.ErrCode, res.Err
 		}
@@ -669,7 +816,9 @@ do: // retry uplock or ec-recovery, the latter only once
 
 	// validate checksums and recover (a.k.a. self-heal) if corrupted
 	if !cold && goi.lom.CksumConf().ValidateWarmGet {
+		started = mono.NanoTime()
 		cold, ecode, err = goi.validateRecover()
+		goi.phases.Cksum += mono.SinceNano(started)
 		if err != nil {
 			if !cold {
 				nlog.Errorln(err)
@@ -695,8 +844,11 @@ do: // retry uplock or ec-recovery, the latter only once
 				uplock = goi.uplock(cmn.GCO.Get())
 				nlog.Warningln(uplockWarn, goi.lom.String())
 			}
-			if err := uplock.do(goi.lom); err != nil {
-				return http.StatusConflict, err
+			started = mono.NanoTime()
+			errU := uplock.do(goi.lom)
+			goi.phases.Lock += mono.SinceNano(started)
+			if errU != nil {
+				return http.StatusConflict, errU
 			}
 			cold = false
 			goto do // repeat
@@ -708,8 +860,10 @@ do: // retry uplock or ec-recovery, the latter only once
 
 		// get remote reader (compare w/ t.GetCold)
 		goi.rget = true
+		started = mono.NanoTime()
 		res := bp.GetObjReader(goi.ctx, goi.lom, 0, 0)
 		if res.Err != nil {
+			goi.phases.Backend += mono.SinceNano(started)
 			goi.lom.Unlock(true)
 			goi.unlocked = true
 			if !cos.IsNotExist(res.Err, res.ErrCode) {
@@ -720,13 +874,15 @@ do: // retry uplock or ec-recovery, the latter only once
 		goi.cold = true
 
 		if goi.isStreamingColdGet() {
-			err = goi.coldStream(&res)
+			err = goi.coldStream(&res) // (backend read and write to the client)
+			goi.phases.Backend += mono.SinceNano(started)
 			goi.unlocked = true
 			return 0, err
 		}
 
 		// regular path
 		ecode, err = goi.coldPut(&res)
+		goi.phases.Backend += mono.SinceNano(started)
 		if err != nil {
 			goi.unlocked = true
 			return ecode, err
@@ -918,7 +1074,8 @@ func (goi *getOI) restoreFromAny(skipLomRestore bool) (doubleCheck bool, ecode i
 	if running {
 		doubleCheck = true
 	}
-	if running && tsi.ID() != goi.t.SID() {
+	if (running || goi.lom.MirrorConf().AcrossTargets()) && tsi.ID() != goi.t.SID() {
+		// (also, when mirroring across targets: replica not yet received or lost)
 		if goi.t.headt2t(goi.lom, tsi, smap) {
 			gfnNode = tsi
 			goto gfn
@@ -936,7 +1093,7 @@ gfn:
 	}
 
 	// restore from existing EC slices
-	ecErr := ec.ECM.Recover(goi.lom)
+	ecErr := ec.ECM.Recover(goi.ctx, goi.lom)
 	if ecErr == nil {
 		ecErr = goi.lom.Load(true /*cache it*/, false /*locked*/) // TODO: optimize locking
 		if ecErr == nil {
@@ -1078,7 +1235,13 @@ func (goi *getOI) _txrng(fqn string, lmfh cos.LomReader, whdr http.Header, hrng
 		size       = hrng.Length
 		cksumRange = ckconf.Type != cos.ChecksumNone && ckconf.EnableReadRange
 	)
-	r = io.NewSectionReader(lmfh, hrng.Start, hrng.Length)
+	if rr := core.NewRangeReader(lmfh, hrng.Start, hrng.Length); rr != nil {
+		// chunked object: read the range in parallel
+		defer rr.Close()
+		r = rr
+	} else {
+		r = io.NewSectionReader(lmfh, hrng.Start, hrng.Length)
+	}
 
 	// compute range checksum
 	if cksumRange {
@@ -1129,15 +1292,57 @@ func (goi *getOI) setwhdr(whdr http.Header, cksum *cos.Cksum, size int64) {
 func (goi *getOI) _txreg(fqn string, lmfh cos.LomReader, whdr http.Header) (err error) {
 	// set response header
 	size := goi.lom.Lsize()
-	goi.setwhdr(whdr, goi.lom.Checksum(), size)
+	cksum := goi.lom.Checksum()
+	if ty := goi.dpq.cksumType; ty != "" { // apc.QparamCksumType
+		if ck := goi.lom.CksumOf(ty); ck != nil {
+			cksum = ck
+		}
+	}
+	goi.setwhdr(whdr, cksum, size)
+	if goi.dpq.isS3 && s3.IsCksumMode(goi.req.Header) {
+		s3.SetCksumHeaders(whdr, goi.lom)
+	}
 
 	// Tx
+	if fh := goi.zeroCopy(lmfh, size); fh != nil {
+		return goi.transmit(fh, nil /*buf*/, fqn, size)
+	}
 	buf, slab := goi.t.gmm.AllocSize(min(size, memsys.MaxPageSlabSize))
 	err = goi.transmit(lmfh, buf, fqn, size)
 	slab.Free(buf)
 	return err
 }
 
+// smaller objects are transmitted via (user-space) buffer copy
+const zeroCopyMinSize = 64 * cos.KiB
+
+// whether to transmit via sendfile(2) or splice(2), whereby the kernel moves
+// file pages directly to the socket; requires:
+// - whole object stored as a plain file (not chunked, packed, or compressed);
+// - plain HTTP (no TLS);
+// - response writer that can use the underlying connection's io.ReaderFrom
+// (any configured checksum validation is done prior to transmitting - see validateRecover)
+// returns the file to transmit or nil
+func (goi *getOI) zeroCopy(lmfh cos.LomReader, size int64) *os.File {
+	if size < zeroCopyMinSize {
+		return nil
+	}
+	if _, ok := goi.w.(io.ReaderFrom); !ok {
+		return nil
+	}
+	if goi.req != nil && goi.req.TLS != nil {
+		return nil
+	}
+	switch fh := lmfh.(type) {
+	case *os.File:
+		return fh
+	case *uring.File:
+		return fh.OSFile() // (io_uring has nothing to add here)
+	default:
+		return nil
+	}
+}
+
 // TODO: checksum
 func (goi *getOI) _txarch(fqn string, lmfh cos.LomReader, whdr http.Header) error {
 	var (
@@ -1189,10 +1394,23 @@ func (goi *getOI) _txarch(fqn string, lmfh cos.LomReader, whdr http.Header) erro
 
 func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string, size int64) error {
 	var (
-		errTx error
-		lom   = goi.lom
+		errTx   error
+		err     error
+		written int64
+		lom     = goi.lom
+		started = mono.NanoTime()
 	)
-	written, err := cos.CopyBuffer(goi.w, r, buf)
+	if buf == nil {
+		// zero-copy (see zeroCopy above)
+		written, err = goi.w.(io.ReaderFrom).ReadFrom(r)
+		goi.rd = timedReader{r: r, n: written}
+		goi.phases.Write += mono.SinceNano(started)
+	} else {
+		goi.rd = timedReader{r: r}
+		written, err = cos.CopyBuffer(goi.w, &goi.rd, buf)
+		goi.phases.Read += goi.rd.elapsed
+		goi.phases.Write += mono.SinceNano(started) - goi.rd.elapsed
+	}
 	if err != nil || written != size {
 		errTx = goi._txerr(err, fqn /*lbget*/, written, size)
 	}
@@ -1270,12 +1488,15 @@ func (goi *getOI) stats(written int64) {
 		cos.NamedVal64{Name: stats.GetThroughput, Value: written, VarLabs: vlabs}, // vis-à-vis user (as written m.b. range)
 		cos.NamedVal64{Name: stats.GetLatency, Value: delta, VarLabs: vlabs},      // see also: per-backend *LatencyTotal below
 		cos.NamedVal64{Name: stats.GetLatencyTotal, Value: delta, VarLabs: vlabs}, // ditto
+		cos.NamedVal64{Name: stats.GetLatencyHist, Value: delta},
 	)
+	goi.t.statsT.AddBckTraffic(stats.TrafficGet, bck.Bucket(), goi.dpq.user, written)
 
 	if !goi.rget {
 		debug.Assert(!goi.verchanged)
 		return
 	}
+	goi.t.statsT.Add(stats.GetColdLatencyHist, delta)
 
 	backend := goi.t.Backend(bck)
 	if !fl.IsSet(feat.EnableDetailedPromMetrics) {
@@ -1378,6 +1599,9 @@ func (a *apndOI) do(r *http.Request) (packedHdl string, ecode int, err error) {
 
 	switch a.op {
 	case apc.AppendOp:
+		if err = quota.Check(a.lom.Bck(), a.size, quota.Prev(a.lom, false /*locked*/)); err != nil {
+			return "", http.StatusInsufficientStorage, err
+		}
 		buf, slab := a.t.gmm.Alloc()
 		packedHdl, err = a.apnd(buf)
 		slab.Free(buf)
@@ -1400,7 +1624,7 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, err error) {
 		workFQN = fs.CSM.Gen(a.lom, fs.WorkfileType, fs.WorkfileAppend)
 		a.lom.Lock(false)
 		if a.lom.Load(false /*cache it*/, false /*locked*/) == nil {
-			_, a.hdl.partialCksum, err = cos.CopyFile(a.lom.FQN, workFQN, buf, a.lom.CksumType())
+			_, a.hdl.partialCksum, err = a.lom.CopyWhole(workFQN, buf, a.lom.CksumType())
 			a.lom.Unlock(false)
 			if err != nil {
 				return "", err
@@ -1434,6 +1658,7 @@ func (a *apndOI) apnd(buf []byte) (packedHdl string, err error) {
 	a.t.statsT.IncWith(stats.AppendCount, vlabs)
 	a.t.statsT.AddWith(
 		cos.NamedVal64{Name: stats.AppendLatency, Value: lat, VarLabs: vlabs},
+		cos.NamedVal64{Name: stats.AppendLatencyHist, Value: lat},
 	)
 	if cmn.Rom.FastV(4, cos.SmoduleAIS) {
 		nlog.Infoln("APPEND", a.lom.String(), time.Duration(lat))
@@ -1733,7 +1958,7 @@ func (coi *coi) _regular(t *target, lom, dst *core.LOM, lcopy bool) (res xs.CoiR
 	if res.Err = err; res.Err == nil {
 		res.Lsize = lom.Lsize()
 		if coi.Finalize {
-			t.putMirror(dst2)
+			t.putMirror(dst2, true /*overwrite*/)
 		}
 	}
 	if dst2 != nil {
@@ -1823,6 +2048,8 @@ func (coi *coi) put(t *target, sargs *sendArgs) error {
 	)
 	cmn.ToHeader(sargs.objAttrs, hdr, sargs.objAttrs.Lsize(true))
 	hdr.Set(apc.HdrT2TPutterID, t.SID())
+	hdr.Set(apc.HdrCallerID, t.SID())
+	hdr.Set(apc.HdrCallerName, t.si.Name())
 	query.Set(apc.QparamOWT, sargs.owt.ToS())
 	if coi.Xact != nil {
 		query.Set(apc.QparamUUID, coi.Xact.ID())
@@ -1864,7 +2091,7 @@ func (a *putA2I) do() (int, error) {
 	}
 	// standard library does not support appending to tgz, zip, and such;
 	// for TAR there is an optimizing workaround not requiring a full copy
-	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() {
+	if a.mime == archive.ExtTar && !a.put /*append*/ && !a.lom.IsChunked() && !a.lom.IsPacked() && !a.lom.IsCompressed() && !a.lom.IsDeduped() {
 		var (
 			err       error
 			fh        *os.File
@@ -2002,12 +2229,14 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 	if err := a.lom.Persist(); err != nil {
 		return err
 	}
+	quota.Put(a.lom, a.prev)
 	if a.lom.ECEnabled() {
 		if err := ec.ECM.EncodeObject(a.lom, nil); err != nil && err != ec.ErrorECDisabled {
 			return err
 		}
 	}
-	a.t.putMirror(a.lom)
+	a.t.putMirror(a.lom, true /*overwrite*/)
+	repl.Enqueue(a.lom.Bck(), a.lom.ObjName, repl.OpPut)
 	return nil
 }
 
@@ -2015,12 +2244,26 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 // put mirror (main)
 //
 
-func (t *target) putMirror(lom *core.LOM) {
+func (t *target) putMirror(lom *core.LOM, overwrite bool) {
 	mconfig := lom.MirrorConf()
 	if !mconfig.Enabled {
 		return
 	}
-	if mpathCnt := fs.NumAvail(); mpathCnt < int(mconfig.Copies) {
+	if mconfig.AcrossTargets() {
+		// only the owner replicates (see mirror/replicas.go)
+		if _, local, err := lom.HrwTarget(&t.owner.smap.get().Smap); err != nil || !local {
+			return
+		}
+		// replica holders serve their replicas without asking the owner; therefore,
+		// replicas of the previous version get removed before (asynchronously) replicating the new one
+		if overwrite {
+			mirror.DelReplicas(lom)
+		}
+	} else if lom.IsChunked() || lom.IsPacked() || lom.IsDeduped() {
+		// local copies of a chunked object would share its chunks (see core/lchunk.go);
+		// packed and deduplicated objects are not mirrored
+		return
+	} else if mpathCnt := fs.NumAvail(); mpathCnt < int(mconfig.Copies) {
 		// removed: inc stats.ErrPutMirrorCount
 		nanotim := mono.NanoTime()
 		if nanotim&0x7 == 7 {
@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"bytes"
 	"flag"
 	"io"
 	"net/http"
@@ -39,6 +40,12 @@ type (
 	discardRW struct {
 		w io.Writer
 	}
+	// (as in: http.ResponseWriter that can sendfile)
+	readFromRW struct {
+		discardRW
+		buf   bytes.Buffer
+		files int
+	}
 )
 
 func newDiscardRW() *discardRW {
@@ -51,6 +58,13 @@ func (drw *discardRW) Write(p []byte) (int, error) { return drw.w.Write(p) }
 func (*discardRW) Header() http.Header             { return make(http.Header) }
 func (*discardRW) WriteHeader(int)                 {}
 
+func (rw *readFromRW) ReadFrom(r io.Reader) (int64, error) {
+	if _, ok := r.(*os.File); ok {
+		rw.files++
+	}
+	return io.Copy(&rw.buf, r)
+}
+
 func TestMain(m *testing.M) {
 	flag.Parse()
 
@@ -90,6 +104,55 @@ func TestMain(m *testing.M) {
 	m.Run()
 }
 
+func TestObjGetZeroCopy(tt *testing.T) {
+	for _, size := range []int64{zeroCopyMinSize - 1, zeroCopyMinSize, cos.MiB + 3} {
+		lom := core.AllocLOM("zero-copy")
+		err := lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal})
+		if err != nil {
+			tt.Fatal(err)
+		}
+		r, _ := readers.NewRand(size, cos.ChecksumNone)
+		poi := &putOI{
+			atime:   time.Now().UnixNano(),
+			t:       t,
+			lom:     lom,
+			r:       r,
+			workFQN: path.Join(testMountpath, "zero-copy.work"),
+			config:  cmn.GCO.Get(),
+		}
+		if _, err := poi.putObject(); err != nil {
+			tt.Fatal(err)
+		}
+		if err := lom.Load(false, false); err != nil {
+			tt.Fatal(err)
+		}
+		expected, err := os.ReadFile(lom.FQN)
+		if err != nil {
+			tt.Fatal(err)
+		}
+
+		// with and without io.ReaderFrom
+		for _, w := range []http.ResponseWriter{&readFromRW{discardRW: discardRW{w: io.Discard}}, newDiscardRW()} {
+			goi := &getOI{atime: time.Now().UnixNano(), t: t, lom: lom, w: w, dpq: &dpq{}}
+			if _, err := goi.getObject(); err != nil {
+				tt.Fatal(err)
+			}
+			rw, ok := w.(*readFromRW)
+			if !ok {
+				continue
+			}
+			if zc := size >= zeroCopyMinSize; zc != (rw.files == 1) {
+				tt.Errorf("size %d: expected zero-copy=%t", size, zc)
+			}
+			if rw.files == 1 && !bytes.Equal(rw.buf.Bytes(), expected) {
+				tt.Errorf("size %d: content mismatch", size)
+			}
+		}
+		lom.RemoveMain()
+		core.FreeLOM(lom)
+	}
+}
+
 func BenchmarkObjPut(b *testing.B) {
 	benches := []struct {
 		fileSize int64
@@ -0,0 +1,298 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios"
+)
+
+// Read-ahead for sequential reads of numbered objects, see cmn.ReadAheadConf
+// - GET of the object N (e.g., shard-000123.tar) with read-ahead count k > 0 (bucket's
+//   `read_ahead.count` or apc.QparamReadAhead) warms up the objects N+1 through N+k
+//   (shard-000124.tar, ...), preserving leading zeros
+// - each of the next objects is handled by its HRW target: this one or another,
+//   via intra-cluster apc.ActReadAhead hint
+// - present object: ask the kernel to read it into page cache (ios.ReadAhead)
+// - remote bucket, object not present: cold-GET in the background by dedicated
+//   read-ahead workers (no per-request xaction)
+// - best effort: requests are dropped when the queue is full; objects warmed
+//   within the last `raRecent` are skipped; chunked, packed, and deduplicated
+//   objects are not warmed
+
+const (
+	raQueueSize   = 256
+	raWorkers     = 2
+	raColdWorkers = 4 // cold GETs
+	raRecent      = time.Minute
+	raMaxRecent   = 16 * 1024 // prune when exceeded
+)
+
+type (
+	raReq struct {
+		names []string
+		bck   cmn.Bck
+		hint  bool // from another target (local only)
+	}
+	raCold struct {
+		name string
+		bck  cmn.Bck
+	}
+	readAhead struct {
+		t      *target
+		workCh chan *raReq
+		coldCh chan *raCold
+		recent map[string]int64 // uname => mono time warmed
+		mu     sync.Mutex
+	}
+)
+
+func (ra *readAhead) init(t *target) {
+	ra.t = t
+	ra.workCh = make(chan *raReq, raQueueSize)
+	ra.coldCh = make(chan *raCold, raQueueSize)
+	ra.recent = make(map[string]int64, 256)
+	for range raWorkers {
+		go ra.run()
+	}
+	for range raColdWorkers {
+		go ra.runCold()
+	}
+}
+
+// GET: read-ahead count (request overrides bucket)
+func raCount(lom *core.LOM, dpq *dpq) int {
+	if dpq.readAhead == "" {
+		return lom.Bprops().ReadAhead.Count
+	}
+	k, err := strconv.Atoi(dpq.readAhead)
+	if err != nil || k <= 0 {
+		return 0
+	}
+	return min(k, cmn.MaxReadAhead)
+}
+
+func (ra *readAhead) get(lom *core.LOM, k int) {
+	names := raNames(lom.ObjName, k)
+	if len(names) == 0 {
+		return
+	}
+	ra.add(&raReq{names: names, bck: *lom.Bucket()})
+}
+
+func (ra *readAhead) add(req *raReq) {
+	select {
+	case ra.workCh <- req:
+	default:
+		if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Warningln(ra.t.String(), "read-ahead queue full, dropping", req.bck.Cname(req.names[0]))
+		}
+	}
+}
+
+// object names that follow the given one: the last number in the name (excluding
+// directory and extension) incremented by 1 through k, with leading zeros preserved
+func raNames(objName string, k int) []string {
+	var (
+		base = strings.LastIndexByte(objName, '/') + 1
+		end  = len(objName) - len(filepath.Ext(objName[base:]))
+	)
+	for end > base && !raDigit(objName[end-1]) {
+		end--
+	}
+	if end == base {
+		return nil
+	}
+	start := end - 1
+	for start > base && raDigit(objName[start-1]) {
+		start--
+	}
+	width := end - start
+	n, err := strconv.ParseUint(objName[start:end], 10, 64)
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, k)
+	for i := 1; i <= k; i++ {
+		names = append(names, objName[:start]+fmt.Sprintf("%0*d", width, n+uint64(i))+objName[end:])
+	}
+	return names
+}
+
+func raDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func (ra *readAhead) run() {
+	for req := range ra.workCh {
+		ra.do(req)
+	}
+}
+
+func (ra *readAhead) do(req *raReq) {
+	var (
+		smap   = ra.t.owner.smap.get()
+		remote map[string][]string // target ID => names
+	)
+	for _, name := range req.names {
+		lom := core.AllocLOM(name)
+		if err := lom.InitBck(&req.bck); err != nil {
+			core.FreeLOM(lom)
+			return
+		}
+		if !ra.fresh(lom.Uname()) {
+			core.FreeLOM(lom)
+			continue
+		}
+		tsi, local, err := lom.HrwTarget(&smap.Smap)
+		switch {
+		case err != nil:
+			core.FreeLOM(lom)
+			return
+		case local:
+			if ra.warm(lom) {
+				ra.addCold(&raCold{name: name, bck: req.bck})
+			}
+		case !req.hint:
+			if remote == nil {
+				remote = make(map[string][]string, 2)
+			}
+			remote[tsi.ID()] = append(remote[tsi.ID()], name)
+		}
+		core.FreeLOM(lom)
+	}
+	for tid, names := range remote {
+		if tsi := smap.GetTarget(tid); tsi != nil {
+			ra.t.raHint(tsi, &req.bck, names, smap)
+		}
+	}
+}
+
+// skip objects warmed (or hinted) within the last `raRecent`
+func (ra *readAhead) fresh(uname string) bool {
+	now := mono.NanoTime()
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+	if ts, ok := ra.recent[uname]; ok && now-ts < int64(raRecent) {
+		return false
+	}
+	if len(ra.recent) >= raMaxRecent {
+		for k, ts := range ra.recent {
+			if now-ts >= int64(raRecent) {
+				delete(ra.recent, k)
+			}
+		}
+	}
+	ra.recent[uname] = now
+	return true
+}
+
+// returns true if the object is to be cold-GET
+func (*readAhead) warm(lom *core.LOM) (cold bool) {
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		bck := lom.Bck()
+		return cmn.IsErrObjNought(err) && (bck.IsCloud() || bck.IsRemoteAIS())
+	}
+	if lom.IsChunked() || lom.IsPacked() || lom.IsDeduped() {
+		return false
+	}
+	fh, err := os.Open(lom.FQN)
+	if err != nil {
+		return false
+	}
+	if err := ios.ReadAhead(fh); err != nil && cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Warningln("read-ahead", lom.Cname(), "[", err, "]")
+	}
+	cos.Close(fh)
+	return false
+}
+
+func (ra *readAhead) addCold(c *raCold) {
+	select {
+	case ra.coldCh <- c:
+	default:
+		if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Warningln(ra.t.String(), "read-ahead cold-GET queue full, dropping", c.bck.Cname(c.name))
+		}
+	}
+}
+
+func (ra *readAhead) runCold() {
+	for c := range ra.coldCh {
+		ra.getCold(c)
+	}
+}
+
+func (ra *readAhead) getCold(c *raCold) {
+	if cs := fs.Cap(); cs.Err() != nil {
+		return
+	}
+	lom := core.AllocLOM(c.name)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(&c.bck); err != nil {
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err == nil {
+		return // (cold-GET in the meantime)
+	}
+	// (skip if being read or written)
+	_, err := ra.t.GetCold(context.Background(), lom, apc.ActReadAhead, cmn.OwtGetTryLock)
+	if err != nil && err != cmn.ErrSkip && cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Warningln(ra.t.String(), "read-ahead", lom.Cname(), "[", err, "]")
+	}
+}
+
+// hint the owning target
+func (t *target) raHint(tsi *meta.Snode, bck *cmn.Bck, names []string, smap *smapX) {
+	cargs := allocCargs()
+	{
+		cargs.si = tsi
+		cargs.req = cmn.HreqArgs{
+			Method: http.MethodPost,
+			Base:   tsi.URL(cmn.NetIntraControl),
+			Path:   apc.URLPathBuckets.Join(bck.Name),
+			Query:  bck.AddToQuery(nil),
+			Body:   cos.MustMarshal(t.newAmsgActVal(apc.ActReadAhead, names)),
+		}
+		cargs.timeout = cmn.Rom.CplaneOperation()
+	}
+	res := t.call(cargs, smap)
+	if res.err != nil && cmn.Rom.FastV(4, cos.SmoduleAIS) {
+		nlog.Warningln(t.String(), "read-ahead hint =>", tsi.StringEx(), "[", res.err, "]")
+	}
+	freeCargs(cargs)
+	freeCR(res)
+}
+
+// handle apc.ActReadAhead (hint from another target)
+func (t *target) raHinted(w http.ResponseWriter, r *http.Request, bck *meta.Bck, msg *actMsgExt) {
+	if err := t.checkIntraCall(r.Header, false /*from primary*/); err != nil {
+		t.writeErr(w, r, err)
+		return
+	}
+	var names []string
+	if err := cos.MorphMarshal(msg.Value, &names); err != nil {
+		t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+		return
+	}
+	if len(names) > 0 {
+		t.ra.add(&raReq{names: names, bck: *bck.Bucket(), hint: true})
+	}
+}
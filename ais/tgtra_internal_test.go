@@ -0,0 +1,45 @@
+// Package ais provides AIStore's proxy and target nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadAheadNames(t *testing.T) {
+	tests := []struct {
+		name     string
+		k        int
+		expected []string
+	}{
+		{"shard-000123.tar", 2, []string{"shard-000124.tar", "shard-000125.tar"}},
+		{"a/b/shard-0999.tar.gz", 2, []string{"a/b/shard-1000.tar.gz", "a/b/shard-1001.tar.gz"}},
+		{"train-7-of-10/img99", 1, []string{"train-7-of-10/img100"}},
+		{"data.2024.01.jpg", 1, []string{"data.2024.02.jpg"}},
+		{"v2/video.mp4", 3, nil},
+		{"dir9/noname", 1, nil},
+		{"99999999999999999999999.tar", 1, nil},
+	}
+	for _, test := range tests {
+		names := raNames(test.name, test.k)
+		if !reflect.DeepEqual(names, test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.name, test.expected, names)
+		}
+	}
+}
+
+func TestReadAheadRecent(t *testing.T) {
+	ra := &readAhead{recent: make(map[string]int64)}
+	if !ra.fresh("@ais#b/o1") {
+		t.Fatal("expected fresh")
+	}
+	if ra.fresh("@ais#b/o1") {
+		t.Fatal("expected recently warmed")
+	}
+	if !ra.fresh("@ais#b/o2") {
+		t.Fatal("expected fresh")
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
 )
 
 const fmtErrBckObj = "invalid %s request: expecting bucket and object (names) in the URL, have %v"
@@ -44,10 +45,19 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 
 	switch r.Method {
 	case http.MethodHead:
+		if t.notAdmitted(w, r, prioInteractive, true /*S3*/) {
+			return
+		}
 		t.headObjS3(w, r, apiItems)
 	case http.MethodGet:
+		if t.notAdmitted(w, r, prioInteractive, true) {
+			return
+		}
 		t.getObjS3(w, r, apiItems)
 	case http.MethodPut:
+		if t.notAdmitted(w, r, prioBatch, true) {
+			return
+		}
 		config := cmn.GCO.Get()
 		t.putCopyMpt(w, r, config, apiItems)
 	case http.MethodDelete:
@@ -58,6 +68,9 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
+		if t.notAdmitted(w, r, prioBatch, true) {
+			return
+		}
 		t.postObjS3(w, r, apiItems)
 	default:
 		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPost)
@@ -72,7 +85,7 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, config *cmn.
 		s3.WriteErr(w, r, cs.Err(), http.StatusInsufficientStorage)
 		return
 	}
-	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bck, ecode, err := meta.InitByNameNs(items[0], s3Ns(r), t.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -128,7 +141,7 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 		return
 	}
 	// src
-	bckSrc, ecode, err := meta.InitByNameOnly(parts[0], t.owner.bmd)
+	bckSrc, ecode, err := meta.InitByNameNs(parts[0], s3Ns(r), t.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -152,7 +165,7 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, config *cmn.C
 	}
 
 	// dst
-	bckTo, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bckTo, ecode, err := meta.InitByNameNs(items[0], s3Ns(r), t.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -203,6 +216,11 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 
 	// TODO: dual checksumming, e.g. lom.SetCustom(apc.AWS, ...)
 
+	// verify payload against (SigV4 signed) x-amz-content-sha256 - see s3.SigV4.Verify
+	if sha := r.Header.Get(cos.S3HdrContentSHA256); s3.IsPayloadHash(sha) {
+		r.Body = s3.NewPayloadReader(r.Body, sha, r.ContentLength)
+	}
+
 	dpq := dpqAlloc()
 	if err := dpq.parse(r.URL.RawQuery); err != nil {
 		s3.WriteErr(w, r, err, 0)
@@ -232,7 +250,7 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 // GET s3/<bucket-name[/<object-name>]
 func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bucket := items[0]
-	bck, ecode, err := meta.InitByNameOnly(bucket, t.owner.bmd)
+	bck, ecode, err := meta.InitByNameNs(bucket, s3Ns(r), t.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -290,7 +308,7 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 // See: https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadObject.html
 func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	bucket, objName := items[0], s3.ObjName(items)
-	bck, ecode, err := meta.InitByNameOnly(bucket, t.owner.bmd)
+	bck, ecode, err := meta.InitByNameNs(bucket, s3Ns(r), t.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -336,6 +354,9 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 
 	// set s3 response headers
 	s3.SetS3Headers(hdr, lom)
+	if exists && s3.IsCksumMode(r.Header) {
+		s3.SetCksumHeaders(hdr, lom)
+	}
 	hdr.Set(cos.HdrContentLength, strconv.FormatInt(op.Size, 10))
 	if v, ok := custom[cos.HdrContentType]; ok {
 		hdr.Set(cos.HdrContentType, v)
@@ -350,7 +371,7 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 
 // DELETE /s3/<bucket-name>/<object-name>
 func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string) {
-	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bck, ecode, err := meta.InitByNameNs(items[0], s3Ns(r), t.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -374,11 +395,12 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 	}
 	// EC cleanup if EC is enabled
 	ec.ECM.CleanupObject(lom)
+	t.statsT.AddBckTraffic(stats.TrafficDel, lom.Bucket(), queryUser(r.URL.Query()), 0)
 }
 
 // POST /s3/<bucket-name>/<object-name>
 func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []string) {
-	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bck, ecode, err := meta.InitByNameNs(items[0], s3Ns(r), t.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
@@ -402,3 +424,14 @@ func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		s3.QparamMptUploads, s3.QparamMptUploadID)
 	s3.WriteErr(w, r, err, 0)
 }
+
+// bucket namespace (if any) conveyed by the gateway (see proxy.s3Redirect);
+// S3 bucket names are otherwise resolved by name only (see meta.InitByNameOnly)
+func s3Ns(r *http.Request) *cmn.Ns {
+	uname := r.URL.Query().Get(apc.QparamNamespace)
+	if uname == "" {
+		return nil
+	}
+	ns := cmn.ParseNsUname(uname)
+	return &ns
+}
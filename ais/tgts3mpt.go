@@ -28,6 +28,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/quota"
 	"github.com/NVIDIA/aistore/stats"
 )
 
@@ -136,9 +137,19 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
+	if err := quota.Check(bck, r.ContentLength, -1 /*new part*/); err != nil {
+		s3.WriteErr(w, r, err, http.StatusInsufficientStorage)
+		return
+	}
 	// workfile name format: <upload-id>.<part-number>.<obj-name>
 	prefix := uploadID + "." + strconv.FormatInt(int64(partNum), 10)
 	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, prefix)
+	if lom.CanChunk() {
+		// place it on the mountpath of the corresponding chunk (see completeMpt)
+		if fqn, err := lom.ChunkWorkFQN(int(partNum), prefix); err == nil {
+			wfqn = fqn
+		}
+	}
 	partFh, errC := lom.CreatePart(wfqn)
 	if errC != nil {
 		s3.WriteMptErr(w, r, errC, 0, lom, uploadID)
@@ -229,6 +240,7 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		cos.NamedVal64{Name: stats.PutSize, Value: size, VarLabs: vlabs},
 		cos.NamedVal64{Name: stats.PutLatency, Value: delta, VarLabs: vlabs},
 		cos.NamedVal64{Name: stats.PutLatencyTotal, Value: delta, VarLabs: vlabs},
+		cos.NamedVal64{Name: stats.PutLatencyHist, Value: delta},
 	)
 	if remotePutLatency > 0 {
 		backendBck := t.Backend(bck)
@@ -236,6 +248,7 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 			cos.NamedVal64{Name: backendBck.MetricName(stats.PutSize), Value: size, VarLabs: vlabs},
 			cos.NamedVal64{Name: backendBck.MetricName(stats.PutLatencyTotal), Value: remotePutLatency, VarLabs: vlabs},
 			cos.NamedVal64{Name: backendBck.MetricName(stats.PutE2ELatencyTotal), Value: delta, VarLabs: vlabs},
+			cos.NamedVal64{Name: backendBck.MetricName(stats.PutLatencyHist), Value: remotePutLatency},
 		)
 	}
 }
@@ -326,28 +339,42 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	// 2. <upload-id>.complete.<obj-name>
 	prefix := uploadID + ".complete"
 	wfqn := fs.CSM.Gen(lom, fs.WorkfileType, prefix)
-	wfh, errC := lom.CreateWork(wfqn)
-	if errC != nil {
-		s3.WriteMptErr(w, r, errC, 0, lom, uploadID)
-		return
-	}
-	if remote && lom.CksumConf().Type != cos.ChecksumNone {
-		actualCksum = cos.NewCksumHash(lom.CksumConf().Type)
+
+	// .3 either rename parts into chunks (O(1) in size) or
+	// write all parts into a single workfile (see lom.CanChunk)
+	var (
+		ufest   *core.Ufest
+		written int64
+		errA    error
+	)
+	if lom.CanChunk() {
+		concatMD5, ufest, errA = _chunkMpt(lom, nparts, wfqn)
+		if ufest != nil {
+			written = ufest.Size
+		}
 	} else {
-		actualCksum = cos.NewCksumHash(cos.ChecksumMD5)
-	}
-	mw = multiWriter(actualCksum.H, wfh)
+		wfh, errC := lom.CreateWork(wfqn)
+		if errC != nil {
+			s3.WriteMptErr(w, r, errC, 0, lom, uploadID)
+			return
+		}
+		if remote && lom.CksumConf().Type != cos.ChecksumNone {
+			actualCksum = cos.NewCksumHash(lom.CksumConf().Type)
+		} else {
+			actualCksum = cos.NewCksumHash(cos.ChecksumMD5)
+		}
+		mw = multiWriter(actualCksum.H, wfh)
 
-	// .3 write
-	buf, slab := t.gmm.Alloc()
-	concatMD5, written, errA := _appendMpt(nparts, buf, mw)
-	slab.Free(buf)
+		buf, slab := t.gmm.Alloc()
+		concatMD5, written, errA = _appendMpt(nparts, buf, mw)
+		slab.Free(buf)
 
-	if lom.IsFeatureSet(feat.FsyncPUT) {
-		errS := wfh.Sync()
-		debug.AssertNoErr(errS)
+		if lom.IsFeatureSet(feat.FsyncPUT) {
+			errS := wfh.Sync()
+			debug.AssertNoErr(errS)
+		}
+		cos.Close(wfh)
 	}
-	cos.Close(wfh)
 
 	if errA == nil && written != size {
 		errA = fmt.Errorf("upload %q %q: expected full size=%d, got %d", uploadID, lom.Cname(), size, written)
@@ -356,12 +383,19 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		if nerr := cos.RemoveFile(wfqn); nerr != nil && !cos.IsNotExist(nerr) {
 			nlog.Errorf(fmtNested, t, errA, "remove", wfqn, nerr)
 		}
+		if ufest != nil {
+			if nerr := ufest.Remove(lom); nerr != nil {
+				nlog.Errorf(fmtNested, t, errA, "remove chunks of", lom.Cname(), nerr)
+			}
+			ufest.Done()
+		}
 		s3.WriteMptErr(w, r, errA, 0, lom, uploadID)
 		return
 	}
 
 	// .4 (s3 client => ais://) compute resulting MD5 and, optionally, ETag
-	if actualCksum.H != nil {
+	// (chunked: no object checksum - to be computed and stored upon first validation)
+	if actualCksum != nil && actualCksum.H != nil {
 		actualCksum.Finalize()
 		lom.SetCksum(actualCksum.Cksum.Clone())
 	}
@@ -394,6 +428,7 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 		poi.lom = lom
 		poi.workFQN = wfqn
 		poi.owt = cmn.OwtNone
+		poi.ufest = ufest
 	}
 	ecode, errF := poi.finalize()
 	freePOI(poi)
@@ -428,6 +463,25 @@ func (t *target) completeMpt(w http.ResponseWriter, r *http.Request, items []str
 	}
 }
 
+// rename parts into chunks and store the resulting manifest (see core/lchunk.go)
+func _chunkMpt(lom *core.LOM, nparts []*s3.MptPart, wfqn string) (concatMD5 string, ufest *core.Ufest, err error) {
+	ufest = core.NewUfest()
+	for _, partInfo := range nparts {
+		var cksum *cos.Cksum
+		if partInfo.MD5 != "" {
+			cksum = cos.NewCksum(cos.ChecksumMD5, partInfo.MD5)
+		}
+		concatMD5 += partInfo.MD5
+		if err = ufest.AddChunk(lom, partInfo.FQN, int(partInfo.Num), partInfo.Size, cksum); err != nil {
+			return "", ufest, err
+		}
+	}
+	if err = ufest.Store(lom, wfqn); err != nil {
+		return "", ufest, err
+	}
+	return concatMD5, ufest, nil
+}
+
 func _appendMpt(nparts []*s3.MptPart, buf []byte, mw io.Writer) (concatMD5 string, written int64, err error) {
 	for _, partInfo := range nparts {
 		var (
@@ -455,7 +509,7 @@ func _appendMpt(nparts []*s3.MptPart, buf []byte, mw io.Writer) (concatMD5 strin
 // 3. Remove all info from in-memory structs
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_AbortMultipartUpload.html
 func (t *target) abortMpt(w http.ResponseWriter, r *http.Request, items []string, q url.Values) {
-	bck, ecode, err := meta.InitByNameOnly(items[0], t.owner.bmd)
+	bck, ecode, err := meta.InitByNameNs(items[0], s3Ns(r), t.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, ecode)
 		return
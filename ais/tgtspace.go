@@ -135,6 +135,48 @@ func (t *target) runLRU(id string, wg *sync.WaitGroup, force bool, bcks ...cmn.B
 	space.RunLRU(&ini)
 }
 
+// tiered mountpaths: periodic demotion and promotion (see space/tier.go)
+func (t *target) hkTier(int64) time.Duration {
+	config := cmn.GCO.Get()
+	if config.Tier.Enabled() && fs.Tiered() && t.NodeStarted() && !t.res.IsActive(1) {
+		go t.runTier("" /*uuid*/, nil /*wg*/)
+	}
+	return config.Tier.IntervalX()
+}
+
+func (t *target) runTier(id string, wg *sync.WaitGroup) {
+	regToIC := id == ""
+	if regToIC {
+		id = cos.GenUUID()
+	}
+	rns := xreg.RenewTierMigrate(id)
+	if rns.Err != nil || rns.IsRunning() {
+		debug.Assert(rns.Err == nil || cmn.IsErrXactUsePrev(rns.Err))
+		if wg != nil {
+			wg.Done()
+		}
+		return
+	}
+	xtier := rns.Entry.Get()
+	if regToIC && xtier.ID() == id {
+		regMsg := xactRegMsg{UUID: id, Kind: apc.ActTierMigrate, Srcs: []string{t.SID()}}
+		msg := t.newAmsgActVal(apc.ActRegGlobalXaction, regMsg)
+		t.bcastAsyncIC(msg)
+	}
+	ini := space.IniTier{
+		Xaction:    xtier.(*space.XactTier),
+		Config:     cmn.GCO.Get(),
+		StatsT:     t.statsT,
+		GetFSStats: ios.GetFSStats,
+		WG:         wg,
+	}
+	xtier.AddNotif(&xact.NotifXact{
+		Base: nl.Base{When: core.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xtier,
+	})
+	space.RunTier(&ini)
+}
+
 func (t *target) runSpaceCleanup(xargs *xact.ArgsMsg, wg *sync.WaitGroup) fs.CapStatus {
 	var (
 		ctlmsg  string
@@ -305,7 +305,11 @@ func (t *target) validateMakeNCopies(bck *meta.Bck, msg *actMsgExt) (curCopies,
 	curCopies = bck.Props.Mirror.Copies
 	newCopies, err = _parseNCopies(msg.Value)
 	if err == nil {
-		err = fs.ValidateNCopies(t.si.Name(), int(newCopies))
+		if bck.Props.Mirror.AcrossTargets() {
+			err = t.validateNTargets(bck, newCopies)
+		} else {
+			err = fs.ValidateNCopies(t.si.Name(), int(newCopies))
+		}
 	}
 	// (consider adding "force" option similar to CopyBckMsg.Force)
 	if err == nil {
@@ -412,7 +416,14 @@ func (t *target) validateNprops(bck *meta.Bck, msg *actMsgExt) (nprops *cmn.Bpro
 		return
 	}
 	err = cs.Err()
-	if nprops.Mirror.Enabled {
+	if nprops.Mirror.Enabled && nprops.Mirror.AcrossTargets() {
+		if err = t.validateNTargets(bck, nprops.Mirror.Copies); err != nil {
+			return
+		}
+		if nprops.Mirror.Copies < bck.Props.Mirror.Copies {
+			err = nil
+		}
+	} else if nprops.Mirror.Enabled {
 		mpathCount := fs.NumAvail()
 		if int(nprops.Mirror.Copies) > mpathCount {
 			err = fmt.Errorf(fmtErrInsuffMpaths1, t, mpathCount, bck, nprops.Mirror.Copies)
@@ -428,6 +439,16 @@ func (t *target) validateNprops(bck *meta.Bck, msg *actMsgExt) (nprops *cmn.Bpro
 	return
 }
 
+// mirroring across targets: one copy per target
+func (t *target) validateNTargets(bck *meta.Bck, copies int64) error {
+	smap := t.owner.smap.get()
+	if cnt := smap.CountActiveTs(); int(copies) > cnt {
+		return fmt.Errorf("%s: number of active targets (%d) is insufficient to store %d copies of each object in %s",
+			t, cnt, copies, bck.Cname(""))
+	}
+	return nil
+}
+
 //
 // renameBucket
 //
@@ -129,6 +129,12 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 			t.writeErrf(w, r, "%v: %s", err, xargs.String())
 			return
 		}
+		if prio, ok := xprio(xargs.Kind); ok {
+			if err := t.admitCtrl(w, r, prio); err != nil {
+				t.writeErr(w, r, err, http.StatusServiceUnavailable)
+				return
+			}
+		}
 		if xargs.Kind == apc.ActPrefetchObjects {
 			ecode, err := t.runPrefetch(xargs.ID, bck, &apc.PrefetchMsg{})
 			if err != nil {
@@ -259,6 +265,14 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 		}
 		go t.runSpaceCleanup(args, wg)
 		wg.Wait()
+	case apc.ActTierMigrate:
+		if bck != nil {
+			nlog.Errorf(erfmb, args.Kind, bck)
+		}
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		go t.runTier(args.ID, wg)
+		wg.Wait()
 	case apc.ActResilver:
 		if bck != nil {
 			nlog.Errorf(erfmb, args.Kind, bck)
@@ -288,6 +302,16 @@ func (t *target) xstart(args *xact.ArgsMsg, bck *meta.Bck, msg *apc.ActMsg) (xid
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(args.ID, bck)
 		return xid, rns.Err
+	case apc.ActRecomputeCksum:
+		rns := xreg.RenewRecomputeCksum(args.ID, bck)
+		if rns.Err != nil || rns.IsRunning() {
+			return xid, rns.Err
+		}
+		xact.GoRunW(rns.Entry.Get())
+		return xid, nil
+	case apc.ActReplResync:
+		rns := xreg.RenewReplResync(args.ID, bck)
+		return xid, rns.Err
 	case apc.ActBlobDl:
 		debug.Assert(msg.Name != "")
 		lom := core.AllocLOM(msg.Name)
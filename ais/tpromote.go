@@ -5,6 +5,7 @@
 package ais
 
 import (
+	"net/http"
 	"os"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/quota"
 	"github.com/NVIDIA/aistore/xact/xs"
 )
 
@@ -65,6 +67,7 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 	if err := lom.Load(true /*cache it*/, false /*locked*/); err == nil && !params.OverwriteDst {
 		return -1, 0, nil
 	}
+
 	if params.DeleteSrc {
 		// To use `params.SrcFQN` as `workFQN`, make sure both are
 		// located on the same filesystem. About "filesystem sharing" see also:
@@ -118,6 +121,13 @@ func (t *target) _promLocal(params *core.PromoteParams, lom *core.LOM) (fileSize
 		}
 	}
 
+	if err := quota.Check(lom.Bck(), fileSize, quota.Prev(lom, false /*locked*/)); err != nil {
+		if extraCopy {
+			cos.RemoveFile(workFQN)
+		}
+		return 0, http.StatusInsufficientStorage, err
+	}
+
 	poi := allocPOI()
 	{
 		poi.atime = time.Now().UnixNano()
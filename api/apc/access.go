@@ -79,12 +79,17 @@ const (
 	AccessRW             = AccessRO | AcePUT | AceAPPEND | AceObjDELETE | AceObjMOVE
 	AllowReadWriteAccess = "rw"
 
+	// namespace (tenant) administrator: full control over the buckets in a given namespace
+	// (see also: authn.NsACL)
+	AccessNsAdmin      = AccessRW | AcePromote | AceObjUpdate | AcePATCH | AceBckSetACL | AceCreateBucket | AceDestroyBucket | AceAdmin
+	AllowNsAdminAccess = "ns-admin"
+
 	AccessNone = AccessAttrs(0)
 )
 
 // verbs
 func SupportedPermissions() []string {
-	accList := []string{"ro", "rw", "su"}
+	accList := []string{"ro", "rw", "su", "ns-admin"}
 	for _, v := range accessOp {
 		accList = append(accList, v)
 	}
@@ -179,6 +184,8 @@ func StrToAccess(accessStr string) (access AccessAttrs, err error) {
 		access |= AccessRW
 	case AllowAllAccess:
 		access = AccessAll
+	case AllowNsAdminAccess:
+		access |= AccessNsAdmin
 	case "":
 		access = AccessNone
 	default:
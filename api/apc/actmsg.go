@@ -37,6 +37,8 @@ const (
 
 	ActBlobDl = "blob-download"
 
+	ActReadAhead = "read-ahead" // intra-cluster: warm up the next objects (see QparamReadAhead)
+
 	ActMakeNCopies = "make-n-copies"
 	ActPutCopies   = "put-copies"
 
@@ -49,13 +51,16 @@ const (
 
 	ActLRU          = "lru"
 	ActStoreCleanup = "cleanup-store"
+	ActTierMigrate  = "tier-migrate" // tiered mountpaths: demote and promote objects (see space/tier.go)
 
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActList           = "list"
 	ActLoadLomCache   = "load-lom-cache"
+	ActRecomputeCksum = "recompute-cksum" // compute missing checksums (bucket's checksum.type and checksum.extra)
 	ActNewPrimary     = "new-primary"
 	ActPromote        = "promote"
 	ActRenameObject   = "rename-obj"
+	ActReplResync     = "repl-resync" // resync replicated bucket with its destination
 
 	// cp (reverse)
 	ActResetStats  = "reset-stats"
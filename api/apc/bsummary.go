@@ -30,9 +30,18 @@ type (
 		TotalSize struct {
 			OnDisk      uint64 `json:"size_on_disk,string"`          // sum(dir sizes) aka "apparent size"
 			PresentObjs uint64 `json:"size_all_present_objs,string"` // sum(cached object sizes)
+			StoredObjs  uint64 `json:"size_all_stored_objs,string"`  // ditto, as stored (less than the above when compressed or deduplicated)
+			DedupSaved  uint64 `json:"size_dedup_saved,string"`      // space saved by deduplication (shared content counted once)
 			RemoteObjs  uint64 `json:"size_all_remote_objs,string"`  // sum(all object sizes in a remote bucket)
 			Disks       uint64 `json:"total_disks_size,string"`
 		}
+		Quota struct {
+			Size     int64  `json:"quota_size,string"`         // limit (0: unlimited)
+			Objects  int64  `json:"quota_objects,string"`      // ditto
+			UsedSize int64  `json:"quota_used_size,string"`    // usage as tracked for quota enforcement
+			UsedObjs int64  `json:"quota_used_objects,string"` // ditto
+			UsedPct  uint64 `json:"quota_used_pct"`            // max(size, objects) usage as % of the respective limit
+		}
 		UsedPct      uint64 `json:"used_pct"`
 		IsBckPresent bool   `json:"is_present"` // in BMD
 	}
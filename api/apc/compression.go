@@ -22,3 +22,15 @@ var SupportedCompression = [...]string{CompressNever, CompressAlways}
 func IsValidCompression(c string) bool {
 	return c == "" || c == SupportedCompression[0] || c == SupportedCompression[1]
 }
+
+// compression at rest (bucket property `compress.algo`)
+const (
+	ObjComprZstd = "zstd"
+	ObjComprLZ4  = LZ4Compression
+)
+
+var SupportedObjCompr = [...]string{CompressNever, ObjComprZstd, ObjComprLZ4}
+
+func IsValidObjCompr(algo string) bool {
+	return algo == "" || algo == SupportedObjCompr[0] || algo == SupportedObjCompr[1] || algo == SupportedObjCompr[2]
+}
@@ -127,6 +127,11 @@ const (
 	// - implies remote backend
 	QparamLatestVer = "latest-ver" // Get latest version of objects from remote backend
 
+	// GET: read ahead this number of the next numbered objects (e.g., shard-000124.tar
+	// and so on, when reading shard-000123.tar); overrides bucket's `read_ahead.count`
+	// (and zero disables)
+	QparamReadAhead = "read-ahead"
+
 	// in addition to the latest-ver (above), also entails removing remotely
 	// deleted objects
 	QparamSync = "synchronize"
@@ -134,6 +139,10 @@ const (
 	// validate (ie., recompute and check) in-cluster object's checksums
 	QparamValidateCksum = "validate-checksum"
 
+	// GET and HEAD: return the object's checksum of this type (e.g., "sha256") - the primary
+	// or one of the additional checksums (bucket's `checksum.extra`), if available
+	QparamCksumType = "cksum-type"
+
 	// when true, skip nlog.Error and friends
 	// (to opt-out logging too many messages and/or benign warnings)
 	QparamSilent = "sln" // Suppress error logging and warnings
@@ -197,6 +206,9 @@ const (
 	QparamRebData          = "rbd" // true: get EC rebalance data (pulling data if push way fails)
 	QparamClusterInfo      = "cii" // true: /Health to return `cos.NodeStateInfo` including cluster metadata versions and state flags
 	QparamOWT              = "owt" // object write transaction enum { OwtPut, ..., OwtGet* }
+	QparamUser             = "usr" // authenticated user (principal) that made the redirected request
+	QparamUserSig          = "usg" // QparamUser signed by the redirecting proxy (see ais/htcommon)
+	QparamTraceparent      = "trp" // W3C trace context of the redirecting proxy (distributed tracing)
 
 	QparamTID = "tid" // designated target
 
@@ -241,6 +253,8 @@ const (
 
 	WhatMetricNames = "metrics"
 
+	WhatBckTraffic = "bck_traffic" // per-bucket (and per-user) traffic, see stats.BckTraffic
+
 	// assorted
 	WhatMountpaths = "mountpaths"
 	WhatRemoteAIS  = "remote"
@@ -248,6 +262,9 @@ const (
 	WhatSysInfo    = "sysinfo"
 	WhatTargetIPs  = "target_ips" // comma-separated list of all target IPs (compare w/ GetWhatSnode)
 
+	// rebalance dry-run (movement plan), see RebPlanMsg
+	WhatRebPlan = "reb_plan"
+
 	// log
 	WhatLog = "log"
 
@@ -259,8 +276,9 @@ const (
 	WhatAllRunningXacts = "running_all" // e.g. e.g.: put-copies[D-ViE6HEL_j] list[H96Y7bhR2s] ...
 
 	// internal
-	WhatSnode    = "snode"
-	WhatICBundle = "ic_bundle"
+	WhatSnode      = "snode"
+	WhatICBundle   = "ic_bundle"
+	WhatQuotaUsage = "quota_usage" // target's usage of a given bucket (see quota.Counters)
 
 	// tls
 	WhatCertificate = "tls_certificate"
@@ -0,0 +1,72 @@
+// Package apc: API control messages and constants
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package apc
+
+import "time"
+
+// rebalance dry-run: compute (but do not execute) the movement plan
+// for a given (hypothetical) cluster membership change
+type (
+	RebPlanMsg struct {
+		Add    []string `json:"add,omitempty"`    // IDs of the targets to join
+		Remove []string `json:"remove,omitempty"` // IDs of the targets to leave (decommission, maintenance, shutdown)
+	}
+
+	RebPlanCnt struct {
+		Objs  int64 `json:"objs,string"`
+		Bytes int64 `json:"bytes,string"`
+	}
+
+	RebPlan struct {
+		Sources map[string]*RebPlanCnt `json:"sources"` // per source target (ID)
+		Dests   map[string]*RebPlanCnt `json:"dests"`   // per destination target (ID)
+		Total   RebPlanCnt             `json:"total"`
+		// estimated time to complete, based on the max per-source bytes and `rebalance.bandwidth`;
+		// zero when bandwidth is not configured (unlimited)
+		EstTime    time.Duration `json:"est_time,omitempty"`
+		SmapVer    int64         `json:"smap_version,string"` // computed against this (current) cluster map
+		NumTargets int           `json:"num_targets"`         // in the post-change cluster map
+	}
+)
+
+func (cnt *RebPlanCnt) Add(objs, bytes int64) {
+	cnt.Objs += objs
+	cnt.Bytes += bytes
+}
+
+func (plan *RebPlan) Init() {
+	plan.Sources = make(map[string]*RebPlanCnt, 4)
+	plan.Dests = make(map[string]*RebPlanCnt, 4)
+}
+
+// add `src` => `dst` movement
+func (plan *RebPlan) Move(src, dst string, objs, bytes int64) {
+	if _, ok := plan.Sources[src]; !ok {
+		plan.Sources[src] = &RebPlanCnt{}
+	}
+	plan.Sources[src].Add(objs, bytes)
+	if _, ok := plan.Dests[dst]; !ok {
+		plan.Dests[dst] = &RebPlanCnt{}
+	}
+	plan.Dests[dst].Add(objs, bytes)
+	plan.Total.Add(objs, bytes)
+}
+
+// merge (per-target) plans
+func (plan *RebPlan) Merge(other *RebPlan) {
+	for src, cnt := range other.Sources {
+		if _, ok := plan.Sources[src]; !ok {
+			plan.Sources[src] = &RebPlanCnt{}
+		}
+		plan.Sources[src].Add(cnt.Objs, cnt.Bytes)
+	}
+	for dst, cnt := range other.Dests {
+		if _, ok := plan.Dests[dst]; !ok {
+			plan.Dests[dst] = &RebPlanCnt{}
+		}
+		plan.Dests[dst].Add(cnt.Objs, cnt.Bytes)
+	}
+	plan.Total.Add(other.Total.Objs, other.Total.Bytes)
+}
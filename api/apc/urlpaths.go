@@ -24,6 +24,7 @@ const (
 	Vote     = "vote"
 	S3       = "s3"
 	ML       = "ml"
+	Debug    = "debug" // diagnostics
 
 	// extensions
 	Download = "download" // downloader
@@ -48,6 +49,9 @@ const (
 	Users    = "users"
 	Clusters = "clusters"
 	Roles    = "roles"
+
+	S3Keys  = "s3keys"  // l3: /v1/users/<user-id>/s3keys
+	APIKeys = "apikeys" // l3: /v1/users/<user-id>/apikeys
 )
 
 // l3 ---
@@ -91,6 +95,9 @@ const (
 
 	// ML
 	Moss = "moss"
+
+	// diagnostics (target only)
+	SlowReqs = "slowreqs"
 )
 
 // common
@@ -166,6 +173,10 @@ var (
 	URLPathReverse    = urlpath(Version, Reverse)
 	URLPathReverseDae = urlpath(Version, Reverse, Daemon)
 
+	URLPathDebug           = urlpath(Version, Debug)
+	URLPathDebugSlowReqs   = urlpath(Version, Debug, SlowReqs)
+	URLPathReverseSlowReqs = urlpath(Version, Reverse, Debug, SlowReqs) // via p.reverseHandler
+
 	URLPathVote        = urlpath(Version, Vote)
 	URLPathVoteInit    = urlpath(Version, Vote, Init)
 	URLPathVoteProxy   = urlpath(Version, Vote, Proxy)
@@ -61,7 +61,8 @@ func DeleteUser(bp api.BaseParams, userID string) error {
 
 // Authorize a user and return a user token in case of success.
 // The token expires in `expire` time. If `expire` is `nil` the expiration
-// time is set by AuthN (default AuthN expiration time is 24 hours)
+// time is set by AuthN (default AuthN expiration time is 24 hours).
+// The returned refresh token can be used to obtain new tokens (see RefreshToken).
 func LoginUser(bp api.BaseParams, userID, pass string, expire *time.Duration) (token *TokenMsg, err error) {
 	bp.Method = http.MethodPost
 	rec := LoginMsg{Password: pass, ExpiresIn: expire}
@@ -259,6 +260,7 @@ func DeleteRole(bp api.BaseParams, role string) error {
 	return reqParams.DoRequest()
 }
 
+// Revoke token (including refresh token and API key token)
 func RevokeToken(bp api.BaseParams, token string) error {
 	bp.Method = http.MethodDelete
 	msg := &TokenMsg{Token: token}
@@ -273,6 +275,122 @@ func RevokeToken(bp api.BaseParams, token string) error {
 	return reqParams.DoRequest()
 }
 
+// Exchange refresh token (see LoginUser) for a new token that carries user's current permissions
+func RefreshToken(bp api.BaseParams, refreshToken string) (*TokenMsg, error) {
+	bp.Method = http.MethodPost
+	msg := &TokenMsg{RefreshToken: refreshToken}
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	token := &TokenMsg{}
+	if _, err := reqParams.DoReqAny(token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// Create named API key for the user. Optional scope restricts the key to the specified
+// buckets and permissions (the latter must be a subset of user's own); empty scope - all
+// user's current permissions. The key expires in `msg.ExpiresIn` time (`nil` - AuthN default, zero - never).
+// NOTE: the returned token cannot be retrieved later.
+func AddAPIKey(bp api.BaseParams, userID string, msg *APIKeyMsg) (*APIKey, error) {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.APIKeys)
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	key := &APIKey{}
+	if _, err := reqParams.DoReqAny(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func GetAPIKeys(bp api.BaseParams, userID string) ([]*APIKey, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.APIKeys)
+	}
+	var keys []*APIKey
+	_, err := reqParams.DoReqAny(&keys)
+	return keys, err
+}
+
+// Delete (and revoke) API key by ID
+func DeleteAPIKey(bp api.BaseParams, userID, id string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.APIKeys, id)
+	}
+	return reqParams.DoRequest()
+}
+
+// Create S3 access key for the user (the key inherits user's current permissions).
+// The key expires in `expire` time (`nil` - AuthN default, zero - never).
+// Optional namespace (tenant) is where S3 bucket names resolve to (see NsACL);
+// empty namespace is implied when all user's namespace ACLs refer to a single namespace.
+// NOTE: the returned secret key cannot be retrieved later.
+func AddS3Key(bp api.BaseParams, userID string, expire *time.Duration, namespace ...string) (*S3Key, error) {
+	msg := S3KeyMsg{ExpiresIn: expire}
+	if len(namespace) > 0 {
+		msg.Namespace = namespace[0]
+	}
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.S3Keys)
+		reqParams.Body = cos.MustMarshal(&msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	key := &S3Key{}
+	if _, err := reqParams.DoReqAny(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func GetS3Keys(bp api.BaseParams, userID string) ([]*S3Key, error) {
+	bp.Method = http.MethodGet
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.S3Keys)
+	}
+	var keys []*S3Key
+	_, err := reqParams.DoReqAny(&keys)
+	return keys, err
+}
+
+// Delete (and revoke) S3 access key
+func DeleteS3Key(bp api.BaseParams, userID, accessKey string) error {
+	bp.Method = http.MethodDelete
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathUsers.Join(userID, apc.S3Keys, accessKey)
+	}
+	return reqParams.DoRequest()
+}
+
 func GetConfig(bp api.BaseParams) (*Config, error) {
 	bp.Method = http.MethodGet
 	reqParams := api.AllocRp()
@@ -11,17 +11,21 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 )
 
+const DfltRefreshExpire = 7 * 24 * time.Hour
+
 type (
 	Config struct {
-		Log     LogConf     `json:"log"`
-		Net     NetConf     `json:"net"`
-		Server  ServerConf  `json:"auth"`
-		Timeout TimeoutConf `json:"timeout"`
+		Log     LogConf       `json:"log"`
+		Net     NetConf       `json:"net"`
+		Server  ServerConf    `json:"auth"`
+		Timeout TimeoutConf   `json:"timeout"`
+		Audit   cmn.AuditConf `json:"audit"` // (see cmn/audit)
 		// private
 		mu sync.RWMutex `json:"-"`
 	}
@@ -41,6 +45,8 @@ type (
 	ServerConf struct {
 		Secret string       `json:"secret"`
 		Expire cos.Duration `json:"expiration_time"`
+		// refresh token expiration time (see TokenMsg.RefreshToken); zero - default (DfltRefreshExpire)
+		RefreshExpire cos.Duration `json:"refresh_expiration_time"`
 		// private
 		psecret *string       `json:"-"`
 		pexpire *cos.Duration `json:"-"`
@@ -54,11 +60,16 @@ type (
 	ServerConfToSet struct {
 		Secret *string `json:"secret,omitempty"`
 		Expire *string `json:"expiration_time,omitempty"`
+
+		RefreshExpire *string `json:"refresh_expiration_time,omitempty"`
 	}
-	// TokenList is a list of tokens pushed by authn
+	// TokenList is a list of revoked tokens pushed by authn:
+	// tokens that have IDs (see APIKey) are revoked by ID
 	TokenList struct {
 		Tokens  []string `json:"tokens"`
 		Version int64    `json:"version,string"`
+
+		IDs map[string]int64 `json:"ids,omitempty"` // token ID => token expiration time (Unix seconds)
 	}
 )
 
@@ -88,6 +99,13 @@ func (c *Config) Verbose() bool {
 func (c *Config) Secret() string        { return *c.Server.psecret }
 func (c *Config) Expire() time.Duration { return time.Duration(*c.Server.pexpire) }
 
+func (c *Config) RefreshExpire() time.Duration {
+	if c.Server.RefreshExpire == 0 {
+		return DfltRefreshExpire
+	}
+	return time.Duration(c.Server.RefreshExpire)
+}
+
 func (c *Config) SetSecret(val *string) {
 	c.Server.Secret = *val
 	c.Server.psecret = val
@@ -112,5 +130,15 @@ func (c *Config) ApplyUpdate(cu *ConfigToUpdate) error {
 		c.Server.Expire = v
 		c.Server.pexpire = &v
 	}
+	if cu.Server.RefreshExpire != nil {
+		dur, err := time.ParseDuration(*cu.Server.RefreshExpire)
+		if err != nil {
+			return fmt.Errorf("invalid time format %s: %v", *cu.Server.RefreshExpire, err)
+		}
+		if dur < 0 {
+			return fmt.Errorf("invalid refresh token expiration time %v", dur)
+		}
+		c.Server.RefreshExpire = cos.Duration(dur)
+	}
 	return nil
 }
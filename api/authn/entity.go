@@ -35,8 +35,21 @@ type (
 		Access apc.AccessAttrs `json:"perm,string"`
 	}
 
+	// namespace-scoped permissions: all buckets in a given (local) bucket namespace, aka tenant;
+	// same as BckACL, Ns.UUID is the cluster ID (empty - any cluster), and Ns.Name is the namespace
+	// - users that have namespace ACLs see only their own namespaces when listing buckets
+	// - namespace ACL that includes apc.AceAdmin (see apc.AccessNsAdmin) designates namespace admin
+	NsACL struct {
+		Ns     cmn.Ns          `json:"ns"`
+		Access apc.AccessAttrs `json:"perm,string"`
+	}
+
 	TokenMsg struct {
 		Token string `json:"token"`
+
+		// login only: long-lived token to obtain new (access) tokens without re-entering credentials;
+		// cannot be used to access AIS clusters (see RefreshToken)
+		RefreshToken string `json:"refresh_token,omitempty"`
 	}
 
 	LoginMsg struct {
@@ -44,6 +57,38 @@ type (
 		ExpiresIn *time.Duration `json:"expires_in"`
 	}
 
+	// S3 access key ID and secret key (the latter - only upon creation)
+	S3Key struct {
+		Expires   time.Time `json:"expires"`
+		AccessKey string    `json:"access_key"`
+		SecretKey string    `json:"secret_key,omitempty"`
+		UserID    string    `json:"user"`
+		Namespace string    `json:"namespace,omitempty"` // tenant: S3 bucket names resolve to this namespace
+	}
+	S3KeyMsg struct {
+		ExpiresIn *time.Duration `json:"expires_in"`
+		Namespace string         `json:"namespace,omitempty"` // (optional) tenant; see NsACL
+	}
+
+	// named API key: non-interactive (e.g., CI) bearer token that is
+	// - scoped to the specified buckets and permissions (empty scope: all user's permissions)
+	// - revocable by ID
+	// the token itself is returned only upon creation
+	APIKey struct {
+		Created time.Time `json:"created"`
+		Expires time.Time `json:"expires"`
+		ID      string    `json:"id"`
+		Name    string    `json:"name"`
+		UserID  string    `json:"user"`
+		Token   string    `json:"token,omitempty"`
+		Scope   []*BckACL `json:"scope,omitempty"`
+	}
+	APIKeyMsg struct {
+		ExpiresIn *time.Duration `json:"expires_in"`
+		Name      string         `json:"name"`
+		Scope     []*BckACL      `json:"scope,omitempty"`
+	}
+
 	RegisteredClusters struct {
 		Clusters map[string]*CluACL `json:"clusters,omitempty"`
 	}
@@ -54,6 +99,8 @@ type (
 		ClusterACLs []*CluACL `json:"clusters"`
 		BucketACLs  []*BckACL `json:"buckets"`
 		IsAdmin     bool      `json:"admin"`
+
+		NamespaceACLs []*NsACL `json:"namespaces,omitempty"`
 	}
 )
 
@@ -87,6 +134,18 @@ func (clu *CluACL) String() string {
 	return uuid
 }
 
+///////////
+// NsACL //
+///////////
+
+func (acl *NsACL) String() string {
+	s := acl.Ns.Name
+	if acl.Ns.UUID != "" {
+		s += "[" + acl.Ns.UUID + "]"
+	}
+	return s
+}
+
 //////////////
 // TokenMsg //
 //////////////
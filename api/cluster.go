@@ -106,6 +106,28 @@ func GetNodeClusterMap(bp BaseParams, sid string) (smap *meta.Smap, err error) {
 	return smap, err
 }
 
+// get node info from a BaseParams-referenced node
+// (e.g., a node that's about to join and is not yet present in the cluster map)
+func GetSnode(bp BaseParams) (snode *meta.Snode, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatSnode)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathDae.S
+		reqParams.Query = q
+	}
+
+	snode = &meta.Snode{}
+	_, err = reqParams.DoReqAny(snode)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return snode, err
+}
+
 // get bucket metadata (BMD) from a BaseParams-referenced node
 func GetBMD(bp BaseParams) (bmd *meta.BMD, err error) {
 	q := qalloc()
@@ -491,6 +513,31 @@ func membership(bp BaseParams, action string, actValue *apc.ActValRmNode) (xid s
 	return xid, err
 }
 
+// RebalancePlan (a.k.a. rebalance dry-run) computes the number of objects and bytes
+// that would migrate between targets upon the specified membership change, without
+// changing anything
+func RebalancePlan(bp BaseParams, msg *apc.RebPlanMsg) (plan *apc.RebPlan, err error) {
+	q := qalloc()
+	q.Set(apc.QparamWhat, apc.WhatRebPlan)
+
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = q
+	}
+
+	plan = &apc.RebPlan{}
+	_, err = reqParams.DoReqAny(plan)
+
+	FreeRp(reqParams)
+	qfree(q)
+	return plan, err
+}
+
 // StartMaintenance puts a node into maintenance mode
 func StartMaintenance(bp BaseParams, actValue *apc.ActValRmNode) (xid string, err error) {
 	return membership(bp, apc.ActStartMaintenance, actValue)
@@ -26,6 +26,9 @@ const (
 	// From https://cloud.google.com/storage/quotas#objects
 	// * "There is an update limit on each object of once per second..."
 	httpRetryRateSleep = 1500 * time.Millisecond
+
+	// max sleep when honoring server-provided Retry-After (e.g., 503 from overloaded target)
+	httpRetryAfterMax = 30 * time.Second
 )
 
 // GET(object)
@@ -736,7 +739,9 @@ func CheckObjectLock(bp BaseParams, bck cmn.Bck, objName string) (int, error) {
 //
 
 // DoWithRetry executes `http-client.Do` and retries *retriable connection errors*,
-// such as "broken pipe" and "connection refused".
+// such as "broken pipe" and "connection refused", as well as 429 and 503 (the latter
+// only when carrying Retry-After, see target's admission control).
+// Retry-After, if present, determines the time to sleep before the next retry.
 // This function always closes the `reqArgs.BodR`, even in case of error.
 // Usage: PUT and similar requests that transfer payload from the user side.
 // NOTE: always closes request body reader (reqArgs.BodyR) - explicitly or via Do()
@@ -761,15 +766,12 @@ func DoWithRetry(client *http.Client, cb newRequestCB, reqArgs *cmn.HreqArgs) (r
 	if !_retry(doErr, resp) {
 		goto exit
 	}
-	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
-		sleep = httpRetryRateSleep
-	}
+	sleep = _sleep(resp, sleep)
 
 	// retry
 	for range httpMaxRetries {
 		var r io.ReadCloser
 		time.Sleep(sleep)
-		sleep += sleep / 2
 		if r, err = reader.Open(); err != nil {
 			_close(resp, doErr)
 			return resp, err
@@ -787,6 +789,7 @@ func DoWithRetry(client *http.Client, cb newRequestCB, reqArgs *cmn.HreqArgs) (r
 		if !_retry(doErr, resp) {
 			goto exit
 		}
+		sleep = _sleep(resp, sleep+sleep/2)
 	}
 exit:
 	if err == nil {
@@ -806,8 +809,34 @@ func _close(resp *http.Response, doErr error) {
 }
 
 func _retry(err error, resp *http.Response) bool {
-	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
-		return true
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests:
+			return true
+		case http.StatusServiceUnavailable:
+			return resp.Header.Get(cos.HdrRetryAfter) != ""
+		}
 	}
 	return err != nil && cos.IsRetriableConnErr(err)
 }
+
+// Retry-After (delay-seconds or HTTP-date) takes precedence
+func _sleep(resp *http.Response, sleep time.Duration) time.Duration {
+	if resp == nil {
+		return sleep
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		sleep = max(sleep, httpRetryRateSleep)
+	}
+	v := resp.Header.Get(cos.HdrRetryAfter)
+	if v == "" {
+		return sleep
+	}
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil && secs >= 0 {
+		return time.Duration(min(secs, int64(httpRetryAfterMax/time.Second))) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return min(max(time.Until(t), 0), httpRetryAfterMax)
+	}
+	return sleep
+}
@@ -61,6 +61,20 @@ func GetClusterStats(bp BaseParams) (res stats.Cluster, err error) {
 	return
 }
 
+// per-bucket (and per-user) traffic, summed up over all targets
+func GetClusterBckTraffic(bp BaseParams) (out stats.BckTrafficList, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Query = url.Values{apc.QparamWhat: []string{apc.WhatBckTraffic}}
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return out, err
+}
+
 //
 // node ----------------------
 //
@@ -93,6 +107,27 @@ func GetStatsAndStatus(bp BaseParams, node *meta.Snode) (ds *stats.NodeStatus, e
 	return ds, err
 }
 
+// per-bucket (and per-user) traffic of a given target
+func GetBckTraffic(bp BaseParams, node *meta.Snode) (out stats.BckTrafficList, err error) {
+	err = anyStats(bp, node.ID(), apc.WhatBckTraffic, &out)
+	return out, err
+}
+
+// slow GET requests recently observed by a given target, most recent first
+// (see `log.slow_request_time` config)
+func GetSlowRequests(bp BaseParams, node *meta.Snode) (out stats.SlowReqList, err error) {
+	bp.Method = http.MethodGet
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathReverseSlowReqs.S // NOTE: reverse, via p.reverseHandler
+		reqParams.Header = http.Header{apc.HdrNodeID: []string{node.ID()}}
+	}
+	_, err = reqParams.DoReqAny(&out)
+	FreeRp(reqParams)
+	return out, err
+}
+
 func GetAnyStats(bp BaseParams, sid, what string) (out []byte, err error) {
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
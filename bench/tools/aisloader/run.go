@@ -92,6 +92,7 @@ type (
 		minSizeStr           string
 		proxyURL             string
 		bPropsStr            string
+		ioEngine             string // cluster-wide disk.io_engine for the duration of the run
 		tokenFile            string
 		cksumType            string
 		statsOutput          string
@@ -283,6 +284,14 @@ func Start(version, buildtime string) (err error) {
 		}
 	}
 
+	if runParams.ioEngine != "" && !runParams.getConfig {
+		restore, err := setIOEngine(runParams)
+		if err != nil {
+			return err
+		}
+		defer restore()
+	}
+
 	if isDirectS3() {
 		if err := initS3Svc(); err != nil {
 			return err
@@ -559,6 +568,7 @@ func addCmdLine(f *flag.FlagSet, p *params) {
 	f.BoolVar(&p.statsdProbe, "test-probe StatsD server prior to benchmarks", false, "when enabled probes StatsD server prior to running")
 	f.IntVar(&p.batchSize, "batchsize", 100, "batch size to list and delete")
 	f.StringVar(&p.bPropsStr, "bprops", "", "JSON string formatted as per the SetBucketProps API and containing bucket properties to apply")
+	f.StringVar(&p.ioEngine, "io-engine", "", "target disk I/O engine for the duration of the run: \"sync\" or \"io_uring\" (default: cluster config, unchanged)")
 	f.Int64Var(&p.seed, "seed", 0, "random seed to achieve deterministic reproducible results (0 - use current time in nanoseconds)")
 	f.BoolVar(&p.jsonFormat, "json", false, "when true, print output in JSON format")
 	f.StringVar(&p.readOffStr, "readoff", "", "read range offset (can contain multiplicative suffix K, MB, GiB, etc.)")
@@ -731,6 +741,9 @@ func _init(p *params) (err error) {
 		if p.verifyHash {
 			return errors.New("direct S3 access via '-s3endpoint': '-verifyhash' option is not supported yet")
 		}
+		if p.ioEngine != "" {
+			return errors.New("direct S3 access via '-s3endpoint': '-io-engine' option is not supported")
+		}
 		if p.readOffStr != "" || p.readLenStr != "" {
 			return errors.New("direct S3 access via '-s3endpoint': Read range is not supported yet")
 		}
@@ -842,6 +855,12 @@ func _init(p *params) (err error) {
 		}
 	}
 
+	switch p.ioEngine {
+	case "", cmn.IOEngineSync, cmn.IOEngineUring:
+	default:
+		return fmt.Errorf("invalid '-io-engine' %q (expecting %q or %q)", p.ioEngine, cmn.IOEngineSync, cmn.IOEngineUring)
+	}
+
 	if p.bPropsStr != "" {
 		var bprops cmn.Bprops
 		jsonStr := strings.TrimRight(p.bPropsStr, ",")
@@ -1075,6 +1094,31 @@ func setupBucket(runParams *params, created *bool) error {
 	return nil
 }
 
+// set cluster-wide (transient) disk.io_engine for the duration of the run
+func setIOEngine(p *params) (restore func(), _ error) {
+	const key = "disk.io_engine"
+	config, err := api.GetClusterConfig(p.bp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster config: %v", err)
+	}
+	prev := config.Disk.IOEngine
+	if prev == "" {
+		prev = cmn.IOEngineSync
+	}
+	if prev == p.ioEngine {
+		return func() {}, nil
+	}
+	if err := api.SetClusterConfig(p.bp, cos.StrKVs{key: p.ioEngine}, true /*transient*/); err != nil {
+		return nil, fmt.Errorf("failed to set %s=%s: %v", key, p.ioEngine, err)
+	}
+	fmt.Printf("%s: %s => %s\n", key, prev, p.ioEngine)
+	return func() {
+		if err := api.SetClusterConfig(p.bp, cos.StrKVs{key: prev}, true /*transient*/); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to restore %s=%s: %v\n", key, prev, err)
+		}
+	}, nil
+}
+
 func getIDFromString(val string, hashLen uint) uint64 {
 	hash := onexxh.Checksum64S(cos.UnsafeB(val), cos.MLCG32)
 	// keep just the loaderIDHashLen bytes
@@ -43,9 +43,8 @@ func (m *mgr) validateSecret(clu *authn.CluACL) (err error) {
 	return err
 }
 
-// update list of revoked token on all clusters
-func (m *mgr) broadcastRevoked(token string) {
-	tokenList := authn.TokenList{Tokens: []string{token}}
+// update list of revoked tokens (and token IDs) on all clusters
+func (m *mgr) broadcastRevoked(tokenList *authn.TokenList) {
 	body := cos.MustMarshal(tokenList)
 	m.broadcast(http.MethodDelete, apc.Tokens, body, "broadcast-revoked")
 }
@@ -85,10 +84,10 @@ func (m *mgr) syncTokenList(clu *authn.CluACL) {
 		nlog.Errorf("failed to sync token list with %q(%q): %v (%d)", clu.ID, clu.Alias, err, code)
 		return
 	}
-	if len(tokenList) == 0 {
+	if len(tokenList.Tokens) == 0 && len(tokenList.IDs) == 0 {
 		return
 	}
-	body := cos.MustMarshal(authn.TokenList{Tokens: tokenList})
+	body := cos.MustMarshal(tokenList)
 	for _, u := range clu.URLs {
 		if err = m.call(http.MethodDelete, u, apc.Tokens, body, tag); err == nil {
 			break
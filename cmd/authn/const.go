@@ -17,6 +17,11 @@ const (
 	rolesCollection    = "role"
 	revokedCollection  = "revoked"
 	clustersCollection = "cluster"
+	s3keysCollection   = "s3key"
+
+	refreshCollection    = "refresh"
+	apikeysCollection    = "apikey"
+	revokedIDsCollection = "revoked-id"
 
 	adminUserID   = "admin"
 	adminUserPass = "admin"
@@ -24,4 +29,7 @@ const (
 	// when user-provided token expiration time is zero it means the token never expires;
 	// we then create a token and set it to expire in 20 years - effectively, never
 	foreverTokenTime = 20 * 365 * 24 * time.Hour
+
+	// how often to remove expired records (see mgr.housekeep)
+	compactInterval = time.Hour
 )
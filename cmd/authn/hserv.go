@@ -16,7 +16,9 @@ import (
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/audit"
 	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 
 	jsoniter "github.com/json-iterator/go"
@@ -107,6 +109,7 @@ func (h *hserv) Run() error {
 }
 
 func (h *hserv) registerHandler(path string, handler func(http.ResponseWriter, *http.Request)) {
+	handler = audited(handler)
 	h.mux.HandleFunc(path, handler)
 	if !cos.IsLastB(path, '/') {
 		h.mux.HandleFunc(path+"/", handler)
@@ -121,6 +124,31 @@ func (h *hserv) registerPublicHandlers() {
 	h.registerHandler(apc.URLPathDae.S, configHandler)
 }
 
+// audit trail: users, roles, tokens, and clusters (see cmn/audit)
+func audited(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !Conf.Audit.Enabled {
+			h(w, r)
+			return
+		}
+		var (
+			aw      = &audit.Writer{ResponseWriter: w}
+			rec     = &audit.Record{Time: time.Now(), Node: svcName, Remote: r.RemoteAddr, Method: r.Method, Path: r.URL.Path}
+			started = mono.NanoTime()
+		)
+		h(aw, r)
+		rec.Latency = mono.Since(started)
+		rec.Status = aw.StatusCode()
+		if tk, err := getToken(r); err == nil {
+			rec.User = tk.UserID
+		} else if items, err := cmn.ParseURL(r.URL.Path, apc.URLPathUsers.L, 1, false); err == nil &&
+			r.Method == http.MethodPost && len(items) == 1 {
+			rec.User, rec.Action = items[0], "login"
+		}
+		audit.Log(rec)
+	}
+}
+
 func (h *hserv) userHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodDelete:
@@ -140,8 +168,10 @@ func (h *hserv) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodDelete:
 		h.httpRevokeToken(w, r)
+	case http.MethodPost:
+		h.httpRefreshToken(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodPost)
 	}
 }
 
@@ -169,16 +199,40 @@ func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 	if err := cmn.ReadJSON(w, r, msg); err != nil {
 		return
 	}
-	if msg.Token == "" {
+	if msg.Token == "" && msg.RefreshToken == "" {
 		cmn.WriteErrMsg(w, r, "empty token")
 		return
 	}
-	secret := Conf.Secret()
-	if _, err := tok.DecryptToken(msg.Token, secret); err != nil {
-		cmn.WriteErr(w, r, err)
+	for _, token := range []string{msg.Token, msg.RefreshToken} {
+		if token == "" {
+			continue
+		}
+		if code, err := h.mgr.revokeToken(token); err != nil {
+			cmn.WriteErr(w, r, err, code)
+			return
+		}
+	}
+}
+
+// Exchanges refresh token for a new token
+func (h *hserv) httpRefreshToken(w http.ResponseWriter, r *http.Request) {
+	if _, err := parseURL(w, r, 0, apc.URLPathTokens.L); err != nil {
 		return
 	}
-	h.mgr.revokeToken(msg.Token)
+	msg := &authn.TokenMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	if msg.RefreshToken == "" {
+		cmn.WriteErrMsg(w, r, "empty refresh token", http.StatusUnauthorized)
+		return
+	}
+	repl, code, err := h.mgr.refresh(msg.RefreshToken)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	writeJSON(w, repl, "refresh token")
 }
 
 func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
@@ -186,6 +240,14 @@ func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if len(apiItems) > 1 && apiItems[1] == apc.S3Keys {
+		h.s3KeyDel(w, r, apiItems)
+		return
+	}
+	if len(apiItems) > 1 && apiItems[1] == apc.APIKeys {
+		h.apiKeyDel(w, r, apiItems)
+		return
+	}
 	if err = validateAdminPerms(w, r); err != nil {
 		return
 	}
@@ -200,9 +262,14 @@ func (h *hserv) httpUserPost(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if len(apiItems) == 0 {
+	switch {
+	case len(apiItems) == 0:
 		h.userAdd(w, r)
-	} else {
+	case len(apiItems) == 2 && apiItems[1] == apc.S3Keys:
+		h.s3KeyAdd(w, r, apiItems[0])
+	case len(apiItems) == 2 && apiItems[1] == apc.APIKeys:
+		h.apiKeyAdd(w, r, apiItems[0])
+	default:
 		h.userLogin(w, r)
 	}
 }
@@ -235,13 +302,13 @@ func (h *hserv) httpUserPut(w http.ResponseWriter, r *http.Request) {
 
 // Adds h new user to user list
 func (h *hserv) userAdd(w http.ResponseWriter, r *http.Request) {
-	if err := validateAdminPerms(w, r); err != nil {
-		return
-	}
 	info := &authn.User{}
 	if err := cmn.ReadJSON(w, r, info); err != nil {
 		return
 	}
+	if err := validateNsAdminPerms(w, r, info.Roles...); err != nil {
+		return
+	}
 	if code, err := h.mgr.addUser(info); err != nil {
 		h.failAction(w, r, "add user", info.ID, err, code)
 		return
@@ -257,6 +324,14 @@ func (h *hserv) httpUserGet(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if len(items) == 2 && items[1] == apc.S3Keys {
+		h.s3KeyGet(w, r, items[0])
+		return
+	}
+	if len(items) == 2 && items[1] == apc.APIKeys {
+		h.apiKeyGet(w, r, items[0])
+		return
+	}
 	if len(items) > 1 {
 		cmn.WriteErrMsg(w, r, "invalid request")
 		return
@@ -298,6 +373,15 @@ func getToken(r *http.Request) (*tok.Token, error) {
 	if tk.Expires.Before(time.Now()) {
 		return nil, fmt.Errorf("not authorized (token expired): %s", tk)
 	}
+	switch {
+	case tk.AccessKey:
+		return nil, tok.ErrAccessKey
+	case tk.Refresh:
+		return nil, tok.ErrRefreshToken
+	case tk.APIKey != "":
+		// API keys are meant to access AIS clusters, not to manage AuthN (e.g., to create more keys)
+		return nil, fmt.Errorf("not authorized: API key %q cannot be used with %s", tk.APIKey, svcName)
+	}
 	return tk, nil
 }
 
@@ -317,6 +401,51 @@ func validateAdminPerms(w http.ResponseWriter, r *http.Request) error {
 	return nil
 }
 
+// Checks that the request is made either by admin or by namespace admin (see apc.AccessNsAdmin)
+// in which case all the roles in question must be confined to the namespaces the latter administers
+func validateNsAdminPerms(w http.ResponseWriter, r *http.Request, roles ...*authn.Role) error {
+	tk, err := getToken(r)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return err
+	}
+	if tk.IsAdmin {
+		return nil
+	}
+	if len(roles) == 0 {
+		err = fmt.Errorf("not authorized: requires admin (%s)", tk)
+	}
+	for _, role := range roles {
+		if err = nsDelegable(tk, role); err != nil {
+			break
+		}
+	}
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+	}
+	return err
+}
+
+func nsDelegable(tk *tok.Token, role *authn.Role) error {
+	if role.IsAdmin || role.Name == authn.AdminRole || len(role.ClusterACLs) > 0 {
+		return fmt.Errorf("not authorized: role %q requires admin (%s)", role.Name, tk)
+	}
+	if len(role.NamespaceACLs) == 0 && len(role.BucketACLs) == 0 {
+		return fmt.Errorf("not authorized: role %q is not namespace-scoped (%s)", role.Name, tk)
+	}
+	for _, acl := range role.NamespaceACLs {
+		if !tk.IsNsAdmin(acl.Ns.UUID, &cmn.Ns{Name: acl.Ns.Name}) {
+			return fmt.Errorf("not authorized: requires admin of the namespace %s (%s)", acl, tk)
+		}
+	}
+	for _, acl := range role.BucketACLs {
+		if !tk.IsNsAdmin(acl.Bck.Ns.UUID, &cmn.Ns{Name: acl.Bck.Ns.Name}) {
+			return fmt.Errorf("not authorized: requires admin of the bucket %s namespace (%s)", acl.Bck.String(), tk)
+		}
+	}
+	return nil
+}
+
 func validateUpdatePerms(w http.ResponseWriter, r *http.Request, userID string, updateReq *authn.User) error {
 	tk, err := getToken(r)
 	if err != nil {
@@ -334,9 +463,120 @@ func validateUpdatePerms(w http.ResponseWriter, r *http.Request, userID string,
 	return err
 }
 
-// Generate h token for h user if provided credentials are valid.
-// If h token is already issued and it is not expired yet then the old
-// token is returned
+// Checks that the request is made either by admin or by the user in question
+func validateSelfPerms(w http.ResponseWriter, r *http.Request, userID string) error {
+	tk, err := getToken(r)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return err
+	}
+	if tk.IsAdmin || tk.UserID == userID {
+		return nil
+	}
+	err = fmt.Errorf("not authorized: (%s)", tk)
+	cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+	return err
+}
+
+//
+// S3 access keys: /v1/users/<user-id>/s3keys[/<access-key>]
+//
+
+func (h *hserv) s3KeyAdd(w http.ResponseWriter, r *http.Request, userID string) {
+	if err := validateSelfPerms(w, r, userID); err != nil {
+		return
+	}
+	msg := &authn.S3KeyMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	key, code, err := h.mgr.addS3Key(userID, msg)
+	if err != nil {
+		h.failAction(w, r, "create S3 access key for", userID, err, code)
+		return
+	}
+	if Conf.Verbose() {
+		nlog.Infof("Add S3 access key for %q", userID)
+	}
+	writeJSON(w, key, "add S3 key")
+}
+
+func (h *hserv) s3KeyGet(w http.ResponseWriter, r *http.Request, userID string) {
+	if err := validateSelfPerms(w, r, userID); err != nil {
+		return
+	}
+	keys, code, err := h.mgr.s3Keys(userID)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	writeJSON(w, keys, "list S3 keys")
+}
+
+func (h *hserv) s3KeyDel(w http.ResponseWriter, r *http.Request, items []string) {
+	userID := items[0]
+	if len(items) != 3 {
+		cmn.WriteErrMsg(w, r, "missing S3 access key")
+		return
+	}
+	if err := validateSelfPerms(w, r, userID); err != nil {
+		return
+	}
+	if code, err := h.mgr.delS3Key(userID, items[2]); err != nil {
+		h.failAction(w, r, "delete S3 access key of", userID, err, code)
+	}
+}
+
+//
+// API keys: /v1/users/<user-id>/apikeys[/<key-id>]
+//
+
+func (h *hserv) apiKeyAdd(w http.ResponseWriter, r *http.Request, userID string) {
+	if err := validateSelfPerms(w, r, userID); err != nil {
+		return
+	}
+	msg := &authn.APIKeyMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	key, code, err := h.mgr.addAPIKey(userID, msg)
+	if err != nil {
+		h.failAction(w, r, "create API key for", userID, err, code)
+		return
+	}
+	if Conf.Verbose() {
+		nlog.Infof("Add API key %q (%s) for %q", key.Name, key.ID, userID)
+	}
+	writeJSON(w, key, "add API key")
+}
+
+func (h *hserv) apiKeyGet(w http.ResponseWriter, r *http.Request, userID string) {
+	if err := validateSelfPerms(w, r, userID); err != nil {
+		return
+	}
+	keys, code, err := h.mgr.apiKeys(userID)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	writeJSON(w, keys, "list API keys")
+}
+
+func (h *hserv) apiKeyDel(w http.ResponseWriter, r *http.Request, items []string) {
+	userID := items[0]
+	if len(items) != 3 {
+		cmn.WriteErrMsg(w, r, "missing API key ID")
+		return
+	}
+	if err := validateSelfPerms(w, r, userID); err != nil {
+		return
+	}
+	if code, err := h.mgr.delAPIKey(userID, items[2]); err != nil {
+		h.failAction(w, r, "delete API key of", userID, err, code)
+	}
+}
+
+// Generate h token (and refresh token) for h user if provided credentials are valid.
 func (h *hserv) userLogin(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := parseURL(w, r, 1, apc.URLPathUsers.L)
 	if err != nil {
@@ -351,18 +591,13 @@ func (h *hserv) userLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var (
-		token  string
-		code   int
-		userID = apiItems[0]
-	)
-	if token, code, err = h.mgr.issueToken(userID, msg.Password, msg); err != nil {
+	userID := apiItems[0]
+	repl, code, err := h.mgr.issueToken(userID, msg.Password, msg)
+	if err != nil {
 		h.failAction(w, r, "generate token for", userID, err, code)
 		return
 	}
-
-	repl := fmt.Sprintf(`{"token": %q}`, token)
-	writeBytes(w, cos.UnsafeB(repl), "login")
+	writeJSON(w, repl, "login")
 }
 
 func writeJSON(w http.ResponseWriter, val any, tag string) {
@@ -372,13 +607,6 @@ func writeJSON(w http.ResponseWriter, val any, tag string) {
 	}
 }
 
-func writeBytes(w http.ResponseWriter, jsbytes []byte, tag string) {
-	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
-	if _, err := w.Write(jsbytes); err != nil {
-		nlog.Errorf("%s: failed to write response: %v", tag, err)
-	}
-}
-
 func (h *hserv) httpSrvPost(w http.ResponseWriter, r *http.Request) {
 	if _, err := parseURL(w, r, 0, apc.URLPathClusters.L); err != nil {
 		return
@@ -517,11 +745,15 @@ func (h *hserv) httpRoleDel(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err = validateAdminPerms(w, r); err != nil {
+	roleID := apiItems[0]
+	role, code, err := h.mgr.lookupRole(roleID)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	if err = validateNsAdminPerms(w, r, role); err != nil {
 		return
 	}
-
-	roleID := apiItems[0]
 	if code, err := h.mgr.delRole(roleID); err != nil {
 		h.failAction(w, r, "delete role", roleID, err, code)
 	}
@@ -532,13 +764,13 @@ func (h *hserv) httpRolePost(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err = validateAdminPerms(w, r); err != nil {
-		return
-	}
 	info := &authn.Role{}
 	if err := cmn.ReadJSON(w, r, info); err != nil {
 		return
 	}
+	if err = validateNsAdminPerms(w, r, info); err != nil {
+		return
+	}
 	if code, err := h.mgr.addRole(info); err != nil {
 		h.failAction(w, r, "add role", info.Name, err, code)
 	}
@@ -549,10 +781,6 @@ func (h *hserv) httpRolePut(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
-	if err = validateAdminPerms(w, r); err != nil {
-		return
-	}
-
 	role := apiItems[0]
 	updateReq := &authn.Role{}
 	err = jsoniter.NewDecoder(r.Body).Decode(updateReq)
@@ -560,6 +788,19 @@ func (h *hserv) httpRolePut(w http.ResponseWriter, r *http.Request) {
 		cmn.WriteErrMsg(w, r, "Invalid request")
 		return
 	}
+	// namespace admin: both the current role and the update must be confined to admin's namespaces
+	current, code, err := h.mgr.lookupRole(role)
+	if err != nil {
+		cmn.WriteErr(w, r, err, code)
+		return
+	}
+	roles := []*authn.Role{current}
+	if updateReq.IsAdmin || len(updateReq.ClusterACLs) > 0 || len(updateReq.BucketACLs) > 0 || len(updateReq.NamespaceACLs) > 0 {
+		roles = append(roles, updateReq)
+	}
+	if err = validateNsAdminPerms(w, r, roles...); err != nil {
+		return
+	}
 	if Conf.Verbose() {
 		nlog.Infof("PUT role %q\n", role)
 	}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/audit"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/fname"
 	"github.com/NVIDIA/aistore/cmn/jsp"
@@ -74,9 +75,14 @@ func main() {
 	if val := os.Getenv(env.AisAuthSecretKey); val != "" {
 		Conf.SetSecret(&val)
 	}
-	if err := updateLogOptions(); err != nil {
+	logDir, err := updateLogOptions()
+	if err != nil {
 		cos.ExitLogf("Failed to set up logger: %v", err)
 	}
+	if err := Conf.Audit.Validate(); err != nil {
+		cos.ExitLogf("Invalid configuration: %v", err)
+	}
+	audit.Init(svcName, logDir, func() *cmn.AuditConf { return &Conf.Audit })
 	if Conf.Verbose() {
 		nlog.Infof("Loaded configuration from %s", configPath)
 	}
@@ -94,6 +100,7 @@ func main() {
 	nlog.Infof("Version %s (build %s)\n", cmn.VersionAuthN+"."+build, buildtime)
 
 	go logFlush()
+	go mgr.housekeep()
 
 	srv := newServer(mgr)
 	err = srv.Run()
@@ -105,13 +112,13 @@ func main() {
 	}
 }
 
-func updateLogOptions() error {
+func updateLogOptions() (string, error) {
 	logDir := cos.GetEnvOrDefault(env.AisAuthLogDir, Conf.Log.Dir)
 	if err := cos.CreateDir(logDir); err != nil {
-		return fmt.Errorf("failed to create log dir %q, err: %v", logDir, err)
+		return "", fmt.Errorf("failed to create log dir %q, err: %v", logDir, err)
 	}
 	nlog.SetPre(logDir, "auth")
-	return nil
+	return logDir, nil
 }
 
 func installSignalHandler() {
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -80,12 +82,27 @@ func (m *mgr) addUser(info *authn.User) (int, error) {
 	return m.db.Set(usersCollection, info.ID, info)
 }
 
-// Deletes an existing user
+// Deletes an existing user (and revokes the user's S3 access keys, API keys, and refresh tokens, if any)
 func (m *mgr) delUser(userID string) (int, error) {
 	if userID == adminUserID {
 		return http.StatusForbidden, fmt.Errorf("cannot remove built-in %q account", adminUserID)
 	}
-	return m.db.Delete(usersCollection, userID)
+	code, err := m.db.Delete(usersCollection, userID)
+	if err != nil {
+		return code, err
+	}
+	if keys, _, err := m.s3Keys(userID); err == nil {
+		for _, key := range keys {
+			m.delS3Key(userID, key.AccessKey)
+		}
+	}
+	if keys, _, err := m.apiKeys(userID); err == nil {
+		for _, key := range keys {
+			m.delAPIKey(userID, key.ID)
+		}
+	}
+	m.delRefreshTokens(userID)
+	return code, nil
 }
 
 // Updates an existing user. The function invalidates user tokens after
@@ -102,6 +119,7 @@ func (m *mgr) updateUser(userID string, updateReq *authn.User) (int, error) {
 
 	if updateReq.Password != "" {
 		uInfo.Password = encryptPassword(updateReq.Password)
+		m.delRefreshTokens(userID)
 	}
 	if len(updateReq.Roles) != 0 {
 		uInfo.Roles = updateReq.Roles
@@ -149,6 +167,9 @@ func (m *mgr) addRole(info *authn.Role) (int, error) {
 	if info.IsAdmin {
 		return http.StatusForbidden, fmt.Errorf("only built-in roles can have %q permissions", adminUserID)
 	}
+	if err := validateNsACLs(info.NamespaceACLs); err != nil {
+		return http.StatusBadRequest, err
+	}
 	_, _, err := m.db.GetString(rolesCollection, info.Name)
 	if err == nil {
 		return http.StatusConflict, cos.NewErrAlreadyExists(m, "role "+info.Name)
@@ -178,12 +199,28 @@ func (m *mgr) updateRole(role string, updateReq *authn.Role) (int, error) {
 	if updateReq.Description != "" {
 		rInfo.Description = updateReq.Description
 	}
+	if err := validateNsACLs(updateReq.NamespaceACLs); err != nil {
+		return http.StatusBadRequest, err
+	}
 	rInfo.ClusterACLs = mergeClusterACLs(rInfo.ClusterACLs, updateReq.ClusterACLs, "")
 	rInfo.BucketACLs = mergeBckACLs(rInfo.BucketACLs, updateReq.BucketACLs, "")
+	rInfo.NamespaceACLs = mergeNsACLs(rInfo.NamespaceACLs, updateReq.NamespaceACLs, "")
 
 	return m.db.Set(rolesCollection, role, rInfo)
 }
 
+func validateNsACLs(acls []*authn.NsACL) error {
+	for _, acl := range acls {
+		if acl.Ns.Name == "" {
+			return errors.New("namespace ACL: namespace name is undefined")
+		}
+		if err := cos.CheckAlphaPlus(acl.Ns.Name, "namespace"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (m *mgr) lookupRole(roleID string) (*authn.Role, int, error) {
 	rInfo := &authn.Role{}
 	code, err := m.db.Get(rolesCollection, roleID, rInfo)
@@ -360,66 +397,364 @@ func (m *mgr) delCluster(cluID string) (int, error) {
 // tokens ============================================================
 //
 
-// Generates a token for a user if user credentials are valid. If the token is
-// already generated and is not expired yet the existing token is returned.
+// Generates a token for a user if user credentials are valid.
 // Token includes user ID, permissions, and token expiration time.
-// If a new token was generated then it sends the proxy a new valid token list
-func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (token string, code int, err error) {
-	var (
-		uInfo   = &authn.User{}
-		cid     string
-		cluACLs []*authn.CluACL
-		bckACLs []*authn.BckACL
-	)
-	_, err = m.db.Get(usersCollection, uid, uInfo)
-	if err != nil {
+// In addition, generates (and stores) a refresh token to be used to obtain new tokens
+// without re-entering credentials (see refresh)
+func (m *mgr) issueToken(uid, pwd string, msg *authn.LoginMsg) (*authn.TokenMsg, int, error) {
+	uInfo := &authn.User{}
+	if _, err := m.db.Get(usersCollection, uid, uInfo); err != nil {
 		nlog.Errorln(err)
-		return "", http.StatusUnauthorized, errInvalidCredentials
+		return nil, http.StatusUnauthorized, errInvalidCredentials
 	}
 
 	debug.Assert(uid == uInfo.ID, uid, " vs ", uInfo.ID)
 
 	if !isSamePassword(pwd, uInfo.Password) {
-		return "", http.StatusUnauthorized, errInvalidCredentials
-	}
-
-	// update ACLs with roles' ones
-	for _, role := range uInfo.Roles {
-		cluACLs = mergeClusterACLs(cluACLs, role.ClusterACLs, cid)
-		bckACLs = mergeBckACLs(bckACLs, role.BucketACLs, cid)
+		return nil, http.StatusUnauthorized, errInvalidCredentials
 	}
 
 	// generate token
-	token, err = m._token(msg, uInfo, cluACLs, bckACLs)
+	token, err := m._token(msg.ExpiresIn, uInfo)
 	if err != nil {
-		return "", http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
 	}
-	return token, http.StatusOK, nil
+	refresh, code, err := m.addRefreshToken(uid)
+	if err != nil {
+		return nil, code, err
+	}
+	return &authn.TokenMsg{Token: token, RefreshToken: refresh}, http.StatusOK, nil
+}
+
+// merge user's ACLs with the ones of all user's roles
+func userACLs(uInfo *authn.User, cluIDFlt string) (cluACLs []*authn.CluACL, bckACLs []*authn.BckACL, nsACLs []*authn.NsACL) {
+	for _, role := range uInfo.Roles {
+		cluACLs = mergeClusterACLs(cluACLs, role.ClusterACLs, cluIDFlt)
+		bckACLs = mergeBckACLs(bckACLs, role.BucketACLs, cluIDFlt)
+		nsACLs = mergeNsACLs(nsACLs, role.NamespaceACLs, cluIDFlt)
+	}
+	return cluACLs, bckACLs, nsACLs
 }
 
-func (m *mgr) _token(msg *authn.LoginMsg, uInfo *authn.User, cluACLs []*authn.CluACL, bckACLs []*authn.BckACL) (token string, err error) {
-	expDelta := Conf.Expire()
-	if msg.ExpiresIn != nil {
-		expDelta = *msg.ExpiresIn
+func expiresIn(expDelta *time.Duration, dflt time.Duration) time.Time {
+	d := dflt
+	if expDelta != nil {
+		d = *expDelta
 	}
-	if expDelta == 0 {
-		expDelta = foreverTokenTime
+	if d == 0 {
+		d = foreverTokenTime
 	}
+	return time.Now().Add(d)
+}
 
+func (m *mgr) _token(expDelta *time.Duration, uInfo *authn.User) (token string, err error) {
 	// put all useful info into token: who owns the token, when it was issued,
 	// when it expires and credentials to log in AWS, GCP etc.
 	// If a user is a super user, it is enough to pass only isAdmin marker
-	expires := time.Now().Add(expDelta)
-	uid := uInfo.ID
+	var (
+		expires = expiresIn(expDelta, Conf.Expire())
+		uid     = uInfo.ID
+		id      = cos.GenUUID()
+	)
 	if uInfo.IsAdmin() {
-		token, err = tok.AdminJWT(expires, uid, Conf.Secret())
+		token, err = tok.AdminJWT(expires, uid, id, Conf.Secret())
 	} else {
+		cluACLs, bckACLs, nsACLs := userACLs(uInfo, "")
 		m.fixClusterIDs(cluACLs)
-		token, err = tok.JWT(expires, uid, bckACLs, cluACLs, Conf.Secret())
+		token, err = tok.JWT(expires, uid, id, bckACLs, cluACLs, nsACLs, Conf.Secret())
 	}
 	return token, err
 }
 
+//
+// refresh tokens ============================================================
+//
+
+// refresh tokens are stored (by ID) for as long as they remain valid:
+// logging out, changing password, and deleting user - all remove the record
+type refreshRec struct {
+	Expires time.Time `json:"expires"`
+	UserID  string    `json:"user"`
+}
+
+func (m *mgr) addRefreshToken(userID string) (string, int, error) {
+	var (
+		id      = cos.GenUUID()
+		expires = time.Now().Add(Conf.RefreshExpire())
+	)
+	token, err := tok.RefreshJWT(expires, userID, id, Conf.Secret())
+	if err != nil {
+		return "", http.StatusInternalServerError, err
+	}
+	if code, err := m.db.Set(refreshCollection, id, &refreshRec{Expires: expires, UserID: userID}); err != nil {
+		return "", code, err
+	}
+	return token, http.StatusOK, nil
+}
+
+// Exchanges valid (non-expired and not revoked) refresh token for a new token
+// that carries user's current permissions
+func (m *mgr) refresh(refreshToken string) (*authn.TokenMsg, int, error) {
+	tk, err := tok.DecryptToken(refreshToken, Conf.Secret())
+	if err != nil || !tk.Refresh || tk.ID == "" {
+		return nil, http.StatusUnauthorized, tok.ErrInvalidToken
+	}
+	if tk.Expires.Before(time.Now()) {
+		return nil, http.StatusUnauthorized, fmt.Errorf("%v: refresh %s", tok.ErrTokenExpired, tk)
+	}
+	rec := &refreshRec{}
+	if _, err := m.db.Get(refreshCollection, tk.ID, rec); err != nil || rec.UserID != tk.UserID {
+		return nil, http.StatusUnauthorized, tok.ErrTokenRevoked
+	}
+	uInfo, _, err := m.lookupUser(tk.UserID)
+	if err != nil {
+		return nil, http.StatusUnauthorized, errInvalidCredentials
+	}
+	token, err := m._token(nil, uInfo)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return &authn.TokenMsg{Token: token}, http.StatusOK, nil
+}
+
+// Removes all user's refresh tokens (so that the user has to log in again)
+func (m *mgr) delRefreshTokens(userID string) {
+	recs, _, err := m.db.GetAll(refreshCollection, "")
+	if err != nil {
+		return
+	}
+	for id, str := range recs {
+		rec := &refreshRec{}
+		if err := jsoniter.Unmarshal([]byte(str), rec); err != nil || rec.UserID == userID {
+			m.db.Delete(refreshCollection, id)
+		}
+	}
+}
+
+//
+// API keys ============================================================
+//
+
+// Creates named API key: a token that carries either all user's current permissions
+// or - when scope is specified - a subset of the latter
+func (m *mgr) addAPIKey(userID string, msg *authn.APIKeyMsg) (*authn.APIKey, int, error) {
+	if msg.Name == "" || !cos.IsAlphaNice(msg.Name) {
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid API key name %q: %s", msg.Name, cos.OnlyNice)
+	}
+	uInfo, code, err := m.lookupUser(userID)
+	if err != nil {
+		return nil, code, err
+	}
+	keys, code, err := m.apiKeys(userID)
+	if err != nil {
+		return nil, code, err
+	}
+	for _, key := range keys {
+		if key.Name == msg.Name {
+			return nil, http.StatusConflict, cos.NewErrAlreadyExists(m, "API key "+msg.Name+" of the user "+userID)
+		}
+	}
+
+	cluACLs, bckACLs, nsACLs := userACLs(uInfo, "")
+	m.fixClusterIDs(cluACLs)
+	if len(msg.Scope) > 0 {
+		utk := &tok.Token{UserID: userID, IsAdmin: uInfo.IsAdmin(), ClusterACLs: cluACLs, BucketACLs: bckACLs, NamespaceACLs: nsACLs}
+		if code, err := m.validateScope(utk, msg.Scope); err != nil {
+			return nil, code, err
+		}
+	}
+
+	var (
+		token string
+		key   = &authn.APIKey{
+			Created: time.Now(),
+			Expires: expiresIn(msg.ExpiresIn, Conf.Expire()),
+			ID:      cos.GenUUID(),
+			Name:    msg.Name,
+			UserID:  userID,
+			Scope:   msg.Scope,
+		}
+		secret = Conf.Secret()
+	)
+	if len(msg.Scope) > 0 {
+		token, err = tok.APIKeyJWT(key.Expires, userID, key.ID, key.Name, msg.Scope, nil, nil, false, secret)
+	} else {
+		token, err = tok.APIKeyJWT(key.Expires, userID, key.ID, key.Name, bckACLs, cluACLs, nsACLs, uInfo.IsAdmin(), secret)
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if code, err := m.db.Set(apikeysCollection, key.ID, key); err != nil {
+		return nil, code, err
+	}
+	key.Token = token
+	return key, http.StatusOK, nil
+}
+
+// API key scope cannot exceed user's own permissions
+// (scope bucket's Ns.UUID is the cluster ID or alias - see authn.BckACL)
+func (m *mgr) validateScope(utk *tok.Token, scope []*authn.BckACL) (int, error) {
+	for _, acl := range scope {
+		if acl.Bck.Name == "" || acl.Access == apc.AccessNone {
+			return http.StatusBadRequest, fmt.Errorf("invalid API key scope: bucket %q, permissions %q",
+				acl.Bck.Name, acl.Access.Describe(false /*include all*/))
+		}
+		if acl.Bck.Provider == "" {
+			acl.Bck.Provider = apc.AIS
+		}
+		cid := acl.Bck.Ns.UUID
+		if cid != "" {
+			if cid = m.cluLookup(cid, cid); cid == "" {
+				return http.StatusNotFound, cos.NewErrNotFound(m, "cluster "+acl.Bck.Ns.UUID)
+			}
+			acl.Bck.Ns.UUID = cid
+		}
+		bck := cmn.Bck{Name: acl.Bck.Name, Provider: acl.Bck.Provider, Ns: cmn.Ns{Name: acl.Bck.Ns.Name}}
+		if err := utk.CheckPermissions(cid, &bck, acl.Access); err != nil {
+			return http.StatusForbidden, fmt.Errorf("API key scope exceeds user's permissions: %v", err)
+		}
+	}
+	return http.StatusOK, nil
+}
+
+// Returns user's API keys (and removes expired ones)
+func (m *mgr) apiKeys(userID string) ([]*authn.APIKey, int, error) {
+	recs, code, err := m.db.GetAll(apikeysCollection, "")
+	if err != nil {
+		return nil, code, err
+	}
+	var (
+		now  = time.Now()
+		keys = make([]*authn.APIKey, 0, 4)
+	)
+	for id, str := range recs {
+		key := &authn.APIKey{}
+		if err := jsoniter.Unmarshal([]byte(str), key); err != nil {
+			nlog.Errorf("Failed to parse API key record: %v", err)
+			continue
+		}
+		if key.Expires.Before(now) {
+			m.db.Delete(apikeysCollection, id)
+			continue
+		}
+		if key.UserID == userID {
+			keys = append(keys, key)
+		}
+	}
+	return keys, http.StatusOK, nil
+}
+
+// Deletes and revokes (by ID) API key
+func (m *mgr) delAPIKey(userID, id string) (int, error) {
+	key := &authn.APIKey{}
+	if _, err := m.db.Get(apikeysCollection, id, key); err != nil || key.UserID != userID {
+		return http.StatusNotFound, cos.NewErrNotFound(m, "API key "+id+" of the user "+userID)
+	}
+	if code, err := m.db.Delete(apikeysCollection, id); err != nil {
+		return code, err
+	}
+	return m.revokeID(id, key.Expires)
+}
+
+//
+// S3 access keys ============================================================
+//
+
+// Creates S3 access key that carries user's current permissions (see tok.S3KeyJWT);
+// the secret key is derived and returned but never stored.
+// Tenant (namespace) is either requested explicitly or implied - when all user's
+// namespace ACLs refer to a single namespace.
+func (m *mgr) addS3Key(userID string, msg *authn.S3KeyMsg) (*authn.S3Key, int, error) {
+	uInfo, code, err := m.lookupUser(userID)
+	if err != nil {
+		return nil, code, err
+	}
+	cluACLs, bckACLs, nsACLs := userACLs(uInfo, "")
+	m.fixClusterIDs(cluACLs)
+
+	tenant, err := s3Tenant(userID, msg.Namespace, nsACLs)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	var (
+		secret  = Conf.Secret()
+		expires = expiresIn(msg.ExpiresIn, Conf.Expire())
+	)
+	accessKey, err := tok.S3KeyJWT(expires, userID, bckACLs, cluACLs, nsACLs, tenant, uInfo.IsAdmin(), secret)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	key := &authn.S3Key{AccessKey: accessKey, UserID: userID, Expires: expires, Namespace: tenant}
+	if code, err := m.db.Set(s3keysCollection, accessKey, key); err != nil {
+		return nil, code, err
+	}
+	key.SecretKey = tok.S3Secret(accessKey, secret)
+	return key, http.StatusOK, nil
+}
+
+func s3Tenant(userID, requested string, nsACLs []*authn.NsACL) (string, error) {
+	var tenant string
+	if requested != "" {
+		tenant = strings.TrimPrefix(requested, string(apc.NsNamePrefix))
+		for _, acl := range nsACLs {
+			if acl.Ns.Name == tenant {
+				return tenant, nil
+			}
+		}
+		return "", fmt.Errorf("user %q has no permissions for namespace %q", userID, requested)
+	}
+	for _, acl := range nsACLs {
+		switch tenant {
+		case "":
+			tenant = acl.Ns.Name
+		case acl.Ns.Name:
+		default:
+			return "", nil // multiple namespaces: none implied
+		}
+	}
+	return tenant, nil
+}
+
+// Returns user's S3 access keys (and removes expired ones)
+func (m *mgr) s3Keys(userID string) ([]*authn.S3Key, int, error) {
+	recs, code, err := m.db.GetAll(s3keysCollection, "")
+	if err != nil {
+		return nil, code, err
+	}
+	var (
+		now  = time.Now()
+		keys = make([]*authn.S3Key, 0, 4)
+	)
+	for accessKey, str := range recs {
+		key := &authn.S3Key{}
+		if err := jsoniter.Unmarshal([]byte(str), key); err != nil {
+			nlog.Errorf("Failed to parse S3 key record: %v", err)
+			continue
+		}
+		if key.Expires.Before(now) {
+			m.db.Delete(s3keysCollection, accessKey)
+			continue
+		}
+		if key.UserID == userID {
+			keys = append(keys, key)
+		}
+	}
+	return keys, http.StatusOK, nil
+}
+
+// Deletes and revokes S3 access key
+func (m *mgr) delS3Key(userID, accessKey string) (int, error) {
+	key := &authn.S3Key{}
+	if _, err := m.db.Get(s3keysCollection, accessKey, key); err != nil || key.UserID != userID {
+		return http.StatusNotFound, cos.NewErrNotFound(m, "S3 access key of the user "+userID)
+	}
+	if code, err := m.db.Delete(s3keysCollection, accessKey); err != nil {
+		return code, err
+	}
+	return m.revokeToken(accessKey)
+}
+
 // Before putting a list of cluster permissions to a token, cluster aliases
 // must be replaced with their IDs.
 func (m *mgr) fixClusterIDs(lst []*authn.CluACL) {
@@ -440,8 +775,20 @@ func (m *mgr) fixClusterIDs(lst []*authn.CluACL) {
 }
 
 // Delete existing token, a.k.a log out
-// If the token was removed successfully then it sends the proxy a new valid token list
+// Tokens that have IDs are revoked by ID; refresh tokens are simply removed
+// (they are never accepted by AIS clusters). Otherwise, sends the proxy
+// the revoked token.
 func (m *mgr) revokeToken(token string) (int, error) {
+	tk, err := tok.DecryptToken(token, Conf.Secret())
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	switch {
+	case tk.Refresh:
+		return m.db.Delete(refreshCollection, tk.ID)
+	case tk.ID != "":
+		return m.revokeID(tk.ID, tk.Expires)
+	}
 	code, err := m.db.Set(revokedCollection, token, "!")
 	if err != nil {
 		return code, err
@@ -449,22 +796,33 @@ func (m *mgr) revokeToken(token string) (int, error) {
 
 	// send the token in all case to allow an admin to revoke
 	// an existing token even after cluster restart
-	go m.broadcastRevoked(token)
+	go m.broadcastRevoked(&authn.TokenList{Tokens: []string{token}})
+	return http.StatusOK, nil
+}
+
+func (m *mgr) revokeID(id string, expires time.Time) (int, error) {
+	exp := expires.Unix()
+	if code, err := m.db.Set(revokedIDsCollection, id, exp); err != nil {
+		return code, err
+	}
+	go m.broadcastRevoked(&authn.TokenList{IDs: map[string]int64{id: exp}})
 	return http.StatusOK, nil
 }
 
-// Create a list of non-expired and valid revoked tokens.
-// Obsolete and invalid tokens are removed from the database.
-func (m *mgr) generateRevokedTokenList() ([]string, int, error) {
+// Create a list of non-expired and valid revoked tokens and token IDs.
+// Obsolete and invalid tokens and IDs are removed from the database (see also compact).
+func (m *mgr) generateRevokedTokenList() (*authn.TokenList, int, error) {
 	tokens, code, err := m.db.List(revokedCollection, "")
 	if err != nil {
 		debug.AssertNoErr(err)
 		return nil, code, err
 	}
 
-	now := time.Now()
-	revokeList := make([]string, 0, len(tokens))
-	secret := Conf.Secret()
+	var (
+		now    = time.Now()
+		secret = Conf.Secret()
+		list   = &authn.TokenList{Tokens: make([]string, 0, len(tokens))}
+	)
 	for _, token := range tokens {
 		tk, err := tok.DecryptToken(token, secret)
 		if err != nil {
@@ -476,9 +834,52 @@ func (m *mgr) generateRevokedTokenList() ([]string, int, error) {
 			m.db.Delete(revokedCollection, token)
 			continue
 		}
-		revokeList = append(revokeList, token)
+		list.Tokens = append(list.Tokens, token)
+	}
+
+	ids, code, err := m.db.GetAll(revokedIDsCollection, "")
+	if err != nil {
+		return nil, code, err
+	}
+	for id, str := range ids {
+		exp, err := strconv.ParseInt(str, 10, 64)
+		if err != nil || time.Unix(exp, 0).Before(now) {
+			m.db.Delete(revokedIDsCollection, id)
+			continue
+		}
+		if list.IDs == nil {
+			list.IDs = make(map[string]int64, len(ids))
+		}
+		list.IDs[id] = exp
+	}
+	return list, http.StatusOK, nil
+}
+
+// Periodically removes expired records: revoked tokens and IDs, refresh tokens, and keys
+// (so that neither the database nor the revoked list that AIS clusters maintain grow without bound)
+func (m *mgr) housekeep() {
+	for {
+		time.Sleep(compactInterval)
+		m.compact()
+	}
+}
+
+func (m *mgr) compact() {
+	if _, _, err := m.generateRevokedTokenList(); err != nil {
+		nlog.Errorln("failed to compact revoked tokens:", err)
+	}
+	if recs, _, err := m.db.GetAll(refreshCollection, ""); err == nil {
+		now := time.Now()
+		for id, str := range recs {
+			rec := &refreshRec{}
+			if err := jsoniter.Unmarshal([]byte(str), rec); err != nil || rec.Expires.Before(now) {
+				m.db.Delete(refreshCollection, id)
+			}
+		}
 	}
-	return revokeList, http.StatusOK, nil
+	// listing any user's keys removes all expired ones
+	m.apiKeys("")
+	m.s3Keys("")
 }
 
 //
@@ -0,0 +1,380 @@
+// Package tok provides AuthN token (structure and methods)
+// for validation by AIS gateways
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package tok
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmn"
+
+	"github.com/golang-jwt/jwt/v4"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// OIDC: tokens issued by external identity providers and signed with
+// asymmetric keys (RSA, RSA-PSS, ECDSA); see also cmn.OIDCConf
+
+type (
+	// public keys (to verify token signatures) by key ID
+	KeySet struct {
+		keys map[string]crypto.PublicKey // by "kid"
+		all  []crypto.PublicKey
+	}
+	jwk struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+	jwks struct {
+		Keys []jwk `json:"keys"`
+	}
+)
+
+var (
+	ErrUnknownKey = fmt.Errorf("%w: unknown signing key", ErrInvalidToken)
+
+	asymMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+)
+
+// IsHMAC returns true if the token is signed with a shared secret (and is, therefore, AuthN-issued)
+func IsHMAC(tokenStr string) bool {
+	i := strings.IndexByte(tokenStr, '.')
+	if i <= 0 {
+		return true // (will fail to parse)
+	}
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(tokenStr[:i], "="))
+	if err != nil {
+		return true
+	}
+	var hdr struct {
+		Alg string `json:"alg"`
+	}
+	if err := jsoniter.Unmarshal(b, &hdr); err != nil {
+		return true
+	}
+	return strings.HasPrefix(hdr.Alg, "HS")
+}
+
+////////////
+// KeySet //
+////////////
+
+// ParseJWKS parses JSON Web Key Set (RFC 7517), skipping unsupported and encryption-only keys
+func ParseJWKS(b []byte) (*KeySet, error) {
+	var set jwks
+	if err := jsoniter.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %v", err)
+	}
+	ks := &KeySet{keys: make(map[string]crypto.PublicKey, len(set.Keys))}
+	for i := range set.Keys {
+		k := &set.Keys[i]
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.pubKey()
+		if err != nil {
+			return nil, fmt.Errorf("JWKS key %q: %v", k.Kid, err)
+		}
+		if pub == nil {
+			continue
+		}
+		ks.add(k.Kid, pub)
+	}
+	if len(ks.all) == 0 {
+		return nil, errors.New("JWKS contains no (supported) signing keys")
+	}
+	return ks, nil
+}
+
+// ParsePEM parses PEM-encoded public keys and/or certificates
+func ParsePEM(b []byte) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]crypto.PublicKey, 2)}
+	for {
+		var blk *pem.Block
+		if blk, b = pem.Decode(b); blk == nil {
+			break
+		}
+		var (
+			pub any
+			err error
+		)
+		switch blk.Type {
+		case "PUBLIC KEY":
+			pub, err = x509.ParsePKIXPublicKey(blk.Bytes)
+		case "RSA PUBLIC KEY":
+			pub, err = x509.ParsePKCS1PublicKey(blk.Bytes)
+		case "CERTIFICATE":
+			var cert *x509.Certificate
+			if cert, err = x509.ParseCertificate(blk.Bytes); err == nil {
+				pub = cert.PublicKey
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PEM block %q: %v", blk.Type, err)
+		}
+		switch pub.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+			ks.add("", pub)
+		default:
+			return nil, fmt.Errorf("unsupported public key type %T", pub)
+		}
+	}
+	if len(ks.all) == 0 {
+		return nil, errors.New("no public keys found in PEM")
+	}
+	return ks, nil
+}
+
+func (ks *KeySet) add(kid string, pub crypto.PublicKey) {
+	if kid != "" {
+		ks.keys[kid] = pub
+	}
+	ks.all = append(ks.all, pub)
+}
+
+func (ks *KeySet) Has(kid string) bool {
+	_, ok := ks.keys[kid]
+	return ok
+}
+
+func (ks *KeySet) Len() int { return len(ks.all) }
+
+// candidate keys: by key ID if present, all keys otherwise
+func (ks *KeySet) lookup(kid string) []crypto.PublicKey {
+	if kid == "" {
+		return ks.all
+	}
+	if pub, ok := ks.keys[kid]; ok {
+		return []crypto.PublicKey{pub}
+	}
+	if len(ks.keys) == 0 {
+		return ks.all // (PEM)
+	}
+	return nil
+}
+
+/////////
+// jwk //
+/////////
+
+func (k *jwk) pubKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := b64int(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := b64int(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() < 3 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var crv elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			crv = elliptic.P256()
+		case "P-384":
+			crv = elliptic.P384()
+		case "P-521":
+			crv = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := b64int(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := b64int(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: crv, X: x, Y: y}, nil
+	default:
+		return nil, nil // skip (e.g., symmetric "oct")
+	}
+}
+
+func b64int(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+//
+// validate and map
+//
+
+// DecryptOIDC validates the token's signature and standard claims ("exp", "nbf", "iss", "aud"),
+// and then maps the token's roles (or groups) onto AIS permissions.
+// Bucket-level permissions are granted in the context of the given cluster.
+func DecryptOIDC(tokenStr string, ks *KeySet, conf *cmn.OIDCConf, clusterID string) (*Token, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods(asymMethods))
+	jwtToken, err := parser.Parse(tokenStr, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		pubs := ks.lookup(kid)
+		if len(pubs) == 0 {
+			return nil, ErrUnknownKey
+		}
+		// (in the unlikely case of multiple candidate keys, pick the first that matches the method)
+		for _, pub := range pubs {
+			switch pub.(type) {
+			case *rsa.PublicKey:
+				if _, ok := t.Method.(*jwt.SigningMethodECDSA); !ok {
+					return pub, nil
+				}
+			case *ecdsa.PublicKey:
+				if _, ok := t.Method.(*jwt.SigningMethodECDSA); ok {
+					return pub, nil
+				}
+			}
+		}
+		return nil, ErrUnknownKey
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrUnknownKey):
+		return nil, ErrUnknownKey
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return nil, ErrTokenExpired
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	claims, ok := jwtToken.Claims.(jwt.MapClaims)
+	if !ok || !jwtToken.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	now := time.Now().Unix()
+	if !claims.VerifyExpiresAt(now, true /*required*/) {
+		return nil, ErrTokenExpired
+	}
+	if conf.Issuer != "" && !claims.VerifyIssuer(conf.Issuer, true) {
+		return nil, fmt.Errorf("%w: unexpected issuer %v", ErrInvalidToken, claims["iss"])
+	}
+	if conf.Audience != "" && !claims.VerifyAudience(conf.Audience, true) {
+		return nil, fmt.Errorf("%w: unexpected audience %v", ErrInvalidToken, claims["aud"])
+	}
+	return fromClaims(claims, conf, clusterID)
+}
+
+func fromClaims(claims jwt.MapClaims, conf *cmn.OIDCConf, clusterID string) (*Token, error) {
+	uid, _ := claimPath(claims, conf.UserClaim).(string)
+	if uid == "" {
+		return nil, fmt.Errorf("%w: missing %q claim", ErrInvalidToken, conf.UserClaim)
+	}
+	tk := &Token{UserID: uid}
+	if exp, ok := claims["exp"].(float64); ok {
+		tk.Expires = time.Unix(int64(exp), 0)
+	}
+
+	var (
+		cluAccess apc.AccessAttrs
+		bckAccess = make(map[cmn.Bck]apc.AccessAttrs, 2)
+	)
+	for _, name := range claimStrings(claimPath(claims, conf.RolesClaim)) {
+		role, ok := conf.Roles[name]
+		if !ok || role == nil {
+			continue
+		}
+		if role.Admin {
+			tk.IsAdmin = true
+		}
+		access, err := role.Access()
+		if err != nil {
+			return nil, err // (unlikely - validated)
+		}
+		if len(role.Buckets) == 0 {
+			cluAccess |= access
+			continue
+		}
+		for _, uri := range role.Buckets {
+			bck, err := role.ParseBck(uri)
+			if err != nil {
+				return nil, err // ditto
+			}
+			b := cmn.Bck{Name: bck.Name, Provider: bck.Provider, Ns: cmn.Ns{UUID: clusterID}}
+			bckAccess[b] |= access
+		}
+	}
+	if cluAccess != 0 {
+		tk.ClusterACLs = []*authn.CluACL{{Access: cluAccess}} // (default cluster ACL)
+	}
+	for bck, access := range bckAccess {
+		tk.BucketACLs = append(tk.BucketACLs, &authn.BckACL{Bck: bck, Access: access})
+	}
+	return tk, nil
+}
+
+// nested claim, e.g. "realm_access.roles"
+func claimPath(claims jwt.MapClaims, path string) any {
+	var (
+		v   any = map[string]any(claims)
+		cur     = path
+	)
+	for cur != "" {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		if val, ok := m[cur]; ok { // (the entire remaining path may be a claim name that contains dots)
+			return val
+		}
+		i := strings.IndexByte(cur, '.')
+		if i < 0 {
+			return nil
+		}
+		v, cur = m[cur[:i]], cur[i+1:]
+	}
+	return v
+}
+
+// claim value can be a list of strings or a (space or comma-separated) string
+func claimStrings(v any) []string {
+	switch vals := v.(type) {
+	case string:
+		return strings.FieldsFunc(vals, func(r rune) bool { return r == ' ' || r == ',' })
+	case []any:
+		out := make([]string, 0, len(vals))
+		for _, val := range vals {
+			if s, ok := val.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	case []string:
+		return vals
+	}
+	return nil
+}
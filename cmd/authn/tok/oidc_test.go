@@ -0,0 +1,261 @@
+// Package tok_test: unit tests for the package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package tok_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "aistore"
+	testCluID    = "test-clu-id"
+)
+
+// stand-in identity provider: signs tokens and serves its public keys (JWKS)
+type idp struct {
+	rsaKey *rsa.PrivateKey
+	ecKey  *ecdsa.PrivateKey
+	srv    *httptest.Server
+}
+
+func newIDP(t *testing.T) *idp {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	tassert.CheckFatal(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tassert.CheckFatal(t, err)
+	p := &idp{rsaKey: rsaKey, ecKey: ecKey}
+	p.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+		w.Write(p.jwks())
+	}))
+	t.Cleanup(p.srv.Close)
+	return p
+}
+
+func (p *idp) jwks() []byte {
+	b64 := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+	keys := []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": b64(p.rsaKey.N), "e": b64(big.NewInt(int64(p.rsaKey.E)))},
+		{"kty": "EC", "kid": "ec-1", "use": "sig", "crv": "P-256", "x": b64(p.ecKey.X), "y": b64(p.ecKey.Y)},
+		{"kty": "oct", "kid": "hmac-1", "k": "c2VjcmV0"}, // (skipped)
+	}
+	return cos.MustMarshal(map[string]any{"keys": keys})
+}
+
+func (p *idp) sign(t *testing.T, method jwt.SigningMethod, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	var key any = p.rsaKey
+	if _, ok := method.(*jwt.SigningMethodECDSA); ok {
+		key = p.ecKey
+	}
+	s, err := token.SignedString(key)
+	tassert.CheckFatal(t, err)
+	return s
+}
+
+func (p *idp) keySet(t *testing.T) *tok.KeySet {
+	resp, err := http.Get(p.srv.URL)
+	tassert.CheckFatal(t, err)
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	tassert.CheckFatal(t, err)
+	ks, err := tok.ParseJWKS(b)
+	tassert.CheckFatal(t, err)
+	return ks
+}
+
+func oidcConf(t *testing.T, jwksURL, pubKeyFile string) *cmn.OIDCConf {
+	conf := &cmn.OIDCConf{
+		JWKSURL:    jwksURL,
+		PubKeyFile: pubKeyFile,
+		Issuer:     testIssuer,
+		Audience:   testAudience,
+		RolesClaim: "realm_access.roles",
+		Roles: map[string]*cmn.OIDCRoleConf{
+			"readers": {Perm: apc.AllowReadOnlyAccess},
+			"writers": {Perm: apc.AllowReadWriteAccess, Buckets: []string{"ais://data"}},
+			"admins":  {Admin: true},
+		},
+	}
+	tassert.CheckFatal(t, conf.Validate())
+	return conf
+}
+
+func claims(user string, roles ...string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":          user,
+		"iss":          testIssuer,
+		"aud":          []string{testAudience, "other"},
+		"exp":          time.Now().Add(time.Hour).Unix(),
+		"realm_access": map[string]any{"roles": roles},
+	}
+}
+
+func TestOIDCSignatures(t *testing.T) {
+	var (
+		p    = newIDP(t)
+		ks   = p.keySet(t)
+		conf = oidcConf(t, p.srv.URL, "")
+	)
+	tassert.Fatalf(t, ks.Len() == 2, "expected 2 signing keys, got %d", ks.Len())
+
+	tests := []struct {
+		method jwt.SigningMethod
+		kid    string
+	}{
+		{jwt.SigningMethodRS256, "rsa-1"},
+		{jwt.SigningMethodPS256, "rsa-1"},
+		{jwt.SigningMethodES256, "ec-1"},
+	}
+	for _, test := range tests {
+		token := p.sign(t, test.method, test.kid, claims("alice", "readers"))
+		tassert.Errorf(t, !tok.IsHMAC(token), "%s: not expecting HMAC", test.method.Alg())
+		tk, err := tok.DecryptOIDC(token, ks, conf, testCluID)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, tk.UserID == "alice", "%s: expected user %q, got %q", test.method.Alg(), "alice", tk.UserID)
+	}
+
+	// unknown key ID
+	token := p.sign(t, jwt.SigningMethodRS256, "rsa-2", claims("alice"))
+	_, err := tok.DecryptOIDC(token, ks, conf, testCluID)
+	tassert.Errorf(t, err == tok.ErrUnknownKey, "expected %v, got %v", tok.ErrUnknownKey, err)
+
+	// signed by a different key
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	tassert.CheckFatal(t, err)
+	jtoken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims("mallory", "admins"))
+	jtoken.Header["kid"] = "rsa-1"
+	token, err = jtoken.SignedString(other)
+	tassert.CheckFatal(t, err)
+	_, err = tok.DecryptOIDC(token, ks, conf, testCluID)
+	tassert.Errorf(t, errors.Is(err, tok.ErrInvalidToken), "expected invalid signature, got %v", err)
+
+	// HMAC (AuthN-issued) tokens are not accepted here
+	token, err = tok.AdminJWT(time.Now().Add(time.Hour), "admin", "", "secret")
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tok.IsHMAC(token), "expecting HMAC")
+	_, err = tok.DecryptOIDC(token, ks, conf, testCluID)
+	tassert.Errorf(t, errors.Is(err, tok.ErrInvalidToken), "expected invalid token, got %v", err)
+}
+
+func TestOIDCClaims(t *testing.T) {
+	var (
+		p    = newIDP(t)
+		ks   = p.keySet(t)
+		conf = oidcConf(t, p.srv.URL, "")
+	)
+	tests := []struct {
+		name   string
+		modify func(jwt.MapClaims)
+		err    error
+	}{
+		{"expired", func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }, tok.ErrTokenExpired},
+		{"no-exp", func(c jwt.MapClaims) { delete(c, "exp") }, tok.ErrTokenExpired},
+		{"wrong-issuer", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }, tok.ErrInvalidToken},
+		{"wrong-audience", func(c jwt.MapClaims) { c["aud"] = "other" }, tok.ErrInvalidToken},
+		{"not-yet-valid", func(c jwt.MapClaims) { c["nbf"] = time.Now().Add(time.Hour).Unix() }, tok.ErrInvalidToken},
+		{"no-subject", func(c jwt.MapClaims) { delete(c, "sub") }, tok.ErrInvalidToken},
+	}
+	for _, test := range tests {
+		c := claims("alice", "readers")
+		test.modify(c)
+		token := p.sign(t, jwt.SigningMethodES256, "ec-1", c)
+		_, err := tok.DecryptOIDC(token, ks, conf, testCluID)
+		tassert.Errorf(t, errors.Is(err, test.err), "%s: expected %v, got %v", test.name, test.err, err)
+	}
+}
+
+func TestOIDCRoles(t *testing.T) {
+	var (
+		p     = newIDP(t)
+		ks    = p.keySet(t)
+		conf  = oidcConf(t, p.srv.URL, "")
+		data  = &cmn.Bck{Name: "data", Provider: apc.AIS}
+		other = &cmn.Bck{Name: "other", Provider: apc.AIS}
+	)
+	decrypt := func(roles ...string) *tok.Token {
+		token := p.sign(t, jwt.SigningMethodRS256, "rsa-1", claims("bob", roles...))
+		tk, err := tok.DecryptOIDC(token, ks, conf, testCluID)
+		tassert.CheckFatal(t, err)
+		return tk
+	}
+
+	// no (known) roles - no permissions
+	tk := decrypt("unknown")
+	tassert.Errorf(t, tk.CheckPermissions(testCluID, data, apc.AceGET) != nil, "expected no access")
+
+	// cluster-wide read-only
+	tk = decrypt("readers")
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, other, apc.AceGET))
+	tassert.Errorf(t, tk.CheckPermissions(testCluID, other, apc.AcePUT) != nil, "expected no write access")
+
+	// read-only + read-write to a given bucket
+	tk = decrypt("readers", "writers")
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, data, apc.AcePUT))
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, other, apc.AceGET))
+	tassert.Errorf(t, tk.CheckPermissions(testCluID, other, apc.AcePUT) != nil, "expected no write access")
+	// bucket permissions are granted in the context of the cluster
+	tassert.Errorf(t, tk.CheckPermissions("another-clu-id", data, apc.AcePUT) != nil, "expected no write access")
+
+	// admin
+	tk = decrypt("admins")
+	tassert.Errorf(t, tk.IsAdmin, "expected admin")
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, nil, apc.AceAdmin))
+
+	// roles as a space-separated string claim
+	conf.RolesClaim = "scope"
+	c := claims("bob")
+	c["scope"] = "openid writers"
+	token := p.sign(t, jwt.SigningMethodRS256, "rsa-1", c)
+	tk, err := tok.DecryptOIDC(token, ks, conf, testCluID)
+	tassert.CheckFatal(t, err)
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, data, apc.AcePUT))
+}
+
+func TestOIDCPEM(t *testing.T) {
+	p := newIDP(t)
+	der, err := x509.MarshalPKIXPublicKey(&p.ecKey.PublicKey)
+	tassert.CheckFatal(t, err)
+	b := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	b = append(b, pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&p.rsaKey.PublicKey)})...)
+	ks, err := tok.ParsePEM(b)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, ks.Len() == 2, "expected 2 keys, got %d", ks.Len())
+
+	conf := oidcConf(t, "", "/dev/null" /*not used*/)
+	// PEM keys have no key IDs: any (matching) key will do
+	for _, method := range []jwt.SigningMethod{jwt.SigningMethodES256, jwt.SigningMethodRS256} {
+		token := p.sign(t, method, "whatever", claims("carol", "readers"))
+		tk, err := tok.DecryptOIDC(token, ks, conf, testCluID)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, tk.UserID == "carol", "expected user %q, got %q", "carol", tk.UserID)
+	}
+
+	_, err = tok.ParsePEM([]byte("garbage"))
+	tassert.Errorf(t, err != nil, "expected error parsing garbage PEM")
+}
@@ -6,6 +6,9 @@
 package tok
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -27,6 +30,14 @@ type Token struct {
 	ClusterACLs []*authn.CluACL `json:"clusters"`
 	BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
 	IsAdmin     bool            `json:"admin"`
+	AccessKey   bool            `json:"s3key,omitempty"` // S3 access key ID (not a bearer token) - see S3KeyJWT
+
+	NamespaceACLs []*authn.NsACL `json:"namespaces,omitempty"`
+	Tenant        string         `json:"tenant,omitempty"` // S3 access key only: namespace that S3 bucket names resolve to
+
+	ID      string `json:"jti,omitempty"`     // token ID (to revoke by ID); empty for tokens issued by older AuthN versions
+	APIKey  string `json:"apikey,omitempty"`  // API key name (see APIKeyJWT)
+	Refresh bool   `json:"refresh,omitempty"` // refresh token (not a bearer token) - see RefreshJWT
 }
 
 var (
@@ -36,30 +47,108 @@ var (
 	ErrNoBearerToken = errors.New("invalid token: no bearer")
 	ErrTokenExpired  = errors.New("token expired")
 	ErrTokenRevoked  = errors.New("token revoked")
+	ErrAccessKey     = errors.New("invalid token: S3 access key cannot be used as bearer token")
+	ErrRefreshToken  = errors.New("invalid token: refresh token cannot be used as bearer token")
 )
 
 // TODO: cos.Unsafe* and other micro-optimization and refactoring
 
-func AdminJWT(expires time.Time, userID, secret string) (string, error) {
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+func AdminJWT(expires time.Time, userID, id, secret string) (string, error) {
+	claims := jwt.MapClaims{
 		"expires":  expires,
 		"username": userID,
 		"admin":    true,
-	})
-	return t.SignedString([]byte(secret))
+	}
+	return sign(claims, id, secret)
 }
 
-func JWT(expires time.Time, userID string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
-	secret string) (string, error) {
-	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+func JWT(expires time.Time, userID, id string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
+	nsACLs []*authn.NsACL, secret string) (string, error) {
+	claims := jwt.MapClaims{
 		"expires":  expires,
 		"username": userID,
 		"buckets":  bucketACLs,
 		"clusters": clusterACLs,
-	})
+	}
+	if len(nsACLs) > 0 {
+		claims["namespaces"] = nsACLs
+	}
+	return sign(claims, id, secret)
+}
+
+// refresh token: carries no permissions and can only be exchanged (with AuthN)
+// for a new token that, in turn, carries user's current permissions
+func RefreshJWT(expires time.Time, userID, id, secret string) (string, error) {
+	claims := jwt.MapClaims{
+		"expires":  expires,
+		"username": userID,
+		"refresh":  true,
+	}
+	return sign(claims, id, secret)
+}
+
+// API key: named bearer token; unlike login tokens, API keys are revoked by ID
+func APIKeyJWT(expires time.Time, userID, id, name string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
+	nsACLs []*authn.NsACL, isAdmin bool, secret string) (string, error) {
+	claims := jwt.MapClaims{
+		"expires":  expires,
+		"username": userID,
+		"apikey":   name,
+	}
+	if isAdmin {
+		claims["admin"] = true
+	} else {
+		claims["buckets"] = bucketACLs
+		claims["clusters"] = clusterACLs
+		if len(nsACLs) > 0 {
+			claims["namespaces"] = nsACLs
+		}
+	}
+	return sign(claims, id, secret)
+}
+
+func sign(claims jwt.MapClaims, id, secret string) (string, error) {
+	if id != "" {
+		claims["jti"] = id
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return t.SignedString([]byte(secret))
 }
 
+// S3 access key ID: self-contained (signed) user ID, permissions, and expiration time,
+// to be used only in conjunction with the secret key (see S3Secret) that signs S3 requests;
+// non-empty tenant maps S3 bucket names to the respective (tenant's) namespace
+func S3KeyJWT(expires time.Time, userID string, bucketACLs []*authn.BckACL, clusterACLs []*authn.CluACL,
+	nsACLs []*authn.NsACL, tenant string, isAdmin bool, secret string) (string, error) {
+	claims := jwt.MapClaims{
+		"expires":  expires,
+		"username": userID,
+		"s3key":    true,
+	}
+	if isAdmin {
+		claims["admin"] = true
+	} else {
+		claims["buckets"] = bucketACLs
+		claims["clusters"] = clusterACLs
+		if len(nsACLs) > 0 {
+			claims["namespaces"] = nsACLs
+		}
+	}
+	if tenant != "" {
+		claims["tenant"] = tenant
+	}
+	return sign(claims, "", secret)
+}
+
+// S3 secret access key is derived from the access key ID and the (AuthN) secret,
+// so that AIS gateways can verify S3 signatures without contacting AuthN
+func S3Secret(accessKey, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("s3-secret-key:"))
+	mac.Write([]byte(accessKey))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:30]) // 40 characters
+}
+
 // Header format: 'Authorization: Bearer <token>'
 func ExtractToken(hdr http.Header) (string, error) {
 	s := hdr.Get(apc.HdrAuthorization)
@@ -94,6 +183,21 @@ func DecryptToken(tokenStr, secret string) (*Token, error) {
 	return tk, nil
 }
 
+// ClaimedUser returns the user ID the token claims - WITHOUT validating the token
+// (e.g., to identify the caller in the audit trail when the token is invalid)
+func ClaimedUser(tokenStr string, conf *cmn.OIDCConf) string {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(tokenStr, claims); err != nil {
+		return ""
+	}
+	if conf.IsEnabled() && !IsHMAC(tokenStr) {
+		uid, _ := claimPath(claims, conf.UserClaim).(string)
+		return uid
+	}
+	uid, _ := claims["username"].(string)
+	return uid
+}
+
 ///////////
 // Token //
 ///////////
@@ -102,23 +206,29 @@ func (tk *Token) String() string {
 	return fmt.Sprintf("user %s, %s", tk.UserID, expiresIn(tk.Expires))
 }
 
-// A user has two-level permissions: cluster-wide and on per bucket basis.
+// A user has three-level permissions: cluster-wide, per namespace (tenant), and on per bucket basis.
 // To be able to access data, a user must have either permission. This
 // allows creating users, e.g, with read-only access to the entire cluster,
 // and read-write access to a single bucket.
-// Per-bucket ACL overrides cluster-wide one.
+// Per-bucket ACL overrides namespace ACL that, in turn, overrides cluster-wide one.
 // Permissions for a cluster with empty ID are used as default ones when
 // a user do not have permissions for the given `clusterID`.
 //
 // ACL rules are checked in the following order (from highest to the lowest priority):
 //  1. A user's role is an admin.
 //  2. User's permissions for the given bucket
-//  3. User's permissions for the given cluster
-//  4. User's default cluster permissions (ACL for a cluster with empty clusterID)
+//  3. User's permissions for the bucket's namespace (excepting cluster-only permissions, see below)
+//  4. User's permissions for the given cluster
+//  5. User's default cluster permissions (ACL for a cluster with empty clusterID)
 //
 // If there are no defined ACL found at any step, any access is denied.
 
-const accessCluster = apc.AceListBuckets | apc.AceCreateBucket | apc.AceDestroyBucket | apc.AceMoveBucket | apc.AceShowCluster | apc.AceAdmin
+const (
+	accessCluster = apc.AceListBuckets | apc.AceCreateBucket | apc.AceDestroyBucket | apc.AceMoveBucket | apc.AceShowCluster | apc.AceAdmin
+
+	// cannot be granted by namespace ACL
+	accessClusterOnly = apc.AceMoveBucket | apc.AceShowCluster | apc.AceAdmin
+)
 
 func (tk *Token) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.AccessAttrs) error {
 	if tk.IsAdmin {
@@ -127,6 +237,11 @@ func (tk *Token) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.Acce
 	if perms == 0 {
 		return errors.New("empty permissions requested")
 	}
+	if bck != nil && perms&accessClusterOnly == 0 {
+		if nsACL, ok := tk.aclForNs(clusterID, &bck.Ns); ok {
+			return tk.checkNs(clusterID, bck, perms, nsACL)
+		}
+	}
 	cluPerms := perms & accessCluster
 	objPerms := perms &^ accessCluster
 	cluACL, cluOk := tk.aclForCluster(clusterID)
@@ -165,6 +280,33 @@ func (tk *Token) CheckPermissions(clusterID string, bck *cmn.Bck, perms apc.Acce
 	return nil
 }
 
+// Tenant is a user with namespace-scoped permissions (see authn.NsACL)
+func (tk *Token) IsTenant() bool { return !tk.IsAdmin && len(tk.NamespaceACLs) > 0 }
+
+// Tenants list only the buckets in their respective namespaces and
+// the buckets they were explicitly granted access to.
+// Other users must have cluster-wide apc.AceListBuckets permission to list buckets
+// (see CheckPermissions).
+func (tk *Token) CanList(clusterID string, bck *cmn.Bck) bool {
+	if !tk.IsTenant() {
+		return true
+	}
+	if acl, ok := tk.aclForBucket(clusterID, bck); ok {
+		return acl != apc.AccessNone
+	}
+	acl, ok := tk.aclForNs(clusterID, &bck.Ns)
+	return ok && acl.Has(apc.AceListBuckets)
+}
+
+// namespace admin (see apc.AccessNsAdmin) can delegate permissions within the namespace
+func (tk *Token) IsNsAdmin(clusterID string, ns *cmn.Ns) bool {
+	if tk.IsAdmin {
+		return true
+	}
+	acl, ok := tk.aclForNs(clusterID, ns)
+	return ok && acl.Has(apc.AceAdmin)
+}
+
 //
 // private
 //
@@ -204,10 +346,47 @@ func (tk *Token) aclForBucket(clusterID string, bck *cmn.Bck) (perms apc.AccessA
 		}
 		// For AuthN all buckets are external: they have UUIDs of the respective AIS clusters.
 		// To correctly compare with the caller's `bck` we construct tokenBck from the token.
-		tokenBck := cmn.Bck{Name: tbBck.Name, Provider: tbBck.Provider}
+		tokenBck := cmn.Bck{Name: tbBck.Name, Provider: tbBck.Provider, Ns: cmn.Ns{Name: tbBck.Ns.Name}}
 		if tokenBck.Equal(bck) {
 			return b.Access, true
 		}
 	}
 	return 0, false
 }
+
+// only local (named) namespaces; ACL for the given cluster takes precedence over the default one
+func (tk *Token) aclForNs(clusterID string, ns *cmn.Ns) (perms apc.AccessAttrs, ok bool) {
+	if ns.UUID != "" || ns.Name == "" {
+		return 0, false
+	}
+	for _, acl := range tk.NamespaceACLs {
+		if acl.Ns.Name != ns.Name {
+			continue
+		}
+		if acl.Ns.UUID == clusterID {
+			return acl.Access, true
+		}
+		if acl.Ns.UUID == "" {
+			perms, ok = acl.Access, true
+		}
+	}
+	return perms, ok
+}
+
+// bucket ACL (if any) for object-level permissions, namespace ACL for the rest
+func (tk *Token) checkNs(clusterID string, bck *cmn.Bck, perms, nsACL apc.AccessAttrs) error {
+	if objPerms := perms &^ accessCluster; objPerms != 0 {
+		if bckACL, ok := tk.aclForBucket(clusterID, bck); ok {
+			if !bckACL.Has(objPerms) {
+				return fmt.Errorf("user `%s` has %v: [%s, bucket %s, granted(%s)]", tk.UserID,
+					ErrNoPermissions, tk, bck.String(), bckACL.Describe(false /*include all*/))
+			}
+			perms &^= objPerms
+		}
+	}
+	if perms != 0 && !nsACL.Has(perms) {
+		return fmt.Errorf("user `%s` has %v: [%s, namespace %s, granted(%s)]", tk.UserID,
+			ErrNoPermissions, tk, bck.Ns.String(), nsACL.Describe(false /*include all*/))
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Package tok_test: unit tests for the package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package tok_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const testSecret = "test-secret"
+
+func TestNamespaceACL(t *testing.T) {
+	var (
+		nsA     = cmn.Ns{Name: "team-a"}
+		nsB     = cmn.Ns{Name: "team-b"}
+		bckA    = &cmn.Bck{Name: "data", Provider: apc.AIS, Ns: nsA}
+		bckB    = &cmn.Bck{Name: "data", Provider: apc.AIS, Ns: nsB}
+		global  = &cmn.Bck{Name: "data", Provider: apc.AIS}
+		granted = &cmn.Bck{Name: "shared", Provider: apc.AIS, Ns: cmn.Ns{UUID: testCluID, Name: "team-b"}}
+	)
+	token, err := tok.JWT(time.Now().Add(time.Hour), "alice", "",
+		[]*authn.BckACL{{Bck: *granted, Access: apc.AccessRO}},
+		[]*authn.CluACL{{ID: testCluID, Access: apc.AccessRO}},
+		[]*authn.NsACL{{Ns: nsA, Access: apc.AccessNsAdmin}},
+		testSecret)
+	tassert.CheckFatal(t, err)
+	tk, err := tok.DecryptToken(token, testSecret)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(tk.NamespaceACLs) == 1 && tk.NamespaceACLs[0].Ns == nsA, "expected namespace ACL, got %+v", tk.NamespaceACLs)
+	tassert.Errorf(t, tk.IsTenant(), "expected tenant")
+
+	// namespace ACL overrides cluster ACL
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, bckA, apc.AcePUT))
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, bckA, apc.AceCreateBucket))
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, bckA, apc.AceDestroyBucket))
+	tassert.Errorf(t, tk.CheckPermissions(testCluID, bckB, apc.AcePUT) != nil, "expected no write access to %s", bckB)
+	tassert.Errorf(t, tk.CheckPermissions(testCluID, bckB, apc.AceCreateBucket) != nil, "expected no create access to %s", bckB)
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, bckB, apc.AceGET))
+	tassert.Errorf(t, tk.CheckPermissions(testCluID, global, apc.AcePUT) != nil, "expected no write access to %s", global)
+
+	// cluster-only permissions are never granted by namespace ACL
+	tassert.Errorf(t, tk.CheckPermissions(testCluID, bckA, apc.AceMoveBucket) != nil, "expected no move-bucket access")
+	tassert.Errorf(t, tk.CheckPermissions(testCluID, nil, apc.AceAdmin) != nil, "expected no admin access")
+
+	// bucket ACL (in a namespace) overrides namespace and cluster ACLs
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, &cmn.Bck{Name: "shared", Provider: apc.AIS, Ns: nsB}, apc.AceGET))
+
+	// listing
+	tassert.Errorf(t, tk.CanList(testCluID, bckA), "expected %s listed", bckA)
+	tassert.Errorf(t, !tk.CanList(testCluID, bckB), "expected %s not listed", bckB)
+	tassert.Errorf(t, !tk.CanList(testCluID, global), "expected %s not listed", global)
+	tassert.Errorf(t, tk.CanList(testCluID, &cmn.Bck{Name: "shared", Provider: apc.AIS, Ns: nsB}), "expected granted bucket listed")
+
+	// namespace admin
+	tassert.Errorf(t, tk.IsNsAdmin(testCluID, &nsA), "expected namespace admin")
+	tassert.Errorf(t, !tk.IsNsAdmin(testCluID, &nsB), "expected no namespace admin")
+	tassert.Errorf(t, !tk.IsNsAdmin(testCluID, &cmn.NsGlobal), "expected no namespace admin")
+}
+
+func TestNamespaceACLCluster(t *testing.T) {
+	var (
+		ns  = cmn.Ns{Name: "team-a"}
+		bck = &cmn.Bck{Name: "data", Provider: apc.AIS, Ns: ns}
+		tk  = &tok.Token{
+			UserID: "bob",
+			NamespaceACLs: []*authn.NsACL{
+				{Ns: ns, Access: apc.AccessRW},                                     // any cluster
+				{Ns: cmn.Ns{UUID: testCluID, Name: ns.Name}, Access: apc.AccessRO}, // this one
+			},
+		}
+	)
+	tassert.Errorf(t, tk.CheckPermissions(testCluID, bck, apc.AcePUT) != nil, "expected cluster-specific ACL to take precedence")
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, bck, apc.AceGET))
+	tassert.CheckError(t, tk.CheckPermissions("another-clu-id", bck, apc.AcePUT))
+	tassert.Errorf(t, !tk.CanList(testCluID, &cmn.Bck{Name: "data", Provider: apc.AIS}), "expected no global buckets")
+
+	// not a tenant: all buckets are listed (provided cluster-wide LIST-BUCKETS)
+	tk.NamespaceACLs = nil
+	tassert.Errorf(t, tk.CanList(testCluID, bck), "expected (non-tenant) listing")
+}
+
+func TestS3KeyTenant(t *testing.T) {
+	nsACLs := []*authn.NsACL{{Ns: cmn.Ns{Name: "team-a"}, Access: apc.AccessRW}}
+	key, err := tok.S3KeyJWT(time.Now().Add(time.Hour), "alice", nil, nil, nsACLs, "team-a", false, testSecret)
+	tassert.CheckFatal(t, err)
+	tk, err := tok.DecryptToken(key, testSecret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.AccessKey && tk.Tenant == "team-a", "expected tenant's access key, got %+v", tk)
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, &cmn.Bck{Name: "b", Provider: apc.AIS, Ns: nsACLs[0].Ns}, apc.AcePUT))
+}
+
+func TestAPIKeyToken(t *testing.T) {
+	var (
+		expires = time.Now().Add(time.Hour)
+		bck     = cmn.Bck{Name: "data", Provider: apc.AIS, Ns: cmn.Ns{UUID: testCluID}}
+		scope   = []*authn.BckACL{{Bck: bck, Access: apc.AccessRO}}
+	)
+	key, err := tok.APIKeyJWT(expires, "alice", "key-id", "ci", scope, nil, nil, false, testSecret)
+	tassert.CheckFatal(t, err)
+	tk, err := tok.DecryptToken(key, testSecret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.ID == "key-id" && tk.APIKey == "ci" && !tk.IsAdmin, "unexpected API key %+v", tk)
+	tassert.CheckError(t, tk.CheckPermissions(testCluID, &cmn.Bck{Name: "data", Provider: apc.AIS}, apc.AceGET))
+	err = tk.CheckPermissions(testCluID, &cmn.Bck{Name: "data", Provider: apc.AIS}, apc.AcePUT)
+	tassert.Errorf(t, err != nil, "expected error: PUT is out of API key scope")
+
+	// admin's unscoped key
+	key, err = tok.APIKeyJWT(expires, "admin", "key-id2", "all", nil, nil, nil, true, testSecret)
+	tassert.CheckFatal(t, err)
+	tk, err = tok.DecryptToken(key, testSecret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.IsAdmin && tk.ID == "key-id2", "expected admin's API key, got %+v", tk)
+
+	// refresh token carries no permissions
+	refresh, err := tok.RefreshJWT(expires, "alice", "refresh-id", testSecret)
+	tassert.CheckFatal(t, err)
+	tk, err = tok.DecryptToken(refresh, testSecret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.Refresh && tk.ID == "refresh-id", "expected refresh token, got %+v", tk)
+	err = tk.CheckPermissions(testCluID, &cmn.Bck{Name: "data", Provider: apc.AIS}, apc.AceGET)
+	tassert.Errorf(t, err != nil, "refresh token must not grant permissions")
+}
@@ -114,7 +114,7 @@ func testUserDelete(mgr *mgr, t *testing.T) {
 
 	loginMsg := &authn.LoginMsg{}
 	token, _, err := mgr.issueToken(username, userpass, loginMsg)
-	if err != nil || token == "" {
+	if err != nil || token.Token == "" {
 		t.Errorf("Failed to generate token for %s: %v", username, err)
 	}
 
@@ -153,7 +153,6 @@ func TestToken(t *testing.T) {
 	}
 	var (
 		err    error
-		token  string
 		secret = Conf.Secret()
 	)
 
@@ -176,10 +175,11 @@ func TestToken(t *testing.T) {
 	// correct user creds
 	shortExpiration := 2 * time.Second
 	loginMsg := &authn.LoginMsg{ExpiresIn: &shortExpiration}
-	token, _, err = mgr.issueToken(users[1], passs[1], loginMsg)
-	if err != nil || token == "" {
-		t.Errorf("Failed to generate token for %s: %v", users[1], err)
+	repl, _, err := mgr.issueToken(users[1], passs[1], loginMsg)
+	if err != nil || repl.Token == "" {
+		t.Fatalf("Failed to generate token for %s: %v", users[1], err)
 	}
+	token := repl.Token
 	info, err := tok.DecryptToken(token, secret)
 	if err != nil {
 		t.Fatalf("Failed to decrypt token %v: %v", token, err)
@@ -190,9 +190,9 @@ func TestToken(t *testing.T) {
 
 	// incorrect user creds
 	loginMsg = &authn.LoginMsg{}
-	tokenInval, _, err := mgr.issueToken(users[1], passs[0], loginMsg)
-	if tokenInval != "" || err == nil {
-		t.Errorf("Some token generated for incorrect user creds: %v", tokenInval)
+	replInval, _, err := mgr.issueToken(users[1], passs[0], loginMsg)
+	if replInval != nil || err == nil {
+		t.Errorf("Some token generated for incorrect user creds: %v", replInval)
 	}
 
 	// expired token test
@@ -204,6 +204,273 @@ func TestToken(t *testing.T) {
 	}
 }
 
+func TestS3Key(t *testing.T) {
+	var (
+		secret = Conf.Secret()
+		expire = time.Hour
+	)
+	driver := mock.NewDBDriver()
+	mgr, _, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+
+	key, _, err := mgr.addS3Key(users[0], &authn.S3KeyMsg{ExpiresIn: &expire})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, key.SecretKey == tok.S3Secret(key.AccessKey, secret), "unexpected secret key")
+	tassert.Errorf(t, len(key.SecretKey) == 40, "expected 40-character secret key, got %d", len(key.SecretKey))
+
+	tk, err := tok.DecryptToken(key.AccessKey, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.AccessKey, "expected S3 access key")
+	tassert.Errorf(t, tk.UserID == users[0], "invalid user %s (expected %s)", tk.UserID, users[0])
+	tassert.CheckError(t, tk.CheckPermissions("test-clu-id", &cmn.Bck{Name: "b", Provider: apc.AIS}, apc.AceGET))
+
+	// secret key is never stored
+	keys, _, err := mgr.s3Keys(users[0])
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(keys) == 1 && keys[0].AccessKey == key.AccessKey, "expected one key, got %d", len(keys))
+	tassert.Errorf(t, keys[0].SecretKey == "", "secret key must not be stored")
+
+	// other users
+	keys, _, err = mgr.s3Keys(users[1])
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(keys) == 0, "expected no keys, got %d", len(keys))
+	_, err = mgr.delS3Key(users[1], key.AccessKey)
+	tassert.Errorf(t, err != nil, "deleted another user's key")
+
+	// delete and revoke
+	_, err = mgr.delS3Key(users[0], key.AccessKey)
+	tassert.CheckFatal(t, err)
+	keys, _, err = mgr.s3Keys(users[0])
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(keys) == 0, "expected no keys, got %d", len(keys))
+	_, _, err = driver.GetString(revokedCollection, key.AccessKey)
+	tassert.Errorf(t, err == nil, "expected revoked S3 key")
+
+	// deleting user deletes (and revokes) all user's keys
+	_, _, err = mgr.addS3Key(users[2], &authn.S3KeyMsg{ExpiresIn: &expire})
+	tassert.CheckFatal(t, err)
+	_, err = mgr.delUser(users[2])
+	tassert.CheckFatal(t, err)
+	keys, _, err = mgr.s3Keys(users[2])
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(keys) == 0, "expected no keys, got %d", len(keys))
+}
+
+func TestRefreshToken(t *testing.T) {
+	secret := Conf.Secret()
+	driver := mock.NewDBDriver()
+	mgr, _, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+
+	repl, _, err := mgr.issueToken(users[0], passs[0], &authn.LoginMsg{})
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, repl.RefreshToken != "", "expected refresh token")
+	rtk, err := tok.DecryptToken(repl.RefreshToken, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, rtk.Refresh && rtk.ID != "" && len(rtk.ClusterACLs) == 0, "expected refresh token w/o permissions, got %+v", rtk)
+
+	// refresh
+	refreshed, _, err := mgr.refresh(repl.RefreshToken)
+	tassert.CheckFatal(t, err)
+	tk, err := tok.DecryptToken(refreshed.Token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, !tk.Refresh && tk.UserID == users[0] && tk.ID != "", "expected user's token, got %+v", tk)
+	tassert.CheckError(t, tk.CheckPermissions("test-clu-id", &cmn.Bck{Name: "b", Provider: apc.AIS}, apc.AceGET))
+
+	// not a refresh token
+	_, _, err = mgr.refresh(refreshed.Token)
+	tassert.Errorf(t, err != nil, "exchanged (regular) token for a new one")
+
+	// log out
+	_, err = mgr.revokeToken(repl.RefreshToken)
+	tassert.CheckFatal(t, err)
+	_, _, err = mgr.refresh(repl.RefreshToken)
+	tassert.Errorf(t, err != nil, "exchanged revoked refresh token")
+
+	// changing password invalidates all user's refresh tokens
+	repl, _, err = mgr.issueToken(users[0], passs[0], &authn.LoginMsg{})
+	tassert.CheckFatal(t, err)
+	_, err = mgr.updateUser(users[0], &authn.User{Password: "new-pass"})
+	tassert.CheckFatal(t, err)
+	_, _, err = mgr.refresh(repl.RefreshToken)
+	tassert.Errorf(t, err != nil, "exchanged refresh token after password change")
+}
+
+func TestAPIKey(t *testing.T) {
+	var (
+		secret = Conf.Secret()
+		expire = time.Hour
+		cluID  = "test-clu-id"
+		bck    = cmn.Bck{Name: "data", Provider: apc.AIS, Ns: cmn.Ns{UUID: cluID}}
+	)
+	driver := mock.NewDBDriver()
+	mgr, _, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	createUsers(mgr, t)
+	defer deleteUsers(mgr, true, t)
+	_, err = driver.Set(clustersCollection, cluID, &authn.CluACL{ID: cluID})
+	tassert.CheckFatal(t, err)
+
+	// scope cannot exceed user's (read-only) permissions
+	msg := &authn.APIKeyMsg{Name: "ci", ExpiresIn: &expire, Scope: []*authn.BckACL{{Bck: bck, Access: apc.AccessRW}}}
+	_, _, err = mgr.addAPIKey(users[0], msg)
+	tassert.Errorf(t, err != nil, "expected error: scope exceeds user's permissions")
+
+	msg.Scope = []*authn.BckACL{{Bck: bck, Access: apc.AceGET | apc.AceObjHEAD}}
+	key, _, err := mgr.addAPIKey(users[0], msg)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, key.Token != "" && key.ID != "", "expected API key token and ID")
+	_, _, err = mgr.addAPIKey(users[0], msg)
+	tassert.Errorf(t, err != nil, "expected error: duplicate API key name")
+
+	tk, err := tok.DecryptToken(key.Token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.APIKey == "ci" && tk.ID == key.ID, "unexpected API key token %+v", tk)
+	tassert.CheckError(t, tk.CheckPermissions(cluID, &cmn.Bck{Name: "data", Provider: apc.AIS}, apc.AceGET))
+	err = tk.CheckPermissions(cluID, &cmn.Bck{Name: "other", Provider: apc.AIS}, apc.AceGET)
+	tassert.Errorf(t, err != nil, "API key must be confined to its scope")
+	err = tk.CheckPermissions(cluID, nil, apc.AceListBuckets)
+	tassert.Errorf(t, err != nil, "API key must be confined to its scope")
+
+	// list (the token is never stored)
+	keys, _, err := mgr.apiKeys(users[0])
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(keys) == 1 && keys[0].ID == key.ID, "expected one key, got %d", len(keys))
+	tassert.Errorf(t, keys[0].Token == "", "token must not be stored")
+
+	// delete and revoke by ID
+	_, err = mgr.delAPIKey(users[1], key.ID)
+	tassert.Errorf(t, err != nil, "deleted another user's key")
+	_, err = mgr.delAPIKey(users[0], key.ID)
+	tassert.CheckFatal(t, err)
+	list, _, err := mgr.generateRevokedTokenList()
+	tassert.CheckFatal(t, err)
+	_, ok := list.IDs[key.ID]
+	tassert.Errorf(t, ok && len(list.Tokens) == 0, "expected revoked key ID, got %+v", list)
+}
+
+func TestRevokedCompaction(t *testing.T) {
+	var (
+		secret = Conf.Secret()
+		now    = time.Now()
+	)
+	driver := mock.NewDBDriver()
+	mgr, _, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+
+	// tokens that have IDs are revoked by ID
+	token, err := tok.JWT(now.Add(time.Hour), "user", "token-id", nil, nil, nil, secret)
+	tassert.CheckFatal(t, err)
+	_, err = mgr.revokeToken(token)
+	tassert.CheckFatal(t, err)
+	// legacy (no ID)
+	legacy, err := tok.JWT(now.Add(time.Hour), "user", "", nil, nil, nil, secret)
+	tassert.CheckFatal(t, err)
+	_, err = mgr.revokeToken(legacy)
+	tassert.CheckFatal(t, err)
+	// expired
+	_, err = mgr.revokeID("expired-id", now.Add(-time.Minute))
+	tassert.CheckFatal(t, err)
+
+	list, _, err := mgr.generateRevokedTokenList()
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(list.Tokens) == 1 && list.Tokens[0] == legacy, "expected legacy token, got %v", list.Tokens)
+	tassert.Errorf(t, len(list.IDs) == 1 && list.IDs["token-id"] != 0, "expected (only) non-expired ID, got %v", list.IDs)
+	_, _, err = driver.GetString(revokedIDsCollection, "expired-id")
+	tassert.Errorf(t, err != nil, "expected expired ID removed")
+}
+
+func TestS3KeyTenant(t *testing.T) {
+	var (
+		secret = Conf.Secret()
+		nsRole = &authn.Role{
+			Name:          "team-a-rw",
+			NamespaceACLs: []*authn.NsACL{{Ns: cmn.Ns{Name: "team-a"}, Access: apc.AccessRW}},
+		}
+	)
+	driver := mock.NewDBDriver()
+	mgr, _, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	user := &authn.User{ID: "tenant", Password: "pass", Roles: []*authn.Role{guestRole, nsRole}}
+	_, err = mgr.addUser(user)
+	tassert.CheckFatal(t, err)
+	defer mgr.delUser(user.ID)
+
+	// implied (the user's only namespace)
+	key, _, err := mgr.addS3Key(user.ID, &authn.S3KeyMsg{})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, key.Namespace == "team-a", "expected tenant's namespace, got %q", key.Namespace)
+	tk, err := tok.DecryptToken(key.AccessKey, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, tk.Tenant == "team-a" && len(tk.NamespaceACLs) == 1, "expected tenant's access key, got %+v", tk)
+
+	// explicit
+	key, _, err = mgr.addS3Key(user.ID, &authn.S3KeyMsg{Namespace: "#team-a"})
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, key.Namespace == "team-a", "expected tenant's namespace, got %q", key.Namespace)
+	_, _, err = mgr.addS3Key(user.ID, &authn.S3KeyMsg{Namespace: "team-b"})
+	tassert.Errorf(t, err != nil, "expected error: no permissions for the namespace")
+}
+
+func TestNsDelegation(t *testing.T) {
+	var (
+		nsAdmin = &tok.Token{
+			UserID:        "ns-admin",
+			NamespaceACLs: []*authn.NsACL{{Ns: cmn.Ns{Name: "team-a"}, Access: apc.AccessNsAdmin}},
+		}
+		nsUser = &tok.Token{
+			UserID:        "ns-user",
+			NamespaceACLs: []*authn.NsACL{{Ns: cmn.Ns{Name: "team-a"}, Access: apc.AccessRW}},
+		}
+		teamA = &authn.Role{
+			Name:          "team-a-ro",
+			NamespaceACLs: []*authn.NsACL{{Ns: cmn.Ns{Name: "team-a"}, Access: apc.AccessRO}},
+		}
+		teamABck = &authn.Role{
+			Name:       "team-a-bucket",
+			BucketACLs: []*authn.BckACL{{Bck: cmn.Bck{Name: "b", Provider: apc.AIS, Ns: cmn.Ns{Name: "team-a"}}, Access: apc.AccessRW}},
+		}
+		teamB = &authn.Role{
+			Name:          "team-b-ro",
+			NamespaceACLs: []*authn.NsACL{{Ns: cmn.Ns{Name: "team-b"}, Access: apc.AccessRO}},
+		}
+		clu = &authn.Role{
+			Name:          "cluster-ro",
+			ClusterACLs:   []*authn.CluACL{{ID: "test-clu-id", Access: apc.AccessRO}},
+			NamespaceACLs: teamA.NamespaceACLs,
+		}
+		admin = &authn.Role{Name: authn.AdminRole, NamespaceACLs: teamA.NamespaceACLs}
+	)
+	tassert.CheckError(t, nsDelegable(nsAdmin, teamA))
+	tassert.CheckError(t, nsDelegable(nsAdmin, teamABck))
+	for _, role := range []*authn.Role{teamB, clu, admin, {Name: "empty"}} {
+		tassert.Errorf(t, nsDelegable(nsAdmin, role) != nil, "namespace admin must not be able to delegate %q", role.Name)
+	}
+	tassert.Errorf(t, nsDelegable(nsUser, teamA) != nil, "namespace user must not be able to delegate")
+}
+
+func TestMergeNsACLS(t *testing.T) {
+	var (
+		nsA    = cmn.Ns{Name: "a"}
+		nsAClu = cmn.Ns{UUID: "clu", Name: "a"}
+		nsB    = cmn.Ns{UUID: "other", Name: "b"}
+		to     = []*authn.NsACL{{Ns: nsA, Access: apc.AccessRO}}
+		from   = []*authn.NsACL{
+			{Ns: nsA, Access: apc.AccessRW},
+			{Ns: nsAClu, Access: apc.AccessRO},
+			{Ns: nsB, Access: apc.AccessRO},
+		}
+	)
+	res := mergeNsACLs(to, from, "clu")
+	tassert.Fatalf(t, len(res) == 2, "expected 2 ACLs, got %d", len(res))
+	tassert.Errorf(t, res[0].Ns == nsA && res[0].Access == apc.AccessRW, "expected updated ACL, got %+v", res[0])
+	tassert.Errorf(t, res[1].Ns == nsAClu, "expected cluster's ACL, got %+v", res[1])
+}
+
 func TestMergeCluACLS(t *testing.T) {
 	tests := []struct {
 		title    string
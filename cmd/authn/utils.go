@@ -20,6 +20,18 @@ func (bckList bckACLList) updated(bckACL *authn.BckACL) bool {
 	return false
 }
 
+type nsACLList []*authn.NsACL
+
+func (nsList nsACLList) updated(nsACL *authn.NsACL) bool {
+	for _, acl := range nsList {
+		if acl.Ns == nsACL.Ns {
+			acl.Access = nsACL.Access
+			return true
+		}
+	}
+	return false
+}
+
 type cluACLList []*authn.CluACL
 
 func (cluList cluACLList) updated(cluACL *authn.CluACL) bool {
@@ -47,6 +59,21 @@ func mergeBckACLs(toACLs, fromACLs bckACLList, cluIDFlt string) []*authn.BckACL
 	return toACLs
 }
 
+// mergeNsACLs appends namespace ACLs from fromACLs which are not in toACL.
+// If a namespace ACL is already in the list, its permissions are updated.
+// If cluIDFlt is set, only ACLs for namespaces of the cluster with this ID (or any cluster) are appended.
+func mergeNsACLs(toACLs, fromACLs nsACLList, cluIDFlt string) []*authn.NsACL {
+	for _, n := range fromACLs {
+		if cluIDFlt != "" && n.Ns.UUID != cluIDFlt && n.Ns.UUID != "" {
+			continue
+		}
+		if !toACLs.updated(n) {
+			toACLs = append(toACLs, n)
+		}
+	}
+	return toACLs
+}
+
 // mergeClusterACLs appends cluster ACLs from fromACLs which are not in toACL.
 // If a cluster ACL is already in the list, its permissions are updated.
 // If cluIDFlt is set, only ACLs for cluster with this ID are appended.
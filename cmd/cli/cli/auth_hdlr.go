@@ -39,6 +39,8 @@ const (
 	flagsAuthRevokeToken = "revoke_token"
 	flagsAuthRoleShow    = "role_show"
 	flagsAuthConfShow    = "conf_show"
+	flagsAuthS3KeyAdd    = "s3key_add"
+	flagsAuthAPIKeyAdd   = "apikey_add"
 )
 
 const authnUnreachable = `AuthN unreachable at %s. You may need to update AIS CLI configuration or environment variable %s`
@@ -48,11 +50,13 @@ var (
 		flagsAuthUserLogin:   {tokenFileFlag, passwordFlag, expireFlag, clusterTokenFlag},
 		flagsAuthUserLogout:  {tokenFileFlag},
 		cmdAuthUser:          {passwordFlag},
-		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag},
+		flagsAuthRoleAddSet:  {descRoleFlag, clusterRoleFlag, bucketRoleFlag, nsRoleFlag},
 		flagsAuthRevokeToken: {tokenFileFlag},
 		flagsAuthUserShow:    {nonverboseFlag, verboseFlag},
 		flagsAuthRoleShow:    {nonverboseFlag, verboseFlag, clusterFilterFlag},
 		flagsAuthConfShow:    {jsonFlag},
+		flagsAuthS3KeyAdd:    {expireFlag, s3KeyNsFlag},
+		flagsAuthAPIKeyAdd:   {expireFlag, apiKeyBucketFlag, apiKeyClusterFlag},
 	}
 
 	// define separately to allow for aliasing (see alias_hdlr.go)
@@ -81,6 +85,20 @@ var (
 				ArgsUsage: showAuthUserListArgument,
 				Action:    wrapAuthN(showAuthUserHandler),
 			},
+			{
+				Name:         cmdAuthS3Key,
+				Usage:        "Show user's S3 access keys",
+				ArgsUsage:    showAuthS3KeyArgument,
+				Action:       wrapAuthN(showAuthS3KeyHandler),
+				BashComplete: oneUserCompletions,
+			},
+			{
+				Name:         cmdAuthAPIKey,
+				Usage:        "Show user's API keys",
+				ArgsUsage:    showAuthS3KeyArgument,
+				Action:       wrapAuthN(showAuthAPIKeyHandler),
+				BashComplete: oneUserCompletions,
+			},
 			{
 				Name:   cmdAuthConfig,
 				Usage:  "Show AuthN server configuration",
@@ -123,6 +141,26 @@ var (
 						Action:       wrapAuthN(addAuthRoleHandler),
 						BashComplete: addRoleCompletions,
 					},
+					{
+						Name: cmdAuthS3Key,
+						Usage: "Create S3 access key and secret key for the user (to sign S3 requests);\n" +
+							indent4 + "\tthe key carries user's current permissions; the secret key is shown only once",
+						ArgsUsage:    showAuthS3KeyArgument,
+						Flags:        sortFlags(authFlags[flagsAuthS3KeyAdd]),
+						Action:       wrapAuthN(addAuthS3KeyHandler),
+						BashComplete: oneUserCompletions,
+					},
+					{
+						Name: cmdAuthAPIKey,
+						Usage: "Create named API key for the user (e.g., for CI jobs that must not store passwords);\n" +
+							indent4 + "\tthe key is a bearer token that is scoped to the specified buckets and permissions, if any;\n" +
+							indent4 + "\tthe key is shown only once, e.g.:\n" +
+							indent4 + "\t- 'ais auth add apikey alice ci-job ro --bucket ais://data --cluster mycluster --expire 720h'",
+						ArgsUsage:    addAuthAPIKeyArgument,
+						Flags:        sortFlags(authFlags[flagsAuthAPIKeyAdd]),
+						Action:       wrapAuthN(addAuthAPIKeyHandler),
+						BashComplete: oneUserCompletions,
+					},
 				},
 			},
 			// rm
@@ -158,6 +196,20 @@ var (
 						ArgsUsage: deleteAuthTokenArgument,
 						Action:    wrapAuthN(revokeTokenHandler),
 					},
+					{
+						Name:         cmdAuthS3Key,
+						Usage:        "Remove (and revoke) S3 access key",
+						ArgsUsage:    deleteAuthS3KeyArgument,
+						Action:       wrapAuthN(deleteAuthS3KeyHandler),
+						BashComplete: oneUserCompletions,
+					},
+					{
+						Name:         cmdAuthAPIKey,
+						Usage:        "Remove (and revoke) API key",
+						ArgsUsage:    deleteAuthAPIKeyArgument,
+						Action:       wrapAuthN(deleteAuthAPIKeyHandler),
+						BashComplete: oneUserCompletions,
+					},
 				},
 			},
 			// set
@@ -290,6 +342,12 @@ func filterRolesByCluster(roles []*authn.Role, clusters []string) ([]*authn.Role
 				break
 			}
 		}
+		for _, acl := range role.NamespaceACLs {
+			if cos.StringInSlice(acl.Ns.UUID, cluIDs) {
+				filtered = append(filtered, role)
+				break
+			}
+		}
 	}
 	return filtered, nil
 }
@@ -587,11 +645,15 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 		args    = c.Args()
 		cluster = parseStrFlag(c, clusterRoleFlag)
 		bucket  = parseStrFlag(c, bucketRoleFlag)
+		ns      = parseStrFlag(c, nsRoleFlag)
 		role    = args.Get(0)
 	)
 	if bucket != "" && cluster == "" {
 		return nil, fmt.Errorf("flag %s requires %s to be specified", qflprn(bucketRoleFlag), qflprn(clusterRoleFlag))
 	}
+	if bucket != "" && ns != "" {
+		return nil, fmt.Errorf("flags %s and %s are mutually exclusive", qflprn(bucketRoleFlag), qflprn(nsRoleFlag))
+	}
 
 	if cluster != "" {
 		cluList, err := authn.GetRegisteredClusters(authParams, authn.CluACL{})
@@ -628,7 +690,19 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 		Name:        role,
 		Description: parseStrFlag(c, descRoleFlag),
 	}
-	if bucket != "" {
+	switch {
+	case ns != "":
+		name := strings.TrimPrefix(ns, string(apc.NsNamePrefix))
+		if err := cos.CheckAlphaPlus(name, "namespace"); err != nil {
+			return nil, err
+		}
+		roleACL.NamespaceACLs = []*authn.NsACL{
+			{
+				Ns:     cmn.Ns{UUID: cluster, Name: name},
+				Access: perms,
+			},
+		}
+	case bucket != "":
 		bck, err := parseBckURI(c, bucket, false)
 		if err != nil {
 			return nil, err
@@ -640,7 +714,7 @@ func addOrUpdateRole(c *cli.Context) (*authn.Role, error) {
 				Access: perms,
 			},
 		}
-	} else {
+	default:
 		roleACL.ClusterACLs = []*authn.CluACL{
 			{
 				ID:     cluster,
@@ -703,8 +777,123 @@ func revokeTokenHandler(c *cli.Context) (err error) {
 	if err := jsoniter.Unmarshal(b, msg); err != nil {
 		return fmt.Errorf("invalid token %q format: %v", tokenFilePath, err)
 	}
-	return authn.RevokeToken(authParams, msg.Token)
+	if err := authn.RevokeToken(authParams, msg.Token); err != nil {
+		return err
+	}
+	if msg.RefreshToken != "" {
+		return authn.RevokeToken(authParams, msg.RefreshToken)
+	}
+	return nil
+}
+
+func addAuthAPIKeyHandler(c *cli.Context) error {
+	var (
+		userID = c.Args().Get(0)
+		msg    = &authn.APIKeyMsg{Name: c.Args().Get(1)}
+	)
+	if userID == "" || msg.Name == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if flagIsSet(c, expireFlag) {
+		msg.ExpiresIn = apc.Ptr(parseDurationFlag(c, expireFlag))
+	}
+	perms := apc.AccessNone
+	for i := 2; i < c.NArg(); i++ {
+		p, err := apc.StrToAccess(c.Args().Get(i))
+		if err != nil {
+			return err
+		}
+		perms |= p
+	}
+	if flagIsSet(c, apiKeyBucketFlag) {
+		if perms == apc.AccessNone {
+			return fmt.Errorf("flag %s requires permissions, e.g. 'ro'", qflprn(apiKeyBucketFlag))
+		}
+		for _, uri := range splitCsv(parseStrFlag(c, apiKeyBucketFlag)) {
+			bck, err := parseBckURI(c, uri, false)
+			if err != nil {
+				return err
+			}
+			bck.Ns.UUID = parseStrFlag(c, apiKeyClusterFlag)
+			msg.Scope = append(msg.Scope, &authn.BckACL{Bck: bck, Access: perms})
+		}
+	} else if perms != apc.AccessNone {
+		return fmt.Errorf("permissions require %s (the buckets to scope the API key to)", qflprn(apiKeyBucketFlag))
+	}
+	key, err := authn.AddAPIKey(authParams, userID, msg)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.App.Writer, "ID:     ", key.ID)
+	fmt.Fprintln(c.App.Writer, "Token:  ", key.Token)
+	fmt.Fprintln(c.App.Writer, "Expires:", teb.FmtTime(key.Expires))
+	actionNote(c, "save the token - it cannot be retrieved later")
+	return nil
+}
+
+func showAuthAPIKeyHandler(c *cli.Context) error {
+	userID := c.Args().Get(0)
+	if userID == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	keys, err := authn.GetAPIKeys(authParams, userID)
+	if err != nil {
+		return err
+	}
+	return teb.Print(keys, teb.AuthNAPIKeyTmpl)
+}
+
+func deleteAuthAPIKeyHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	return authn.DeleteAPIKey(authParams, c.Args().Get(0), c.Args().Get(1))
+}
+
+func addAuthS3KeyHandler(c *cli.Context) error {
+	var (
+		expireIn *time.Duration
+		userID   = c.Args().Get(0)
+	)
+	if userID == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	if flagIsSet(c, expireFlag) {
+		expireIn = apc.Ptr(parseDurationFlag(c, expireFlag))
+	}
+	key, err := authn.AddS3Key(authParams, userID, expireIn, parseStrFlag(c, s3KeyNsFlag))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.App.Writer, "Access key:", key.AccessKey)
+	fmt.Fprintln(c.App.Writer, "Secret key:", key.SecretKey)
+	fmt.Fprintln(c.App.Writer, "Expires:   ", teb.FmtTime(key.Expires))
+	if key.Namespace != "" {
+		fmt.Fprintln(c.App.Writer, "Namespace: ", string(apc.NsNamePrefix)+key.Namespace)
+	}
+	actionNote(c, "save the secret key - it cannot be retrieved later")
+	return nil
 }
+
+func showAuthS3KeyHandler(c *cli.Context) error {
+	userID := c.Args().Get(0)
+	if userID == "" {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	keys, err := authn.GetS3Keys(authParams, userID)
+	if err != nil {
+		return err
+	}
+	return teb.Print(keys, teb.AuthNS3KeyTmpl)
+}
+
+func deleteAuthS3KeyHandler(c *cli.Context) error {
+	if c.NArg() < 2 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	return authn.DeleteS3Key(authParams, c.Args().Get(0), c.Args().Get(1))
+}
+
 func showAuthConfigHandler(c *cli.Context) (err error) {
 	conf, err := authn.GetConfig(authParams)
 	if err != nil {
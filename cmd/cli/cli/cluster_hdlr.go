@@ -12,6 +12,7 @@ import (
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/xact"
@@ -55,19 +56,23 @@ var (
 		cmdJoin: {
 			roleFlag,
 			nonElectableFlag,
+			rebDryRunFlag,
 		},
 		cmdStartMaint: {
 			noRebalanceFlag,
 			yesFlag,
+			rebDryRunFlag,
 		},
 		cmdStopMaint: {
 			noRebalanceFlag,
 			yesFlag,
+			rebDryRunFlag,
 		},
 		cmdShutdown + ".node": {
 			noRebalanceFlag,
 			rmUserDataFlag,
 			yesFlag,
+			rebDryRunFlag,
 		},
 		cmdNodeDecommission + ".node": {
 			noRebalanceFlag,
@@ -75,6 +80,7 @@ var (
 			rmUserDataFlag,
 			keepInitialConfigFlag,
 			yesFlag,
+			rebDryRunFlag,
 		},
 		cmdClusterDecommission: {
 			rmUserDataFlag,
@@ -175,8 +181,9 @@ var (
 				Usage: "Manage cluster membership (add/remove nodes, temporarily or permanently)",
 				Subcommands: []cli.Command{
 					{
-						Name:      cmdJoin,
-						Usage:     "Add a node to the cluster",
+						Name: cmdJoin,
+						Usage: "Add a node to the cluster\n" +
+							indent1 + "\t(with " + qflprn(rebDryRunFlag) + " more than one target address can be specified)",
 						ArgsUsage: joinNodeArgument,
 						Flags:     sortFlags(clusterCmdsFlags[cmdJoin]),
 						Action:    joinNodeHandler,
@@ -343,6 +350,9 @@ func joinNodeHandler(c *cli.Context) (err error) {
 	if c.NArg() < 1 {
 		return missingArgumentsError(c, "public IPv4:PORT address to communicate with the node")
 	}
+	if flagIsSet(c, rebDryRunFlag) {
+		return joinDryRun(c)
+	}
 	addr = c.Args().Get(0)
 	addrParts = strings.Split(addr, ":")
 	if len(addrParts) != 2 {
@@ -404,7 +414,41 @@ func joinNodeHandler(c *cli.Context) (err error) {
 
 // (compare w/ cluster-level clusterDecommissionHandler & clusterShutdownHandler)
 //
+// rebalance dry-run: resolve (possibly, multiple) addresses of the nodes to join
+//
 //nolint:staticcheck // `fmterr` with punctuation for usability
+func joinDryRun(c *cli.Context) error {
+	msg := &apc.RebPlanMsg{}
+	for _, addr := range c.Args() {
+		if !strings.Contains(addr, "://") {
+			addr = getPrefixFromPrimary() + addr
+		}
+		bp := apiBP
+		bp.URL = addr
+		snode, err := api.GetSnode(bp)
+		if err != nil {
+			return fmt.Errorf("failed to reach %s: %v", addr, err)
+		}
+		if !snode.IsTarget() {
+			return fmt.Errorf("option %s applies only to targets (%s is a proxy)", qflprn(rebDryRunFlag), addr)
+		}
+		msg.Add = append(msg.Add, snode.ID())
+	}
+	return showRebPlan(c, msg)
+}
+
+func showRebPlan(c *cli.Context, msg *apc.RebPlanMsg) error {
+	plan, err := api.RebalancePlan(apiBP, msg)
+	if err != nil {
+		return V(err)
+	}
+	if plan.Total.Objs == 0 {
+		fmt.Fprintln(c.App.Writer, "Nothing to rebalance")
+		return nil
+	}
+	return teb.Print(plan, teb.RebPlanTmpl)
+}
+
 func nodeMaintShutDecommHandler(c *cli.Context) error {
 	if c.NArg() < 1 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
@@ -421,6 +465,16 @@ func nodeMaintShutDecommHandler(c *cli.Context) error {
 	if smap.IsPrimary(node) {
 		return fmt.Errorf("%s is primary (cannot %s the primary node)", sname, action)
 	}
+	if flagIsSet(c, rebDryRunFlag) {
+		if !node.IsTarget() {
+			return fmt.Errorf("option %s applies only to targets (%s is a proxy)", qflprn(rebDryRunFlag), sname)
+		}
+		msg := &apc.RebPlanMsg{Remove: []string{node.ID()}}
+		if action == cmdStopMaint {
+			msg = &apc.RebPlanMsg{Add: []string{node.ID()}}
+		}
+		return showRebPlan(c, msg)
+	}
 	var (
 		xid               string
 		skipRebalance     = flagIsSet(c, noRebalanceFlag) || node.IsProxy()
@@ -181,6 +181,8 @@ const (
 	cmdAuthRole    = "role"
 	cmdAuthCluster = cmdCluster
 	cmdAuthToken   = "token"
+	cmdAuthS3Key   = "s3key"
+	cmdAuthAPIKey  = "apikey"
 	cmdAuthConfig  = cmdConfig
 
 	// K8s subcommans
@@ -383,6 +385,11 @@ const (
 	addSetAuthRoleArgument    = "ROLE [PERMISSION ...]"
 	deleteAuthRoleArgument    = "ROLE"
 	deleteAuthTokenArgument   = "TOKEN | TOKEN_FILE" //nolint:gosec // false positive G101
+	showAuthS3KeyArgument     = "USER_NAME"
+	deleteAuthS3KeyArgument   = "USER_NAME ACCESS_KEY"
+
+	addAuthAPIKeyArgument    = "USER_NAME KEY_NAME [PERMISSION ...]"
+	deleteAuthAPIKeyArgument = "USER_NAME KEY_ID"
 
 	// Alias
 	aliasURLPairArgument = "ALIAS=URL (or UUID=URL)"
@@ -526,6 +533,11 @@ var (
 	progressFlag = cli.BoolFlag{Name: "progress", Usage: "Show progress bar(s) and progress of execution in real time"}
 	dryRunFlag   = cli.BoolFlag{Name: "dry-run", Usage: "Preview the results without really running the action"}
 
+	rebDryRunFlag = cli.BoolFlag{
+		Name:  dryRunFlag.Name,
+		Usage: "Show rebalance plan: number of objects and bytes that would move between targets (no changes are made)",
+	}
+
 	verboseFlag    = cli.BoolFlag{Name: "verbose,v", Usage: "Verbose output"}
 	verboseJobFlag = cli.BoolFlag{Name: verboseFlag.Name, Usage: "Show extended statistics"}
 
@@ -698,6 +710,12 @@ var (
 		Usage: "Can be used in combination with " + qflprn(refreshFlag) + " to override configured '" + nodeLogFlushName + "'",
 		Value: logFlushTime,
 	}
+	logSlowFlag = cli.BoolFlag{
+		Name: "slow",
+		Usage: "Show recent slow GET requests with their per-phase timing: namelock wait, metadata load,\n" +
+			indent4 + "\tremote backend, checksum validation, disk read, and network write (see 'log.slow_request_time' config);\n" +
+			indent4 + "\twhen no target is specified, show slow requests from all targets",
+	}
 
 	// Download
 	descJobFlag = cli.StringFlag{Name: "description,desc", Usage: "job description"}
@@ -1056,6 +1074,22 @@ var (
 		Usage: "Comma-separated list of AIS cluster IDs (type ',' for an empty cluster ID)",
 	}
 
+	nsRoleFlag = cli.StringFlag{
+		Name: "namespace",
+		Usage: "Associate role with the specified bucket namespace (tenant), e.g. '#team-a';\n" +
+			indent4 + "\tuse 'ns-admin' permission to designate namespace admin",
+	}
+	s3KeyNsFlag = cli.StringFlag{
+		Name:  "namespace",
+		Usage: "Namespace (tenant) that S3 bucket names resolve to (default: user's only namespace, if any)",
+	}
+	apiKeyBucketFlag = cli.StringFlag{
+		Name: "bucket",
+		Usage: "Comma-separated list of buckets the API key is scoped to (with the specified permissions);\n" +
+			indent4 + "\twithout this flag, the key carries all user's current permissions",
+	}
+	apiKeyClusterFlag = cli.StringFlag{Name: "cluster", Usage: "AIS cluster (ID or alias) of the buckets the API key is scoped to"}
+
 	// archive
 	listArchFlag = cli.BoolFlag{Name: "archive", Usage: "List archived content (see docs/archive.md for details)"}
 
@@ -1263,6 +1297,10 @@ var (
 		Name:  "mountpath",
 		Usage: "Show target mountpaths with underlying disks and used/available capacities",
 	}
+	perfBucketFlag = cli.BoolFlag{
+		Name:  "bucket",
+		Usage: "Show per-bucket (and, with authentication enabled, per-user) GET, PUT, DELETE, and list-objects traffic",
+	}
 
 	// LRU
 	lruBucketsFlag = cli.StringFlag{
@@ -11,13 +11,16 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/sys"
 
 	"github.com/urfave/cli"
@@ -44,6 +47,10 @@ var (
 			longRunFlags,
 			logSevFlag,
 			logFlushFlag,
+			logSlowFlag,
+			unitsFlag,
+			noHeaderFlag,
+			jsonFlag,
 		),
 		commandGet: append(
 			longRunFlags,
@@ -56,8 +63,9 @@ var (
 	// 'show log' and 'log show'
 	showCmdLog = cli.Command{
 		Name: cmdLog,
-		Usage: fmt.Sprintf("For a given node: show its current log (use %s to update, %s for details)",
-			qflprn(refreshFlag), qflprn(cli.HelpFlag)),
+		Usage: fmt.Sprintf("For a given node: show its current log (use %s to update, %s for details);\n"+
+			indent1+"with %s: show recent slow GET requests and their per-phase timing",
+			qflprn(refreshFlag), qflprn(cli.HelpFlag), qflprn(logSlowFlag)),
 		ArgsUsage:    showLogArgument,
 		Flags:        sortFlags(nodeLogFlags[commandShow]),
 		Action:       showNodeLogHandler,
@@ -87,9 +95,77 @@ var (
 )
 
 func showNodeLogHandler(c *cli.Context) error {
+	if flagIsSet(c, logSlowFlag) {
+		return showSlowReqsHandler(c)
+	}
 	return _currentLog(c)
 }
 
+func showSlowReqsHandler(c *cli.Context) error {
+	var (
+		list        stats.SlowReqList
+		usejs       = flagIsSet(c, jsonFlag)
+		hideHeader  = flagIsSet(c, noHeaderFlag)
+		units, errU = parseUnitsFlag(c, unitsFlag)
+	)
+	if errU != nil {
+		return errU
+	}
+	node, sname, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	if node != nil && node.IsProxy() {
+		return fmt.Errorf("%s is a proxy (slow requests are tracked by targets)", sname)
+	}
+
+	setLongRunParams(c, 72)
+
+	if node != nil {
+		list, err = api.GetSlowRequests(apiBP, node)
+	} else {
+		list, err = _allSlowReqs(c)
+	}
+	if err != nil {
+		return V(err)
+	}
+	if len(list) == 0 && !usejs {
+		if !hideHeader {
+			fmt.Fprintln(c.App.Writer, "No slow requests")
+		}
+		return nil
+	}
+	if usejs {
+		return teb.Print(list, "", teb.Jopts(usejs))
+	}
+	table := teb.NewSlowReqTab(list, units)
+	return teb.Print(list, table.Template(hideHeader))
+}
+
+// all targets, most recent first
+func _allSlowReqs(c *cli.Context) (stats.SlowReqList, error) {
+	smap, err := getClusterMap(c)
+	if err != nil {
+		return nil, err
+	}
+	var all stats.SlowReqList
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		list, err := api.GetSlowRequests(apiBP, tsi)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range list {
+			v.Node = tsi.StringEx()
+		}
+		all = append(all, list...)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Time > all[j].Time })
+	return all, nil
+}
+
 func getLogHandler(c *cli.Context) error {
 	if c.NArg() < 1 {
 		return missingArgumentsError(c, c.Command.ArgsUsage)
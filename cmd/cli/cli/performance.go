@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/NVIDIA/aistore/api"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
@@ -34,6 +35,8 @@ var verboseCounters = [...]string{
 	stats.LcacheCollisionCount,
 	stats.LcacheEvictedCount,
 	stats.LcacheFlushColdCount,
+	stats.LcacheMdxHitCount,
+	stats.LcacheMdxMissCount,
 	cos.StreamsOutObjCount,
 	cos.StreamsOutObjSize,
 	cos.StreamsInObjCount,
@@ -59,7 +62,7 @@ var (
 		Name:      commandPerf,
 		Usage:     showPerfArgument,
 		ArgsUsage: optionalTargetIDArgument,
-		Flags:     showPerfFlags,
+		Flags:     append(showPerfFlags, perfBucketFlag),
 		Action:    showPerfHandler,
 		Subcommands: []cli.Command{
 			showCounters,
@@ -113,6 +116,9 @@ func showPerfHandler(c *cli.Context) error {
 		return fmt.Errorf("misplaced flags in %v (hint: change the order of arguments or %s specific view)",
 			c.Args(), tabtab)
 	}
+	if flagIsSet(c, perfBucketFlag) {
+		return showBckTrafficHandler(c)
+	}
 
 	if err := showCountersHandler(c); err != nil {
 		return err
@@ -309,6 +315,13 @@ func showLatencyHandler(c *cli.Context) error {
 		if kind == stats.KindLatency {
 			continue
 		}
+		// percentiles (computed by the node over the last 'periodic.stats_time' interval)
+		if kind == stats.KindHistogram {
+			for _, p := range stats.Percentiles {
+				selected[stats.PercentileName(name, p)] = stats.KindLatency
+			}
+			continue
+		}
 
 		// - always show io-errors
 		// - other errors only if (get|put) and verbose
@@ -364,6 +377,10 @@ func _latency(c *cli.Context, metrics cos.StrKVs, mapBegin, mapEnd teb.StstMap,
 				continue
 			}
 			vend := end.Tracker[name]
+			if stats.IsPercentile(name) {
+				begin.Tracker[name] = vend // the latest
+				continue
+			}
 			ncounter := stats.LatencyToCounter(name)
 			if ncounter == "" {
 				continue
@@ -571,3 +588,41 @@ func showMpathCapHandler(c *cli.Context) error {
 	out := table.Template(hideHeader)
 	return teb.Print(tstatusMap, out)
 }
+
+// per-bucket (and per-user) traffic: cluster-wide or, if specified, a given target
+func showBckTrafficHandler(c *cli.Context) error {
+	var (
+		list        stats.BckTrafficList
+		hideHeader  = flagIsSet(c, noHeaderFlag)
+		units, errU = parseUnitsFlag(c, unitsFlag)
+	)
+	if errU != nil {
+		return errU
+	}
+	node, sname, err := arg0Node(c)
+	if err != nil {
+		return err
+	}
+	if node != nil && node.IsProxy() {
+		return fmt.Errorf("%s is a proxy (per-bucket traffic is tracked by targets)", sname)
+	}
+
+	setLongRunParams(c, 72)
+
+	if node != nil {
+		list, err = api.GetBckTraffic(apiBP, node)
+	} else {
+		list, err = api.GetClusterBckTraffic(apiBP)
+	}
+	if err != nil {
+		return V(err)
+	}
+	if len(list) == 0 {
+		if !hideHeader {
+			fmt.Fprintln(c.App.Writer, "No traffic")
+		}
+		return nil
+	}
+	table := teb.NewBckTrafficTab(list, units)
+	return teb.Print(list, table.Template(hideHeader))
+}
@@ -0,0 +1,76 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"strconv"
+
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// per-bucket (and per-user) traffic; see also: stats.BckTraffic
+
+const (
+	colNamespace = "NAMESPACE"
+	colUser      = "USER"
+	colGetCnt    = "GET"
+	colGetSize   = "GET-SIZE"
+	colPutCnt    = "PUT"
+	colPutSize   = "PUT-SIZE"
+	colDelCnt    = "DELETE"
+	colLstCnt    = "LIST"
+)
+
+func NewBckTrafficTab(list stats.BckTrafficList, units string) *Table {
+	var (
+		cols = []*header{
+			{name: colBucket},
+			{name: colNamespace, hide: true},
+			{name: colUser, hide: true},
+			{name: colGetCnt},
+			{name: colGetSize},
+			{name: colPutCnt},
+			{name: colPutSize},
+			{name: colDelCnt},
+			{name: colLstCnt},
+		}
+		table = newTable(cols...)
+	)
+	for _, v := range list {
+		if v.Ns != "" {
+			cols[1].hide = false
+		}
+		if v.User != "" {
+			cols[2].hide = false
+		}
+		row := []string{
+			v.Bucket,
+			v.Ns,
+			v.User,
+			_fmtCnt(v.GetCount),
+			_fmtSize(v.GetSize, units),
+			_fmtCnt(v.PutCount),
+			_fmtSize(v.PutSize, units),
+			_fmtCnt(v.DelCount),
+			_fmtCnt(v.LstCount),
+		}
+		table.addRow(row)
+	}
+	return table
+}
+
+func _fmtCnt(n int64) string {
+	if n == 0 {
+		return zeroCnt
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+func _fmtSize(size int64, units string) string {
+	if size == 0 {
+		return zeroCnt
+	}
+	return FmtSize(size, units, 2)
+}
@@ -339,8 +339,15 @@ func _metricToPrintedColName(mname string, cols []*header, metrics, n2n cos.StrK
 	}
 
 	// middle name
-	l := len(parts) - 1
-	if parts[l] == "total" { // latency; see related: `stats.LatencyToCounter`
+	var (
+		l   = len(parts) - 1
+		pct string
+	)
+	switch {
+	case parts[l] == "total": // latency; see related: `stats.LatencyToCounter`
+		l--
+	case stats.IsPercentile(mname): // e.g. "get.ns.p99"
+		pct = parts[l]
 		l--
 	}
 	for j := 1; j < l; j++ {
@@ -352,7 +359,11 @@ func _metricToPrintedColName(mname string, cols []*header, metrics, n2n cos.StrK
 	case stats.KindThroughput, stats.KindComputedThroughput:
 		printedName += "(bw)"
 	case stats.KindLatency, stats.KindTotal:
-		printedName += "(t)"
+		if pct != "" {
+			printedName += "(" + pct + ")"
+		} else {
+			printedName += "(t)"
+		}
 	case stats.KindSize:
 		if n2n != nil && _present(cols, metrics, mname, n2n) {
 			printedName += "(total/avg size)"
@@ -0,0 +1,92 @@
+// Package teb contains templates and (templated) tables to format CLI output.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package teb
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// slow requests and their per-phase timing; see also: stats.SlowReq
+
+const (
+	colStarted = "STARTED"
+	colRequest = "REQUEST"
+	colSize    = "SIZE"
+	colTotal   = "TOTAL"
+	colLock    = "LOCK"
+	colLoad    = "LOAD"
+	colBackend = "BACKEND"
+	colCksum   = "CHECKSUM"
+	colError   = "ERROR"
+)
+
+func NewSlowReqTab(list stats.SlowReqList, units string) *Table {
+	var (
+		cols = []*header{
+			{name: colTarget, hide: true},
+			{name: colStarted},
+			{name: colRequest},
+			{name: colUser, hide: true},
+			{name: colSize},
+			{name: colTotal},
+			{name: colLock},
+			{name: colLoad},
+			{name: colBackend},
+			{name: colCksum},
+			{name: colRead},
+			{name: colWrite},
+			{name: colError, hide: true},
+		}
+		table = newTable(cols...)
+	)
+	for _, v := range list {
+		if v.Node != "" {
+			cols[0].hide = false
+		}
+		if v.User != "" {
+			cols[3].hide = false
+		}
+		var errs string
+		if v.Err != "" {
+			cols[len(cols)-1].hide = false
+			errs = v.Err
+			if v.Status != 0 {
+				errs = "(" + strconv.Itoa(v.Status) + ") " + errs
+			}
+		}
+		req := v.Method + " " + v.Cname
+		if v.ColdGET {
+			req += " (cold)"
+		}
+		row := []string{
+			v.Node,
+			cos.FormatNanoTime(v.Time, time.StampMilli),
+			req,
+			v.User,
+			_fmtSize(v.Size, units),
+			FmtDuration(v.Total, units),
+			_fmtPhase(v.Phases.Lock, units),
+			_fmtPhase(v.Phases.Load, units),
+			_fmtPhase(v.Phases.Backend, units),
+			_fmtPhase(v.Phases.Cksum, units),
+			_fmtPhase(v.Phases.Read, units),
+			_fmtPhase(v.Phases.Write, units),
+			errs,
+		}
+		table.addRow(row)
+	}
+	return table
+}
+
+func _fmtPhase(ns int64, units string) string {
+	if ns == 0 {
+		return zeroCnt
+	}
+	return FmtDuration(ns, units)
+}
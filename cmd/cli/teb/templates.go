@@ -69,6 +69,14 @@ const (
 	ObjLockTmpl      = objLockTmplHdr + ObjLockTmplNoHdr
 	ObjLockTmplNoHdr = "{{range $o := . }}" + "{{$o.Name}}\t {{$o.Status}}\n" + "{{end}}"
 
+	// rebalance dry-run (apc.RebPlan)
+	RebPlanTmpl = "SOURCE\t OBJECTS\t SIZE\n" +
+		"{{range $tid, $cnt := .Sources}}" + "{{$tid}}\t {{$cnt.Objs}}\t {{FormatBytesSig $cnt.Bytes 2}}\n" + "{{end}}" +
+		"\nDESTINATION\t OBJECTS\t SIZE\n" +
+		"{{range $tid, $cnt := .Dests}}" + "{{$tid}}\t {{$cnt.Objs}}\t {{FormatBytesSig $cnt.Bytes 2}}\n" + "{{end}}" +
+		"\nTotal to move:\t {{.Total.Objs}}\t {{FormatBytesSig .Total.Bytes 2}}\n" +
+		"{{if .EstTime}}Estimated time (at rebalance.bandwidth):\t {{.EstTime}}\n{{end}}"
+
 	// w/ special arrangement for feature flags
 	FeatDescTmplHdr = "FEATURE\t DESCRIPTION\n"
 
@@ -287,12 +295,14 @@ const (
 	ListBucketsTmplNoSummary = ListBucketsHdrNoSummary + ListBucketsBodyNoSummary
 
 	// Bucket summary templates
-	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, remote)\t USAGE(%)\n" +
+	BucketsSummariesTmpl = "NAME\t OBJECTS (cached, remote)\t OBJECT SIZES (min, avg, max)\t TOTAL OBJECT SIZE (cached, stored, remote)\t DEDUP SAVED\t USAGE(%)\t QUOTA(%)\n" +
 		BucketsSummariesBody
 	BucketsSummariesBody = "{{range $k, $v := . }}" +
 		"{{FormatBckName $v.Bck}}\t {{$v.ObjCount.Present}} {{$v.ObjCount.Remote}}\t " +
 		"{{FormatMAM $v.ObjSize.Min}} {{FormatMAM $v.ObjSize.Avg}} {{FormatMAM $v.ObjSize.Max}}\t " +
-		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t {{$v.UsedPct}}%\n" +
+		"{{FormatBytesUns $v.TotalSize.PresentObjs 2}} {{FormatBytesUns $v.TotalSize.StoredObjs 2}} {{FormatBytesUns $v.TotalSize.RemoteObjs 2}}\t " +
+		"{{if $v.TotalSize.DedupSaved}}{{FormatBytesUns $v.TotalSize.DedupSaved 2}}{{else}}-{{end}}\t {{$v.UsedPct}}%\t " +
+		"{{if (or $v.Quota.Size $v.Quota.Objects)}}{{$v.Quota.UsedPct}}%{{else}}-{{end}}\n" +
 		"{{end}}"
 
 	// For `object put` mass uploader. A caller adds to the template
@@ -342,6 +352,18 @@ See '--help' and docs/cli for details.`
 		"{{end}}\n" +
 		"{{end}}"
 
+	AuthNS3KeyTmpl = "EXPIRES\tNAMESPACE\tACCESS KEY\n" +
+		"{{ range $key := . }}" +
+		"{{ FormatEnd $key.Expires }}\t{{ if $key.Namespace }}#{{ $key.Namespace }}{{ else }}-{{ end }}\t{{ $key.AccessKey }}\n" +
+		"{{end}}"
+
+	AuthNAPIKeyTmpl = "ID\tNAME\tCREATED\tEXPIRES\tSCOPE\n" +
+		"{{ range $key := . }}" +
+		"{{ $key.ID }}\t{{ $key.Name }}\t{{ FormatStart $key.Created }}\t{{ FormatEnd $key.Expires }}\t" +
+		"{{ if $key.Scope }}{{ range $i, $acl := $key.Scope }}{{ if $i }}; {{ end }}" +
+		"{{ FormatBckName $acl.Bck }}: {{ FormatACL $acl.Access }}{{ end }}{{ else }}-{{ end }}\n" +
+		"{{end}}"
+
 	AuthNUserVerboseTmpl = "Name\t{{ .ID }}\n" +
 		"Roles\t{{ range $i, $role := .Roles }}{{ if $i }}, {{ end }}{{ $role.Name }}{{ end }}\n" +
 		"{{ range $role := .Roles }}" +
@@ -355,6 +377,11 @@ See '--help' and docs/cli for details.`
 		"{{ range $bck := $role.BucketACLs }}" +
 		"{{ FormatBckName $bck.Bck }}\t{{ FormatACL $bck.Access }}\n" +
 		"{{end}}{{end}}" +
+		"{{ if ne (len $role.NamespaceACLs) 0 }}" +
+		"NAMESPACE\tCLUSTER ID\tPERMISSIONS\n" +
+		"{{ range $ns := $role.NamespaceACLs }}" +
+		"#{{ $ns.Ns.Name }}\t{{ $ns.Ns.UUID }}\t{{ FormatACL $ns.Access }}\n" +
+		"{{end}}{{end}}" +
 		"{{ end }}"
 
 	AuthNRoleVerboseTmpl = "Role\t{{ .Name }}\n" +
@@ -368,6 +395,11 @@ See '--help' and docs/cli for details.`
 		"BUCKET\tPERMISSIONS\n" +
 		"{{ range $bck := .BucketACLs }}" +
 		"{{ FormatBckName $bck.Bck }}\t{{ FormatACL $bck.Access }}\n" +
+		"{{end}}{{end}}" +
+		"{{ if ne (len .NamespaceACLs) 0 }}" +
+		"NAMESPACE\tCLUSTER ID\tPERMISSIONS\n" +
+		"{{ range $ns := .NamespaceACLs }}" +
+		"#{{ $ns.Ns.Name }}\t{{ $ns.Ns.UUID }}\t{{ FormatACL $ns.Access }}\n" +
 		"{{end}}{{end}}"
 
 	// `search`
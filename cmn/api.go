@@ -57,6 +57,13 @@ type (
 		RateLimit   RateLimitConf   `json:"rate_limit"`                       // frontend and backend rate limiting - bursty and adaptive, respectively
 		EC          ECConf          `json:"ec"`                               // erasure coding
 		Mirror      MirrorConf      `json:"mirror"`                           // n-way mirroring
+		Chunks      ChunksConf      `json:"chunks"`                           // store large objects as chunks (see core/lchunk.go)
+		Pack        PackConf        `json:"pack"`                             // pack small objects (see core/lpack.go)
+		Compress    CompressConf    `json:"compress"`                         // compression at rest (see core/lcompr.go)
+		Dedup       DedupConf       `json:"dedup"`                            // content-addressed deduplication (see core/ldedup.go)
+		ReadAhead   ReadAheadConf   `json:"read_ahead"`                       // read ahead numbered objects (see ais/tgtra.go)
+		Repl        ReplConf        `json:"replication"`                      // async replication to remote AIS or cloud
+		Quota       QuotaLimits     `json:"quota"`                            // max size and/or number of objects
 		LRU         LRUConf         `json:"lru"`                              // LRU watermarks and enable/disable
 		Access      apc.AccessAttrs `json:"access,string"`                    // access permissions
 		Features    feat.Flags      `json:"features,string"`                  // to flip assorted enumerated defaults (e.g. "S3-Use-Path-Style"; see cmn/feat)
@@ -135,6 +142,13 @@ type (
 		Cksum       *CksumConfToSet       `json:"checksum,omitempty"`
 		LRU         *LRUConfToSet         `json:"lru,omitempty"`
 		Mirror      *MirrorConfToSet      `json:"mirror,omitempty"`
+		Chunks      *ChunksConfToSet      `json:"chunks,omitempty"`
+		Pack        *PackConfToSet        `json:"pack,omitempty"`
+		Compress    *CompressConfToSet    `json:"compress,omitempty"`
+		Dedup       *DedupConfToSet       `json:"dedup,omitempty"`
+		ReadAhead   *ReadAheadConfToSet   `json:"read_ahead,omitempty"`
+		Repl        *ReplConfToSet        `json:"replication,omitempty"`
+		Quota       *QuotaLimitsToSet     `json:"quota,omitempty"`
 		EC          *ECConfToSet          `json:"ec,omitempty"`
 		Access      *apc.AccessAttrs      `json:"access,string,omitempty"`
 		RateLimit   *RateLimitConfToSet   `json:"rate_limit,omitempty"`
@@ -184,7 +198,7 @@ func (bck *Bck) DefaultProps(c *ClusterConfig) *Bprops {
 	}
 
 	// inherit cluster defaults (w/ override via api.CreateBucket and api.SetBucketProps)
-	return &Bprops{
+	props := &Bprops{
 		Cksum:       cksum,
 		LRU:         lru,
 		Mirror:      c.Mirror,
@@ -193,8 +207,18 @@ func (bck *Bck) DefaultProps(c *ClusterConfig) *Bprops {
 		EC:          c.EC,
 		WritePolicy: wp,
 		RateLimit:   c.RateLimit,
+		Chunks:      c.Chunks,
+		Pack:        c.Pack,
+		Compress:    c.Compress,
+		Dedup:       c.Dedup,
+		ReadAhead:   c.ReadAhead,
 		Features:    c.Features,
 	}
+	// and then tenant's (namespace) defaults, if any
+	if t := c.Tenants.Get(bck.Ns); t != nil && t.Bprops != nil {
+		props.Apply(t.Bprops)
+	}
+	return props
 }
 
 func (bp *Bprops) SetProvider(provider string) {
@@ -236,7 +260,7 @@ func (bp *Bprops) Validate(targetCnt int) error {
 
 	// run assorted props validators
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit} {
+	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.Repl, &bp.Quota, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.RateLimit, &bp.Chunks, &bp.Pack, &bp.Compress, &bp.ReadAhead} {
 		var err error
 		switch {
 		case pv == &bp.EC:
@@ -256,6 +280,15 @@ func (bp *Bprops) Validate(targetCnt int) error {
 	if bp.Mirror.Enabled && bp.EC.Enabled {
 		nlog.Warningln("n-way mirroring and EC are both enabled at the same time on the same bucket")
 	}
+	if err := bp.Mirror.ValidateLocal(&bp.Chunks, &bp.Pack, &bp.Dedup); err != nil {
+		return err
+	}
+	if err := bp.Dedup.ValidateCksum(bp.Cksum.Type); err != nil {
+		return err
+	}
+	if bp.Dedup.Enabled && bp.Provider == apc.AIS && bp.EC.Enabled {
+		nlog.Warningln("deduplication is enabled but will not apply: excludes EC")
+	}
 
 	// not inheriting cluster-scope features
 	names := bp.Features.Names()
@@ -355,7 +388,13 @@ func aggr(from, to *BsummResult) {
 	to.ObjCount.Remote += from.ObjCount.Remote
 	to.TotalSize.OnDisk += from.TotalSize.OnDisk
 	to.TotalSize.PresentObjs += from.TotalSize.PresentObjs
+	to.TotalSize.StoredObjs += from.TotalSize.StoredObjs
+	to.TotalSize.DedupSaved += from.TotalSize.DedupSaved
 	to.TotalSize.RemoteObjs += from.TotalSize.RemoteObjs
+	to.Quota.Size = max(from.Quota.Size, to.Quota.Size)
+	to.Quota.Objects = max(from.Quota.Objects, to.Quota.Objects)
+	to.Quota.UsedSize += from.Quota.UsedSize
+	to.Quota.UsedObjs += from.Quota.UsedObjs
 }
 
 func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
@@ -377,6 +416,16 @@ func (s AllBsummResults) Finalize(dsize map[string]uint64, testingEnv bool) {
 		if totalDisksSize > 0 {
 			summ.UsedPct = cos.DivRoundU64(summ.TotalSize.OnDisk*100, totalDisksSize)
 		}
+		if q := &summ.Quota; q.Size > 0 || q.Objects > 0 {
+			var pct uint64
+			if q.Size > 0 {
+				pct = cos.DivRoundU64(uint64(max(q.UsedSize, 0))*100, uint64(q.Size))
+			}
+			if q.Objects > 0 {
+				pct = max(pct, cos.DivRoundU64(uint64(max(q.UsedObjs, 0))*100, uint64(q.Objects)))
+			}
+			q.UsedPct = pct
+		}
 	}
 }
 
@@ -134,8 +134,8 @@ func MimeFile(file cos.LomReader, smm *memsys.MMSA, mime, archname string) (m st
 	)
 	m, n, err = _detect(file, archname, m, buf)
 	if n > 0 {
-		fh, ok := file.(*os.File)
-		cos.Assertf(ok, "expecting os.File, got %T", file)
+		fh, ok := file.(io.Seeker) // (os.File or chunked object reader)
+		cos.Assertf(ok, "expecting io.Seeker, got %T", file)
 		_, errV := fh.Seek(0, io.SeekStart)
 		debug.AssertNoErr(errV)
 		if err == nil {
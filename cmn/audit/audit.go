@@ -0,0 +1,310 @@
+// Package audit provides (opt-in) audit trail of client requests: who did what,
+// to which bucket and/or object, when, and with what result.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package audit
+
+import (
+	"bufio"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Records are written, one JSON object per line, to local files named
+//   audit.<node-ID>.<timestamp>.jsonl
+// that get rotated upon reaching `audit.max_size`; the oldest files are removed
+// when the total exceeds `audit.max_total`.
+//
+// Optionally, records are also forwarded (in batches) to syslog or HTTP(S) endpoint -
+// on a best-effort basis: local files are the source of truth.
+//
+// Logging is asynchronous and never blocks the caller: when the queue is full
+// records are dropped (and the number of dropped records is logged).
+
+const (
+	queueSize = 4096
+	flushTime = time.Second // flush, forward, check configuration
+	errEvery  = time.Minute // throttle errors
+
+	filePrefix = "audit."
+	fileSuffix = ".jsonl"
+	fileTime   = "20060102-150405.000000"
+)
+
+type (
+	Record struct {
+		Time    time.Time     `json:"time"`
+		Node    string        `json:"node"`
+		User    string        `json:"user,omitempty"`         // principal (from the token); empty when authentication is disabled
+		Claimed string        `json:"claimed_user,omitempty"` // unverified user claimed by the (invalid) token
+		Remote  string        `json:"remote"`                 // client address
+		Method  string        `json:"method"`                 // HTTP verb
+		Action  string        `json:"action,omitempty"`
+		Path    string        `json:"path"`
+		Bucket  string        `json:"bucket,omitempty"`
+		Object  string        `json:"object,omitempty"`
+		Status  int           `json:"status"`
+		Latency time.Duration `json:"latency"` // nanoseconds
+	}
+
+	// captures response status
+	Writer struct {
+		http.ResponseWriter
+		Status int
+	}
+
+	logger struct {
+		confFn  func() *cmn.AuditConf
+		ch      chan *Record
+		file    *os.File
+		bw      *bufio.Writer
+		fwd     *forwarder
+		node    string
+		logDir  string
+		dir     string // current
+		sink    string // ditto
+		batch   []byte // to forward
+		size    int64  // current file
+		errTime int64  // mono time of the last logged error
+		dropped atomic.Int64
+	}
+)
+
+var g logger
+
+// Init is called once upon startup; `confFn` returns current configuration
+func Init(node, logDir string, confFn func() *cmn.AuditConf) {
+	g.node, g.logDir, g.confFn = node, logDir, confFn
+	g.ch = make(chan *Record, queueSize)
+	go g.run()
+}
+
+// Log enqueues the record (see "never blocks" above)
+// returns false when the record is dropped
+func Log(rec *Record) bool {
+	select {
+	case g.ch <- rec:
+		return true
+	default:
+		g.dropped.Inc()
+		return false
+	}
+}
+
+// Sample returns false when a successful object GET (or HEAD) is not to be recorded
+// (see cmn.AuditConf.GetSampling)
+func Sample(conf *cmn.AuditConf, cname string) bool {
+	rate := conf.SampleRate(cname)
+	return rate >= 1 || (rate > 0 && rand.Float64() < rate)
+}
+
+////////////
+// Writer //
+////////////
+
+func (w *Writer) WriteHeader(code int) {
+	if w.Status == 0 {
+		w.Status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *Writer) Write(b []byte) (int, error) {
+	if w.Status == 0 {
+		w.Status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// (see http.ResponseController)
+func (w *Writer) Unwrap() http.ResponseWriter { return w.ResponseWriter }
+
+func (w *Writer) StatusCode() int { return cos.NonZero(w.Status, http.StatusOK) }
+
+////////////
+// logger //
+////////////
+
+func (l *logger) run() {
+	ticker := time.NewTicker(flushTime)
+	for {
+		select {
+		case rec := <-l.ch:
+			l.write(rec)
+		case <-ticker.C:
+			l.housekeep()
+		}
+	}
+}
+
+func (l *logger) write(rec *Record) {
+	conf := l.confFn()
+	b, err := jsoniter.Marshal(rec)
+	if err != nil {
+		l.errorln("failed to marshal", rec.Path, "record:", err)
+		return
+	}
+	b = append(b, '\n')
+	if l.file != nil && l.size > 0 && l.size+int64(len(b)) > conf.MaxSizeX() {
+		l.close()
+	}
+	if l.file == nil {
+		if err := l.open(conf); err != nil {
+			l.errorln(err)
+			return
+		}
+	}
+	if _, err := l.bw.Write(b); err != nil {
+		l.errorln(err)
+		l.close()
+		return
+	}
+	l.size += int64(len(b))
+
+	l.setSink(conf)
+	if l.fwd != nil {
+		l.batch = append(l.batch, b...)
+		if len(l.batch) >= sinkBatch {
+			l.forward()
+		}
+	}
+}
+
+func (l *logger) housekeep() {
+	if n := l.dropped.Swap(0); n > 0 {
+		nlog.Warningln("audit: dropped", n, "records (queue full)")
+	}
+	conf := l.confFn()
+	switch {
+	case !conf.Enabled:
+		l.close()
+		l.closeSink()
+		return
+	case l.file != nil && conf.Dir != l.dir:
+		l.close()
+	}
+	l.setSink(conf)
+	if l.bw != nil {
+		if err := l.bw.Flush(); err != nil {
+			l.errorln(err)
+			l.close()
+		}
+	}
+	l.forward()
+}
+
+func (l *logger) open(conf *cmn.AuditConf) error {
+	dir := conf.Dir
+	if dir == "" {
+		dir = filepath.Join(l.logDir, "audit")
+	}
+	if err := cos.CreateDir(dir); err != nil {
+		return err
+	}
+	fqn := filepath.Join(dir, filePrefix+l.node+"."+time.Now().Format(fileTime)+fileSuffix)
+	file, err := os.OpenFile(fqn, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return err
+	}
+	l.file, l.dir, l.size = file, conf.Dir, 0
+	if l.bw == nil {
+		l.bw = bufio.NewWriterSize(file, 32*cos.KiB)
+	} else {
+		l.bw.Reset(file)
+	}
+	cleanup(dir, l.node, filepath.Base(fqn), conf.MaxTotalX())
+	return nil
+}
+
+func (l *logger) close() {
+	if l.file == nil {
+		return
+	}
+	if err := l.bw.Flush(); err != nil {
+		l.errorln(err)
+	}
+	cos.Close(l.file)
+	l.file = nil
+}
+
+func (l *logger) forward() {
+	if l.fwd == nil || len(l.batch) == 0 {
+		return
+	}
+	l.fwd.send(l.batch)
+	l.batch = nil // (handed over)
+}
+
+func (l *logger) setSink(conf *cmn.AuditConf) {
+	if conf.Sink == l.sink {
+		return
+	}
+	l.closeSink()
+	if conf.Sink != "" {
+		l.fwd = newForwarder(conf.Sink)
+	}
+	l.sink = conf.Sink
+}
+
+func (l *logger) closeSink() {
+	if l.fwd != nil {
+		l.fwd.stop()
+		l.fwd = nil
+	}
+	l.sink, l.batch = "", nil
+}
+
+func (l *logger) errorln(a ...any) {
+	if now := mono.NanoTime(); time.Duration(now-l.errTime) > errEvery {
+		l.errTime = now
+		nlog.Errorln(append([]any{"audit:"}, a...)...)
+	}
+}
+
+// remove the oldest files (of this node) when exceeding max total size
+func cleanup(dir, node, current string, maxTotal int64) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	var (
+		total  int64
+		prefix = filePrefix + node + "."
+		names  = make([]string, 0, len(entries))
+		sizes  = make([]int64, 0, len(entries))
+	)
+	for _, ent := range entries { // (sorted by name, and therefore by time)
+		name := ent.Name()
+		if ent.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+		finfo, err := ent.Info()
+		if err != nil {
+			continue
+		}
+		names = append(names, name)
+		sizes = append(sizes, finfo.Size())
+		total += finfo.Size()
+	}
+	for i := 0; i < len(names) && total > maxTotal; i++ {
+		if names[i] == current {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, names[i])); err == nil {
+			total -= sizes[i]
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Package audit provides (opt-in) audit trail of client requests: who did what,
+// to which bucket and/or object, when, and with what result.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package audit
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+func TestRotateCleanup(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		conf = &cmn.AuditConf{Enabled: true, Dir: dir, MaxSize: cos.KiB, MaxTotal: 4 * cos.KiB}
+		l    = &logger{node: "p1", confFn: func() *cmn.AuditConf { return conf }}
+	)
+	tassert.CheckFatal(t, conf.Validate())
+	for i := range 100 {
+		l.write(&Record{
+			Time:    time.Now(),
+			Node:    l.node,
+			User:    "admin",
+			Method:  http.MethodDelete,
+			Path:    "/v1/buckets/abc",
+			Bucket:  "ais://abc",
+			Status:  http.StatusOK,
+			Latency: time.Duration(i),
+		})
+		time.Sleep(time.Microsecond) // (unique file names)
+	}
+	l.housekeep()
+
+	entries, err := os.ReadDir(dir)
+	tassert.CheckFatal(t, err)
+	var (
+		total int64
+		n     int
+	)
+	for _, ent := range entries {
+		tassert.Errorf(t, strings.HasPrefix(ent.Name(), "audit.p1.") && strings.HasSuffix(ent.Name(), fileSuffix),
+			"unexpected file name %q", ent.Name())
+		finfo, err := ent.Info()
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, finfo.Size() <= int64(conf.MaxSize), "%q exceeds max size: %d", ent.Name(), finfo.Size())
+		total += finfo.Size()
+
+		// JSONL
+		fh, err := os.Open(filepath.Join(dir, ent.Name()))
+		tassert.CheckFatal(t, err)
+		scanner := bufio.NewScanner(fh)
+		for scanner.Scan() {
+			var rec Record
+			tassert.CheckFatal(t, jsoniter.Unmarshal(scanner.Bytes(), &rec))
+			tassert.Errorf(t, rec.User == "admin" && rec.Bucket == "ais://abc", "unexpected record %+v", rec)
+			n++
+		}
+		fh.Close()
+	}
+	tassert.Errorf(t, len(entries) > 1, "expected rotation, got %d file(s)", len(entries))
+	tassert.Errorf(t, total <= int64(conf.MaxTotal)+int64(conf.MaxSize), "expected cleanup, total %d", total)
+	tassert.Errorf(t, n > 0 && n < 100, "expected some (but not all) records to remain, got %d", n)
+
+	// disable
+	conf.Enabled = false
+	l.housekeep()
+	tassert.Errorf(t, l.file == nil, "expected closed file")
+}
+
+func TestSample(t *testing.T) {
+	conf := &cmn.AuditConf{GetSampling: map[string]float64{"abc": 0, "s3://xyz": 0.5, "*": 1}}
+	tassert.CheckFatal(t, conf.Validate())
+
+	_, ok := conf.GetSampling["ais://abc"]
+	tassert.Fatalf(t, ok, "expected normalized bucket name, got %v", conf.GetSampling)
+	for range 100 {
+		tassert.Fatalf(t, !Sample(conf, "ais://abc"), "expected no sampling")
+		tassert.Fatalf(t, Sample(conf, "gs://any"), "expected sampling")
+	}
+	var n int
+	for range 1000 {
+		if Sample(conf, "s3://xyz") {
+			n++
+		}
+	}
+	tassert.Errorf(t, n > 300 && n < 700, "expected roughly half, got %d/1000", n)
+
+	for _, c := range []cmn.AuditConf{
+		{GetSampling: map[string]float64{"abc": 2}},
+		{GetSampling: map[string]float64{"ais://abc/obj": 1}},
+		{Sink: "ftp://host"},
+		{Sink: "http://"},
+		{MaxSize: cos.GiB, MaxTotal: cos.GiB},
+	} {
+		tassert.Errorf(t, c.Validate() != nil, "expected %+v to fail validation", c)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w := &Writer{ResponseWriter: rec}
+	w.Write([]byte("ok"))
+	tassert.Errorf(t, w.StatusCode() == http.StatusOK, "expected 200, got %d", w.StatusCode())
+
+	w = &Writer{ResponseWriter: httptest.NewRecorder()}
+	tassert.Errorf(t, w.StatusCode() == http.StatusOK, "expected 200, got %d", w.StatusCode())
+	w.WriteHeader(http.StatusNotFound)
+	w.WriteHeader(http.StatusOK) // superfluous
+	tassert.Errorf(t, w.StatusCode() == http.StatusNotFound, "expected 404, got %d", w.StatusCode())
+}
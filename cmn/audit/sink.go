@@ -0,0 +1,159 @@
+// Package audit provides (opt-in) audit trail of client requests: who did what,
+// to which bucket and/or object, when, and with what result.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package audit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log/syslog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// forwarding to external sink (see cmn.AuditConf.Sink)
+
+const (
+	sinkBatch   = 256 * cos.KiB
+	sinkQueue   = 64 // batches
+	sinkTimeout = 10 * time.Second
+	syslogTag   = "aistore-audit"
+)
+
+type (
+	sink interface {
+		send(lines []byte) error
+		close()
+	}
+	syslogSink struct {
+		w *syslog.Writer
+	}
+	httpSink struct {
+		client *http.Client
+		url    string
+	}
+
+	forwarder struct {
+		s       sink
+		ch      chan []byte
+		raw     string
+		errTime int64
+	}
+)
+
+func newForwarder(raw string) *forwarder {
+	f := &forwarder{raw: raw, ch: make(chan []byte, sinkQueue)}
+	go f.run()
+	return f
+}
+
+// non-blocking (see logger)
+func (f *forwarder) send(batch []byte) {
+	select {
+	case f.ch <- batch:
+	default:
+		f.errorln(errors.New("queue full, dropping batch"))
+	}
+}
+
+func (f *forwarder) stop() { close(f.ch) }
+
+func (f *forwarder) run() {
+	for batch := range f.ch {
+		if f.s == nil {
+			s, err := newSink(f.raw)
+			if err != nil {
+				f.errorln(err)
+				continue
+			}
+			f.s = s
+		}
+		if err := f.s.send(batch); err != nil {
+			f.errorln(err)
+			f.s.close()
+			f.s = nil // reconnect
+		}
+	}
+	if f.s != nil {
+		f.s.close()
+	}
+}
+
+func (f *forwarder) errorln(err error) {
+	if now := mono.NanoTime(); time.Duration(now-f.errTime) > errEvery {
+		f.errTime = now
+		nlog.Errorln("audit: failed to forward to", f.raw+":", err)
+	}
+}
+
+func newSink(raw string) (sink, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "syslog", "syslog+udp", "syslog+tcp":
+		var network string
+		if u.Host != "" {
+			network = "udp"
+			if u.Scheme == "syslog+tcp" {
+				network = "tcp"
+			}
+		}
+		w, err := syslog.Dial(network, u.Host, syslog.LOG_INFO|syslog.LOG_AUTH, syslogTag)
+		if err != nil {
+			return nil, err
+		}
+		return &syslogSink{w: w}, nil
+	case "http":
+		return &httpSink{url: raw, client: cmn.NewClient(cmn.TransportArgs{Timeout: sinkTimeout})}, nil
+	case "https":
+		client := cmn.NewClientTLS(cmn.TransportArgs{Timeout: sinkTimeout}, cmn.TLSArgs{}, false /*intra-cluster*/)
+		return &httpSink{url: raw, client: client}, nil
+	default:
+		return nil, fmt.Errorf("unsupported sink %q", raw)
+	}
+}
+
+// one syslog message per record
+func (s *syslogSink) send(lines []byte) error {
+	for line := range strings.SplitSeq(strings.TrimSuffix(cos.UnsafeS(lines), "\n"), "\n") {
+		if err := s.w.Info(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syslogSink) close() { s.w.Close() }
+
+// newline-delimited JSON
+func (s *httpSink) send(lines []byte) error {
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(lines))
+	if err != nil {
+		return err
+	}
+	req.Header.Set(cos.HdrContentType, "application/x-ndjson")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s: %s", s.url, resp.Status)
+	}
+	return nil
+}
+
+func (s *httpSink) close() { s.client.CloseIdleConnections() }
@@ -33,6 +33,12 @@ const (
 	confDisabled = "Disabled"
 )
 
+// mirror.placement
+const (
+	MirrorPlaceMpaths  = "mountpaths" // copies on different mountpaths of the same target (default)
+	MirrorPlaceTargets = "targets"    // copies on different targets (HRW-ordered, the first being the owner)
+)
+
 type (
 	Validator interface {
 		Validate() error
@@ -116,7 +122,19 @@ type (
 		Memsys      MemsysConf      `json:"memsys"`
 		FSHC        FSHCConf        `json:"fshc"`
 		Disk        DiskConf        `json:"disk"`
+		Admission   AdmissionConf   `json:"admission"`
+		Chunks      ChunksConf      `json:"chunks"`
+		Pack        PackConf        `json:"pack"`
+		Compress    CompressConf    `json:"compress"`
+		Dedup       DedupConf       `json:"dedup"`
+		ReadAhead   ReadAheadConf   `json:"read_ahead"`
+		Tier        TierConf        `json:"tier"`
+		MDIndex     MDIndexConf     `json:"md_index"`
 		Space       SpaceConf       `json:"space"`
+		Quota       QuotaConf       `json:"quota"`
+		Tenants     TenantConf      `json:"tenants"`
+		Audit       AuditConf       `json:"audit"`
+		Metrics     MetricsConf     `json:"metrics"`
 		Periodic    PeriodConf      `json:"periodic"`
 		Client      ClientConf      `json:"client"`
 		Mirror      MirrorConf      `json:"mirror" allow:"cluster"`
@@ -138,8 +156,20 @@ type (
 		Timeout     *TimeoutConfToSet     `json:"timeout,omitempty"`
 		Client      *ClientConfToSet      `json:"client,omitempty"`
 		Space       *SpaceConfToSet       `json:"space,omitempty"`
+		Quota       *QuotaConfToSet       `json:"quota,omitempty"`
+		Tenants     *TenantConfToSet      `json:"tenants,omitempty"`
+		Audit       *AuditConfToSet       `json:"audit,omitempty"`
+		Metrics     *MetricsConfToSet     `json:"metrics,omitempty"`
 		LRU         *LRUConfToSet         `json:"lru,omitempty"`
 		Disk        *DiskConfToSet        `json:"disk,omitempty"`
+		Admission   *AdmissionConfToSet   `json:"admission,omitempty"`
+		Chunks      *ChunksConfToSet      `json:"chunks,omitempty"`
+		Pack        *PackConfToSet        `json:"pack,omitempty"`
+		Compress    *CompressConfToSet    `json:"compress,omitempty"`
+		Dedup       *DedupConfToSet       `json:"dedup,omitempty"`
+		ReadAhead   *ReadAheadConfToSet   `json:"read_ahead,omitempty"`
+		Tier        *TierConfToSet        `json:"tier,omitempty"`
+		MDIndex     *MDIndexConfToSet     `json:"md_index,omitempty"`
 		Rebalance   *RebalanceConfToSet   `json:"rebalance,omitempty"`
 		Resilver    *ResilverConfToSet    `json:"resilver,omitempty"`
 		Cksum       *CksumConfToSet       `json:"checksum,omitempty"`
@@ -169,14 +199,26 @@ type (
 	BackendConfAIS map[string][]string // cluster alias -> [urls...]
 
 	MirrorConf struct {
-		Copies  int64 `json:"copies"`       // num copies
-		Burst   int   `json:"burst_buffer"` // xaction channel (buffer) size
-		Enabled bool  `json:"enabled"`      // enabled (to generate copies)
+		Placement string `json:"placement"`    // where to put copies: MirrorPlaceMpaths (default) or MirrorPlaceTargets
+		Copies    int64  `json:"copies"`       // num copies
+		Burst     int    `json:"burst_buffer"` // xaction channel (buffer) size
+		Enabled   bool   `json:"enabled"`      // enabled (to generate copies)
 	}
 	MirrorConfToSet struct {
-		Copies  *int64 `json:"copies,omitempty"`
-		Burst   *int   `json:"burst_buffer,omitempty"`
-		Enabled *bool  `json:"enabled,omitempty"`
+		Placement *string `json:"placement,omitempty"`
+		Copies    *int64  `json:"copies,omitempty"`
+		Burst     *int    `json:"burst_buffer,omitempty"`
+		Enabled   *bool   `json:"enabled,omitempty"`
+	}
+
+	// asynchronous (journaled) replication of a bucket to a remote AIS cluster or cloud (bucket-only)
+	ReplConf struct {
+		Dst     string `json:"dst"`     // destination bucket, e.g. "ais://@remais/abc" or "s3://abc"
+		Enabled bool   `json:"enabled"` // enabled (to journal and ship PUT, APPEND, delete, and rename)
+	}
+	ReplConfToSet struct {
+		Dst     *string `json:"dst,omitempty"`
+		Enabled *bool   `json:"enabled,omitempty"`
 	}
 
 	ECConf struct {
@@ -226,6 +268,9 @@ type (
 		FlushTime cos.Duration `json:"flush_time"` // log flush interval
 		StatsTime cos.Duration `json:"stats_time"` // (not used)
 		ToStderr  bool         `json:"to_stderr"`  // Log only to stderr instead of files.
+		// GET requests that take longer are logged with their per-phase timing (see stats.SlowReq);
+		// zero means default (DfltSlowReqTime)
+		SlowReqTime cos.Duration `json:"slow_request_time,omitempty"`
 	}
 	LogConfToSet struct {
 		Level     *cos.LogLevel `json:"level,omitempty"`
@@ -234,16 +279,19 @@ type (
 		MaxTotal  *cos.SizeIEC  `json:"max_total,omitempty"`
 		FlushTime *cos.Duration `json:"flush_time,omitempty"`
 		StatsTime *cos.Duration `json:"stats_time,omitempty"`
+
+		SlowReqTime *cos.Duration `json:"slow_request_time,omitempty"`
 	}
 
 	// TracingConf defines the configuration used for the OpenTelemetry (OTEL) trace exporter.
 	// It includes settings for enabling tracing, sampling ratio, exporter endpoint, and other
 	// parameters necessary for distributed tracing in AIStore.
 	TracingConf struct {
-		ExporterEndpoint  string                `json:"exporter_endpoint"`       // gRPC exporter endpoint
-		ExporterAuth      TraceExporterAuthConf `json:"exporter_auth,omitempty"` // exporter auth config
-		ServiceNamePrefix string                `json:"service_name_prefix"`     // service name prefix used by trace exporter
-		ExtraAttributes   map[string]string     `json:"attributes,omitempty"`    // any extra-attributes to be added to traces
+		ExporterEndpoint  string                `json:"exporter_endpoint"`           // exporter endpoint (see also: ExporterProtocol)
+		ExporterProtocol  string                `json:"exporter_protocol,omitempty"` // enum { TraceExporterGRPC (default), TraceExporterHTTP }
+		ExporterAuth      TraceExporterAuthConf `json:"exporter_auth,omitempty"`     // exporter auth config
+		ServiceNamePrefix string                `json:"service_name_prefix"`         // service name prefix used by trace exporter
+		ExtraAttributes   map[string]string     `json:"attributes,omitempty"`        // any extra-attributes to be added to traces
 
 		// SamplerProbabilityStr is the percentage of traces to be sampled, expressed as a float64.
 		// It's stored as a string to avoid potential floating-point precision issues during json unmarshal.
@@ -257,7 +305,8 @@ type (
 
 	// NOTE: Updating TracingConfig requires restart.
 	TracingConfToSet struct {
-		ExporterEndpoint      *string                     `json:"exporter_endpoint,omitempty"`   // gRPC exporter endpoint
+		ExporterEndpoint      *string                     `json:"exporter_endpoint,omitempty"`   // exporter endpoint
+		ExporterProtocol      *string                     `json:"exporter_protocol,omitempty"`   // OTLP over gRPC or HTTP
 		ExporterAuth          *TraceExporterAuthConfToSet `json:"exporter_auth,omitempty"`       // exporter auth config
 		ServiceNamePrefix     *string                     `json:"service_name_prefix,omitempty"` // service name used by trace exporter
 		ExtraAttributes       map[string]string           `json:"attributes,omitempty"`          // any extra-attributes to be added to traces
@@ -363,6 +412,88 @@ type (
 		OOS       *int64 `json:"out_of_space,omitempty"`
 	}
 
+	// bucket quota (bytes and/or object count); bucket-only (see Bprops.Quota)
+	// and per namespace (see QuotaConf.Namespaces)
+	QuotaLimits struct {
+		Size    cos.SizeIEC `json:"size"`     // max total size of all objects (0: unlimited)
+		Objects int64       `json:"objects"`  // max number of objects (0: unlimited)
+		SoftPct int64       `json:"soft_pct"` // warn when usage exceeds this percentage of either limit (0: default 90%)
+	}
+	QuotaLimitsToSet struct {
+		Size    *cos.SizeIEC `json:"size,omitempty"`
+		Objects *int64       `json:"objects,omitempty"`
+		SoftPct *int64       `json:"soft_pct,omitempty"`
+	}
+
+	// cluster-wide quota configuration
+	QuotaConf struct {
+		// namespace => quota applying to all buckets in the namespace combined,
+		// e.g. {"#team-a": {"size": "10TiB"}} (see cmn.Ns and ParseNsUname)
+		Namespaces map[string]*QuotaLimits `json:"namespaces,omitempty"`
+		// how often targets recount (reconcile) bucket usage (default: 1h)
+		Reconcile cos.Duration `json:"reconcile"`
+	}
+	QuotaConfToSet struct {
+		Namespaces map[string]*QuotaLimits `json:"namespaces,omitempty"`
+		Reconcile  *cos.Duration           `json:"reconcile,omitempty"`
+	}
+
+	// namespaces as tenants (see cmn.Ns): per-namespace policies, in addition to
+	// namespace-scoped AuthN permissions (see authn.NsACL)
+	Tenant struct {
+		// defaults for the buckets created in the namespace, including access (see BpropsToSet);
+		// cluster defaults apply otherwise
+		Bprops *BpropsToSet `json:"bucket_props,omitempty"`
+		// all buckets in the namespace combined (takes precedence over QuotaConf.Namespaces)
+		Quota *QuotaLimits `json:"quota,omitempty"`
+	}
+	TenantConf struct {
+		// namespace => tenant, e.g. {"#team-a": {"bucket_props": {"access": "..."}, "quota": {"size": "10TiB"}}}
+		Namespaces map[string]*Tenant `json:"namespaces,omitempty"`
+	}
+	TenantConfToSet struct {
+		Namespaces map[string]*Tenant `json:"namespaces,omitempty"`
+	}
+
+	// audit trail of client requests: who did what, when, and with what result
+	// (see cmn/audit)
+	AuditConf struct {
+		// local directory for rotated JSONL files (default: <log dir>/audit)
+		Dir string `json:"dir,omitempty"`
+		// optional forwarding, in addition to local files:
+		// "syslog://" (local), "syslog://host:port" (UDP), "syslog+tcp://host:port", or "http(s)://..."
+		Sink string `json:"sink,omitempty"`
+		// sampling of successful object GET and HEAD requests:
+		// bucket (e.g. "s3://abc") or "*" (any bucket) => fraction of requests to record, in [0, 1]
+		// (default: record all)
+		GetSampling map[string]float64 `json:"get_sampling,omitempty"`
+		MaxSize     cos.SizeIEC        `json:"max_size"`  // exceeding this size triggers rotation (default: 64MiB)
+		MaxTotal    cos.SizeIEC        `json:"max_total"` // exceeding this total removes the oldest files (default: 1GiB)
+		Enabled     bool               `json:"enabled"`
+	}
+	AuditConfToSet struct {
+		Dir         *string            `json:"dir,omitempty"`
+		Sink        *string            `json:"sink,omitempty"`
+		GetSampling map[string]float64 `json:"get_sampling,omitempty"`
+		MaxSize     *cos.SizeIEC       `json:"max_size,omitempty"`
+		MaxTotal    *cos.SizeIEC       `json:"max_total,omitempty"`
+		Enabled     *bool              `json:"enabled,omitempty"`
+	}
+
+	// latency histograms (see stats.KindHistogram) and per-bucket traffic (stats.BckTraffic)
+	MetricsConf struct {
+		// histogram buckets: comma-separated upper bounds in increasing order, e.g. "1ms,10ms,100ms,1s"
+		// (default: see DfltLatencyBuckets; histograms are registered at startup - changes take effect upon restart)
+		LatencyBuckets string `json:"latency_buckets,omitempty"`
+		// max number of distinct (bucket, user) pairs tracked by each target (default: DfltMaxTrafficSeries);
+		// the rest is accounted for under the stats.BckTrafficOther name
+		MaxTrafficSeries int `json:"max_traffic_series,omitempty"`
+	}
+	MetricsConfToSet struct {
+		LatencyBuckets   *string `json:"latency_buckets,omitempty"`
+		MaxTrafficSeries *int    `json:"max_traffic_series,omitempty"`
+	}
+
 	LRUConf struct {
 		// DontEvictTimeStr denotes the period of time during which eviction of an object
 		// is forbidden [atime, atime + DontEvictTime]
@@ -386,6 +517,10 @@ type (
 		DiskUtilMaxWM   int64        `json:"disk_util_max_wm"`
 		IostatTimeLong  cos.Duration `json:"iostat_time_long"`
 		IostatTimeShort cos.Duration `json:"iostat_time_short"`
+		// (Linux targets only) "sync" (default) or "io_uring" - the latter to read and write
+		// objects via per-mountpath io_uring instances (see ios/uring); falls back to "sync"
+		// when not supported by the kernel
+		IOEngine string `json:"io_engine,omitempty"`
 	}
 	DiskConfToSet struct {
 		DiskUtilLowWM   *int64        `json:"disk_util_low_wm,omitempty"`
@@ -393,16 +528,159 @@ type (
 		DiskUtilMaxWM   *int64        `json:"disk_util_max_wm,omitempty"`
 		IostatTimeLong  *cos.Duration `json:"iostat_time_long,omitempty"`
 		IostatTimeShort *cos.Duration `json:"iostat_time_short,omitempty"`
+		IOEngine        *string       `json:"io_engine,omitempty"`
+	}
+
+	// admission control on targets: when overloaded - that is, when max disk utilization
+	// crosses DiskConf watermarks and/or memory pressure (memsys) is high - targets shed or queue
+	// lower-priority work (in the order: background, batch, interactive); rejected requests
+	// get 503 with Retry-After
+	AdmissionConf struct {
+		// max time a batch request (PUT, APPEND, etc.) waits in the queue for the load to subside
+		// (default: 2s)
+		QueueTimeout cos.Duration `json:"queue_timeout"`
+		// Retry-After hint returned with 503 (default: 1s)
+		RetryAfter cos.Duration `json:"retry_after"`
+		// max number of concurrently queued requests - when exceeded, reject right away (default: 1024)
+		MaxQueued int  `json:"max_queued"`
+		Enabled   bool `json:"enabled"`
+	}
+	AdmissionConfToSet struct {
+		QueueTimeout *cos.Duration `json:"queue_timeout,omitempty"`
+		RetryAfter   *cos.Duration `json:"retry_after,omitempty"`
+		MaxQueued    *int          `json:"max_queued,omitempty"`
+		Enabled      *bool         `json:"enabled,omitempty"`
+	}
+
+	// chunked objects: store large objects as a chunk manifest plus chunk files
+	// distributed across mountpaths (see core/lchunk.go); S3 multipart uploads
+	// are always stored chunked (one chunk per part)
+	ChunksConf struct {
+		// objects of size >= ObjSizeLimit are stored chunked (default: 0 - disabled)
+		ObjSizeLimit cos.SizeIEC `json:"objsize_limit"`
+		// size of a chunk (default: 1GiB)
+		ChunkSize cos.SizeIEC `json:"chunk_size"`
+	}
+	ChunksConfToSet struct {
+		ObjSizeLimit *cos.SizeIEC `json:"objsize_limit,omitempty"`
+		ChunkSize    *cos.SizeIEC `json:"chunk_size,omitempty"`
+	}
+
+	// small-object packing: append small objects into per-mountpath pack files
+	// to save on inodes and xattrs (see fs/pack.go and core/lpack.go)
+	PackConf struct {
+		// objects of size < ObjSizeLimit are packed (default: 0 - disabled)
+		ObjSizeLimit cos.SizeIEC `json:"objsize_limit"`
+		// max size of a pack file (default: 1GiB)
+		PackSize cos.SizeIEC `json:"pack_size"`
+		// compact a pack file when its deleted and overwritten content reaches
+		// this percentage of its size (default: 50%)
+		CompactPct int `json:"compact_pct"`
+	}
+	PackConfToSet struct {
+		ObjSizeLimit *cos.SizeIEC `json:"objsize_limit,omitempty"`
+		PackSize     *cos.SizeIEC `json:"pack_size,omitempty"`
+		CompactPct   *int         `json:"compact_pct,omitempty"`
+	}
+
+	// compression at rest: store objects as independently compressed frames
+	// (see core/lcompr.go)
+	CompressConf struct {
+		// compression algorithm: "zstd" or "lz4" (default: "" - disabled)
+		Algo string `json:"algo"`
+		// logical (uncompressed) size of a frame - the unit of decompression
+		// when reading a range (default: 256KiB)
+		FrameSize cos.SizeIEC `json:"frame_size"`
+	}
+	CompressConfToSet struct {
+		Algo      *string      `json:"algo,omitempty"`
+		FrameSize *cos.SizeIEC `json:"frame_size,omitempty"`
+	}
+
+	// content-addressed deduplication: objects with identical content (checksum) share
+	// a single physical copy per mountpath (see core/ldedup.go)
+	DedupConf struct {
+		// applies to ais:// buckets only; requires sha256 or sha512 checksum and excludes erasure coding
+		// and local mirroring
+		Enabled bool `json:"enabled"`
+	}
+	DedupConfToSet struct {
+		Enabled *bool `json:"enabled,omitempty"`
+	}
+
+	// read-ahead for sequential reads of numbered objects (e.g., shard-000123.tar):
+	// GET of the object N warms up objects N+1 through N+Count (see ais/tgtra.go)
+	ReadAheadConf struct {
+		// number of objects to read ahead (default: 0 - disabled);
+		// can be overridden by the GET request (apc.QparamReadAhead)
+		Count int `json:"count"`
+	}
+	ReadAheadConfToSet struct {
+		Count *int `json:"count,omitempty"`
+	}
+
+	// tiered mountpaths: fast tier (e.g., NVMe) over capacity tier (e.g., HDD) within a target;
+	// new and recently accessed objects are placed on the fast tier and get demoted
+	// by access time (see fs/tier.go and space/tier.go)
+	TierConf struct {
+		// mountpaths with this label (see FSPConf) comprise the fast tier,
+		// all other mountpaths - the capacity tier (default: "" - no tiering)
+		FastLabel string `json:"fast_label"`
+		// demote objects that were not accessed for so long (default: 24h)
+		DemoteAge cos.Duration `json:"demote_age"`
+		// promote objects accessed within (default: 1h)
+		PromoteAge cos.Duration `json:"promote_age"`
+		// how often to run tier migration (default: 1h)
+		Interval cos.Duration `json:"interval"`
+		// fast-tier used capacity (%) that triggers demotion regardless of age (default: 80%),
+		// least recently accessed first and until used capacity gets below LowWM (default: 70%);
+		// promotion stops at LowWM as well
+		HighWM int64 `json:"highwm"`
+		LowWM  int64 `json:"lowwm"`
+	}
+	TierConfToSet struct {
+		FastLabel  *string       `json:"fast_label,omitempty"`
+		DemoteAge  *cos.Duration `json:"demote_age,omitempty"`
+		PromoteAge *cos.Duration `json:"promote_age,omitempty"`
+		Interval   *cos.Duration `json:"interval,omitempty"`
+		HighWM     *int64        `json:"highwm,omitempty"`
+		LowWM      *int64        `json:"lowwm,omitempty"`
+	}
+
+	// persistent object metadata index: per-mountpath on-disk log that survives restarts
+	// and serves object metadata (in place of xattrs) - see fs/mdx.go and core/lmdx.go
+	MDIndexConf struct {
+		Enabled bool `json:"enabled"`
+		// how often to write queued updates (default: 10s)
+		FlushTime cos.Duration `json:"flush_time"`
+		// max number of in-memory entries per mountpath (default: 1M);
+		// least recently used entries get evicted, their metadata loaded from xattrs
+		MaxEntries int `json:"max_entries"`
+	}
+	MDIndexConfToSet struct {
+		Enabled    *bool         `json:"enabled,omitempty"`
+		FlushTime  *cos.Duration `json:"flush_time,omitempty"`
+		MaxEntries *int          `json:"max_entries,omitempty"`
 	}
 
 	RebalanceConf struct {
 		XactConf
+		// time-of-day window "HH:MM-HH:MM" (local time) during which _automatic_ rebalance is
+		// allowed to make progress; may wrap around midnight, e.g. "22:00-06:00"; empty: any time
+		Window        string       `json:"window,omitempty"`
 		DestRetryTime cos.Duration `json:"dest_retry_time"` // max wait for ACKs & neighbors to complete
-		Enabled       bool         `json:"enabled"`         // true=auto-rebalance | manual rebalancing
+		// rebalance and resilver: max bytes per second per target (zero: unlimited)
+		Bandwidth cos.SizeIEC `json:"bandwidth,omitempty"`
+		// rebalance and resilver yield when average foreground GET latency exceeds (zero: never yield)
+		MaxGetLatency cos.Duration `json:"max_get_latency,omitempty"`
+		Enabled       bool         `json:"enabled"` // true=auto-rebalance | manual rebalancing
 	}
 	RebalanceConfToSet struct {
 		XactConfToSet
+		Window        *string       `json:"window,omitempty"`
 		DestRetryTime *cos.Duration `json:"dest_retry_time,omitempty"`
+		Bandwidth     *cos.SizeIEC  `json:"bandwidth,omitempty"`
+		MaxGetLatency *cos.Duration `json:"max_get_latency,omitempty"`
 		Enabled       *bool         `json:"enabled,omitempty"`
 	}
 
@@ -432,6 +710,11 @@ type (
 
 		// EnableReadRange: Return read range checksum otherwise return entire object checksum.
 		EnableReadRange bool `json:"enable_read_range"`
+
+		// additional checksums to compute and store with each object, comma-separated
+		// (e.g., "sha256,md5"); returned upon request (apc.QparamCksumType, S3 checksum mode)
+		// - computed when writing, and for existing objects by x-recompute-cksum (see core/lcksum.go)
+		Extra string `json:"extra"`
 	}
 	CksumConfToSet struct {
 		Type            *string `json:"type,omitempty"`
@@ -439,6 +722,7 @@ type (
 		ValidateWarmGet *bool   `json:"validate_warm_get,omitempty"`
 		ValidateObjMove *bool   `json:"validate_obj_move,omitempty"`
 		EnableReadRange *bool   `json:"enable_read_range,omitempty"`
+		Extra           *string `json:"extra,omitempty"`
 	}
 
 	VersionConf struct {
@@ -548,12 +832,42 @@ type (
 	}
 
 	AuthConf struct {
-		Secret  string `json:"secret"`
-		Enabled bool   `json:"enabled"`
+		Secret  string   `json:"secret"` // HMAC secret shared with AuthN server (HS256)
+		OIDC    OIDCConf `json:"oidc"`   // tokens issued by external identity provider
+		Enabled bool     `json:"enabled"`
 	}
 	AuthConfToSet struct {
-		Secret  *string `json:"secret,omitempty"`
-		Enabled *bool   `json:"enabled,omitempty"`
+		Secret  *string        `json:"secret,omitempty"`
+		OIDC    *OIDCConfToSet `json:"oidc,omitempty"`
+		Enabled *bool          `json:"enabled,omitempty"`
+	}
+
+	// OpenID Connect: validate RS256/ES256 (and alike) signed tokens using public keys
+	// from a JWKS URL or a local PEM file; map token claims onto AIS permissions
+	OIDCConf struct {
+		JWKSURL    string                   `json:"jwks_url"`     // e.g. "https://idp.example.com/.well-known/jwks.json"
+		PubKeyFile string                   `json:"pub_key_file"` // alternatively, PEM-encoded public key(s) and/or certificate(s)
+		Issuer     string                   `json:"issuer"`       // expected "iss" claim (empty: don't check)
+		Audience   string                   `json:"audience"`     // expected "aud" claim (empty: don't check)
+		UserClaim  string                   `json:"user_claim"`   // user ID claim (default: "sub")
+		RolesClaim string                   `json:"roles_claim"`  // roles or groups claim, e.g. "realm_access.roles" (default: "groups")
+		Roles      map[string]*OIDCRoleConf `json:"roles"`        // role (or group) => permissions
+		Refresh    cos.Duration             `json:"jwks_refresh"` // how often to refetch JWKS (default: 1h)
+	}
+	OIDCConfToSet struct {
+		JWKSURL    *string                  `json:"jwks_url,omitempty"`
+		PubKeyFile *string                  `json:"pub_key_file,omitempty"`
+		Issuer     *string                  `json:"issuer,omitempty"`
+		Audience   *string                  `json:"audience,omitempty"`
+		UserClaim  *string                  `json:"user_claim,omitempty"`
+		RolesClaim *string                  `json:"roles_claim,omitempty"`
+		Roles      map[string]*OIDCRoleConf `json:"roles,omitempty"`
+		Refresh    *cos.Duration            `json:"jwks_refresh,omitempty"`
+	}
+	OIDCRoleConf struct {
+		Buckets []string `json:"buckets,omitempty"` // e.g. ["ais://abc", "s3://xyz"]; empty: cluster-wide
+		Perm    string   `json:"perm"`              // comma-separated: "ro", "rw", "su", and/or individual permissions (e.g., "GET,PUT")
+		Admin   bool     `json:"admin"`             // full access
 	}
 
 	// keepalive
@@ -791,12 +1105,24 @@ var (
 	_ Validator = (*TCBConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
 	_ Validator = (*TracingConf)(nil)
+	_ Validator = (*TenantConf)(nil)
+	_ Validator = (*AdmissionConf)(nil)
+	_ Validator = (*ChunksConf)(nil)
+	_ Validator = (*PackConf)(nil)
+	_ Validator = (*CompressConf)(nil)
+	_ Validator = (*ReadAheadConf)(nil)
+	_ Validator = (*TierConf)(nil)
+	_ Validator = (*MDIndexConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
 	_ PropsValidator = (*SpaceConf)(nil)
 	_ PropsValidator = (*MirrorConf)(nil)
 	_ PropsValidator = (*ECConf)(nil)
 	_ PropsValidator = (*WritePolicyConf)(nil)
+	_ PropsValidator = (*ChunksConf)(nil)
+	_ PropsValidator = (*PackConf)(nil)
+	_ PropsValidator = (*CompressConf)(nil)
+	_ PropsValidator = (*ReadAheadConf)(nil)
 
 	_ json.Marshaler   = (*BackendConf)(nil)
 	_ json.Unmarshaler = (*BackendConf)(nil)
@@ -829,6 +1155,13 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Dedup.ValidateCksum(c.Cksum.Type); err != nil {
+		return err
+	}
+	if err := c.Mirror.ValidateLocal(&c.Chunks, &c.Pack, &c.Dedup); err != nil {
+		return err
+	}
+
 	opts := IterOpts{VisitAll: true}
 	return IterFields(c, _validateFld, opts)
 }
@@ -968,6 +1301,8 @@ func (c *PeriodConf) Validate() error {
 // LogConf //
 /////////////
 
+const DfltSlowReqTime = 10 * time.Second
+
 func (c *LogConf) Validate() error {
 	if err := c.Level.Validate(); err != nil {
 		return err
@@ -987,9 +1322,19 @@ func (c *LogConf) Validate() error {
 	if c.StatsTime.D() > 10*time.Minute {
 		return fmt.Errorf("invalid log.stats_time=%s (expected range [periodic.stats_time, 10m])", c.StatsTime)
 	}
+	if c.SlowReqTime < 0 || c.SlowReqTime.D() > time.Hour {
+		return fmt.Errorf("invalid log.slow_request_time=%s (expected range [0, 1h])", c.SlowReqTime)
+	}
 	return nil
 }
 
+func (c *LogConf) SlowReqTimeX() time.Duration {
+	if c.SlowReqTime == 0 {
+		return DfltSlowReqTime
+	}
+	return c.SlowReqTime.D()
+}
+
 ////////////////
 // ClientConf //
 ////////////////
@@ -1127,6 +1472,11 @@ func (c BackendConfAIS) String() (s string) {
 // DiskConf //
 //////////////
 
+const (
+	IOEngineSync  = "sync"
+	IOEngineUring = "io_uring"
+)
+
 func (c *DiskConf) Validate() (err error) {
 	lwm, hwm, maxwm := c.DiskUtilLowWM, c.DiskUtilHighWM, c.DiskUtilMaxWM
 	if lwm <= 0 || hwm <= lwm || maxwm <= hwm || maxwm > 100 {
@@ -1142,9 +1492,253 @@ func (c *DiskConf) Validate() (err error) {
 		return fmt.Errorf("disk.iostat_time_long %v shorter than disk.iostat_time_short %v",
 			c.IostatTimeLong, c.IostatTimeShort)
 	}
+	switch c.IOEngine {
+	case "", IOEngineSync, IOEngineUring:
+	default:
+		return fmt.Errorf("invalid disk.io_engine %q (expecting %q or %q)", c.IOEngine, IOEngineSync, IOEngineUring)
+	}
+	return nil
+}
+
+func (c *DiskConf) Uring() bool { return c.IOEngine == IOEngineUring }
+
+///////////////////
+// AdmissionConf //
+///////////////////
+
+const (
+	AdmitQueueTimeout = 2 * time.Second
+	AdmitRetryAfter   = time.Second
+	AdmitMaxQueued    = 1024
+)
+
+func (c *AdmissionConf) Validate() error {
+	if c.QueueTimeout < 0 || c.QueueTimeout.D() > time.Minute {
+		return fmt.Errorf("invalid admission.queue_timeout=%s (expected range [0, 1m])", c.QueueTimeout)
+	}
+	if c.RetryAfter < 0 || c.RetryAfter.D() > time.Hour {
+		return fmt.Errorf("invalid admission.retry_after=%s (expected range [0, 1h])", c.RetryAfter)
+	}
+	if c.MaxQueued < 0 {
+		return fmt.Errorf("invalid admission.max_queued=%d (expecting non-negative)", c.MaxQueued)
+	}
 	return nil
 }
 
+func (c *AdmissionConf) QueueTimeoutX() time.Duration {
+	return cos.NonZero(c.QueueTimeout.D(), AdmitQueueTimeout)
+}
+
+func (c *AdmissionConf) RetryAfterX() time.Duration {
+	return cos.NonZero(c.RetryAfter.D(), AdmitRetryAfter)
+}
+
+func (c *AdmissionConf) MaxQueuedX() int { return cos.NonZero(c.MaxQueued, AdmitMaxQueued) }
+
+////////////////
+// ChunksConf //
+////////////////
+
+const (
+	DfltChunkSize = cos.GiB
+	MinChunkSize  = cos.MiB
+	MaxChunkSize  = 5 * cos.GiB
+)
+
+func (c *ChunksConf) Validate() error {
+	if c.ObjSizeLimit < 0 {
+		return fmt.Errorf("invalid chunks.objsize_limit=%d (expecting non-negative)", c.ObjSizeLimit)
+	}
+	if c.ChunkSize != 0 && (c.ChunkSize < MinChunkSize || c.ChunkSize > MaxChunkSize) {
+		return fmt.Errorf("invalid chunks.chunk_size=%s (expected range [%s, %s])", cos.ToSizeIEC(int64(c.ChunkSize), 0),
+			cos.ToSizeIEC(MinChunkSize, 0), cos.ToSizeIEC(MaxChunkSize, 0))
+	}
+	return nil
+}
+
+func (c *ChunksConf) ValidateAsProps(...any) error { return c.Validate() }
+
+func (c *ChunksConf) ChunkSizeX() int64 { return cos.NonZero(int64(c.ChunkSize), DfltChunkSize) }
+
+// whether an object of a given size is to be stored chunked
+func (c *ChunksConf) Chunked(size int64) bool {
+	return c.ObjSizeLimit > 0 && size >= int64(c.ObjSizeLimit)
+}
+
+//////////////
+// PackConf //
+//////////////
+
+const (
+	DfltPackSize       = cos.GiB
+	MinPackSize        = cos.MiB
+	MaxPackSize        = 64 * cos.GiB
+	MaxPackObjSize     = cos.MiB
+	DfltPackCompactPct = 50
+)
+
+func (c *PackConf) Validate() error {
+	if c.ObjSizeLimit < 0 || c.ObjSizeLimit > MaxPackObjSize {
+		return fmt.Errorf("invalid pack.objsize_limit=%s (expected range [0, %s])", cos.ToSizeIEC(int64(c.ObjSizeLimit), 0),
+			cos.ToSizeIEC(MaxPackObjSize, 0))
+	}
+	if c.PackSize != 0 && (c.PackSize < MinPackSize || c.PackSize > MaxPackSize) {
+		return fmt.Errorf("invalid pack.pack_size=%s (expected range [%s, %s])", cos.ToSizeIEC(int64(c.PackSize), 0),
+			cos.ToSizeIEC(MinPackSize, 0), cos.ToSizeIEC(MaxPackSize, 0))
+	}
+	if c.CompactPct < 0 || c.CompactPct >= 100 {
+		return fmt.Errorf("invalid pack.compact_pct=%d (expected range [0, 100))", c.CompactPct)
+	}
+	return nil
+}
+
+func (c *PackConf) ValidateAsProps(...any) error { return c.Validate() }
+
+func (c *PackConf) PackSizeX() int64 { return cos.NonZero(int64(c.PackSize), DfltPackSize) }
+func (c *PackConf) CompactPctX() int { return cos.NonZero(c.CompactPct, DfltPackCompactPct) }
+
+// whether an object of a given size is to be packed
+func (c *PackConf) Packed(size int64) bool { return size < int64(c.ObjSizeLimit) }
+
+//////////////////
+// CompressConf //
+//////////////////
+
+const (
+	DfltComprFrameSize = 256 * cos.KiB
+	MinComprFrameSize  = 4 * cos.KiB
+	MaxComprFrameSize  = 16 * cos.MiB
+)
+
+func (c *CompressConf) Validate() error {
+	if !apc.IsValidObjCompr(c.Algo) {
+		return fmt.Errorf("invalid compress.algo=%q (expecting one of: %v)", c.Algo, apc.SupportedObjCompr)
+	}
+	if c.FrameSize != 0 && (c.FrameSize < MinComprFrameSize || c.FrameSize > MaxComprFrameSize) {
+		return fmt.Errorf("invalid compress.frame_size=%s (expected range [%s, %s])", cos.ToSizeIEC(int64(c.FrameSize), 0),
+			cos.ToSizeIEC(MinComprFrameSize, 0), cos.ToSizeIEC(MaxComprFrameSize, 0))
+	}
+	return nil
+}
+
+func (c *CompressConf) ValidateAsProps(...any) error { return c.Validate() }
+
+func (c *CompressConf) Enabled() bool { return c.Algo != "" && c.Algo != apc.CompressNever }
+
+func (c *CompressConf) FrameSizeX() int64 {
+	return cos.NonZero(int64(c.FrameSize), DfltComprFrameSize)
+}
+
+///////////////
+// DedupConf //
+///////////////
+
+// the content key is the object's checksum (see core/ldedup.go) and must be collision-resistant
+func (c *DedupConf) ValidateCksum(cksumType string) error {
+	if !c.Enabled {
+		return nil
+	}
+	switch cksumType {
+	case cos.ChecksumSHA256, cos.ChecksumSHA512:
+		return nil
+	}
+	return fmt.Errorf("dedup.enabled requires cryptographic checksum.type (%q or %q), have %q",
+		cos.ChecksumSHA256, cos.ChecksumSHA512, cksumType)
+}
+
+///////////////////
+// ReadAheadConf //
+///////////////////
+
+const MaxReadAhead = 64
+
+func (c *ReadAheadConf) Validate() error {
+	if c.Count < 0 || c.Count > MaxReadAhead {
+		return fmt.Errorf("invalid read_ahead.count=%d (expected range [0, %d])", c.Count, MaxReadAhead)
+	}
+	return nil
+}
+
+func (c *ReadAheadConf) ValidateAsProps(...any) error { return c.Validate() }
+
+//////////////
+// TierConf //
+//////////////
+
+const (
+	DfltTierDemoteAge  = 24 * time.Hour
+	DfltTierPromoteAge = time.Hour
+	DfltTierInterval   = time.Hour
+	MinTierInterval    = time.Minute
+	DfltTierHighWM     = 80
+	DfltTierLowWM      = 70
+)
+
+func (c *TierConf) Validate() error {
+	if c.DemoteAge < 0 || c.PromoteAge < 0 || c.Interval < 0 {
+		return fmt.Errorf("invalid %s (expecting non-negative durations)", c)
+	}
+	if c.Interval != 0 && c.Interval.D() < MinTierInterval {
+		return fmt.Errorf("invalid %s (expecting: tier.interval >= %v)", c, MinTierInterval)
+	}
+	if c.PromoteAgeX() >= c.DemoteAgeX() {
+		return fmt.Errorf("invalid %s (expecting: promote_age < demote_age)", c)
+	}
+	if c.HighWM < 0 || c.HighWM >= 100 || c.LowWM < 0 || c.LowWMX() >= c.HighWMX() {
+		return fmt.Errorf("invalid %s (expecting: 0 < lowwm < highwm < 100)", c)
+	}
+	return nil
+}
+
+func (c *TierConf) Enabled() bool { return c.FastLabel != "" }
+
+func (c *TierConf) DemoteAgeX() time.Duration {
+	return cos.NonZero(c.DemoteAge.D(), DfltTierDemoteAge)
+}
+
+func (c *TierConf) PromoteAgeX() time.Duration {
+	return cos.NonZero(c.PromoteAge.D(), DfltTierPromoteAge)
+}
+
+func (c *TierConf) IntervalX() time.Duration { return cos.NonZero(c.Interval.D(), DfltTierInterval) }
+func (c *TierConf) HighWMX() int64           { return cos.NonZero(c.HighWM, DfltTierHighWM) }
+func (c *TierConf) LowWMX() int64            { return cos.NonZero(c.LowWM, DfltTierLowWM) }
+
+func (c *TierConf) String() string {
+	if !c.Enabled() {
+		return "tier: " + confDisabled
+	}
+	return fmt.Sprintf("tier: fast=%q, demote=%v, promote=%v, low=%d%%, high=%d%%",
+		c.FastLabel, c.DemoteAgeX(), c.PromoteAgeX(), c.LowWMX(), c.HighWMX())
+}
+
+/////////////////
+// MDIndexConf //
+/////////////////
+
+const (
+	DfltMDIndexFlushTime  = 10 * time.Second
+	MinMDIndexFlushTime   = time.Second
+	DfltMDIndexMaxEntries = 1024 * 1024
+	MinMDIndexMaxEntries  = 1024
+)
+
+func (c *MDIndexConf) Validate() error {
+	if c.FlushTime != 0 && c.FlushTime.D() < MinMDIndexFlushTime {
+		return fmt.Errorf("invalid md_index.flush_time %v (expecting >= %v)", c.FlushTime, MinMDIndexFlushTime)
+	}
+	if c.MaxEntries != 0 && c.MaxEntries < MinMDIndexMaxEntries {
+		return fmt.Errorf("invalid md_index.max_entries %d (expecting >= %d)", c.MaxEntries, MinMDIndexMaxEntries)
+	}
+	return nil
+}
+
+func (c *MDIndexConf) FlushTimeX() time.Duration {
+	return cos.NonZero(c.FlushTime.D(), DfltMDIndexFlushTime)
+}
+
+func (c *MDIndexConf) MaxEntriesX() int { return cos.NonZero(c.MaxEntries, DfltMDIndexMaxEntries) }
+
 ///////////////
 // SpaceConf //
 ///////////////
@@ -1163,6 +1757,289 @@ func (c *SpaceConf) String() string {
 		c.CleanupWM, c.LowWM, c.HighWM, c.OOS)
 }
 
+/////////////////
+// QuotaLimits //
+/////////////////
+
+const (
+	QuotaSoftPct   = 90 // default QuotaLimits.SoftPct
+	QuotaReconcile = time.Hour
+)
+
+func (c *QuotaLimits) IsSet() bool { return c.Size > 0 || c.Objects > 0 }
+
+func (c *QuotaLimits) Validate() error {
+	if c.Size < 0 || c.Objects < 0 {
+		return fmt.Errorf("invalid %s (expecting non-negative limits)", c)
+	}
+	if c.SoftPct < 0 || c.SoftPct > 100 {
+		return fmt.Errorf("invalid %s (expecting: 0 <= soft_pct <= 100)", c)
+	}
+	return nil
+}
+
+func (c *QuotaLimits) ValidateAsProps(...any) error { return c.Validate() }
+
+func (c *QuotaLimits) Soft() int64 {
+	if c.SoftPct == 0 {
+		return QuotaSoftPct
+	}
+	return c.SoftPct
+}
+
+func (c *QuotaLimits) String() string {
+	if !c.IsSet() {
+		return "unlimited"
+	}
+	var s []string
+	if c.Size > 0 {
+		s = append(s, "size="+c.Size.String())
+	}
+	if c.Objects > 0 {
+		s = append(s, "objects="+strconv.FormatInt(c.Objects, 10))
+	}
+	return "quota: " + strings.Join(s, ", ")
+}
+
+///////////////
+// QuotaConf //
+///////////////
+
+func (c *QuotaConf) Validate() error {
+	if c.Reconcile != 0 && c.Reconcile.D() < time.Minute {
+		return fmt.Errorf("invalid quota.reconcile %v (expecting >= 1m)", c.Reconcile)
+	}
+	for uname, limits := range c.Namespaces {
+		ns := ParseNsUname(uname)
+		if ns.IsGlobal() {
+			return fmt.Errorf("invalid quota.namespaces: %q (expecting named or remote namespace)", uname)
+		}
+		if err := ns.validate(); err != nil {
+			return fmt.Errorf("invalid quota.namespaces: %v", err)
+		}
+		if limits == nil {
+			return fmt.Errorf("invalid quota.namespaces: %q has no limits", uname)
+		}
+		if err := limits.Validate(); err != nil {
+			return fmt.Errorf("invalid quota.namespaces[%q]: %v", uname, err)
+		}
+	}
+	return nil
+}
+
+// returns namespace quota (if configured)
+func (c *QuotaConf) NsLimits(ns Ns) *QuotaLimits {
+	if len(c.Namespaces) == 0 || ns.IsGlobal() {
+		return nil
+	}
+	for uname, limits := range c.Namespaces {
+		if ParseNsUname(uname) == ns && limits.IsSet() {
+			return limits
+		}
+	}
+	return nil
+}
+
+func (c *QuotaConf) ReconcileTime() time.Duration {
+	return cos.NonZero(c.Reconcile.D(), QuotaReconcile)
+}
+
+////////////////
+// TenantConf //
+////////////////
+
+func (c *TenantConf) Validate() error {
+	for uname, tenant := range c.Namespaces {
+		ns := ParseNsUname(uname)
+		if ns.Name == "" || ns.UUID != "" {
+			return fmt.Errorf("invalid tenants.namespaces: %q (expecting local named namespace, e.g. \"#team-a\")", uname)
+		}
+		if err := ns.validate(); err != nil {
+			return fmt.Errorf("invalid tenants.namespaces: %v", err)
+		}
+		if tenant == nil {
+			continue
+		}
+		if tenant.Bprops != nil && tenant.Bprops.BackendBck != nil {
+			return fmt.Errorf("invalid tenants.namespaces[%q]: backend bucket cannot be a namespace default", uname)
+		}
+		if tenant.Quota != nil {
+			if err := tenant.Quota.Validate(); err != nil {
+				return fmt.Errorf("invalid tenants.namespaces[%q]: %v", uname, err)
+			}
+		}
+	}
+	return nil
+}
+
+// returns tenant (if configured)
+func (c *TenantConf) Get(ns Ns) *Tenant {
+	if len(c.Namespaces) == 0 || ns.Name == "" || ns.UUID != "" {
+		return nil
+	}
+	for uname, tenant := range c.Namespaces {
+		if tenant != nil && ParseNsUname(uname) == ns {
+			return tenant
+		}
+	}
+	return nil
+}
+
+// namespace quota: tenant's, if configured, or else QuotaConf.Namespaces
+func (c *ClusterConfig) NsLimits(ns Ns) *QuotaLimits {
+	if t := c.Tenants.Get(ns); t != nil && t.Quota != nil && t.Quota.IsSet() {
+		return t.Quota
+	}
+	return c.Quota.NsLimits(ns)
+}
+
+///////////////
+// AuditConf //
+///////////////
+
+const (
+	AuditMaxSize  = 64 * cos.MiB
+	AuditMaxTotal = cos.GiB
+
+	AuditSampleAny = "*" // (see AuditConf.GetSampling)
+)
+
+var auditSinks = []string{"syslog", "syslog+tcp", "syslog+udp", "http", "https"}
+
+func (c *AuditConf) Validate() error {
+	if c.MaxSize != 0 && (c.MaxSize < cos.KiB || c.MaxSize > cos.GiB) {
+		return fmt.Errorf("invalid audit.max_size=%s (expected range [1KB, 1GB])", c.MaxSize)
+	}
+	if c.MaxTotal != 0 && c.MaxTotal > 100*cos.GiB {
+		return fmt.Errorf("invalid audit.max_total=%s (expected range [2*max_size, 100GB])", c.MaxTotal)
+	}
+	if c.MaxSizeX() > c.MaxTotalX()/2 {
+		return fmt.Errorf("invalid audit.max_total=%s, must be >= 2*(audit.max_size=%s)", c.MaxTotal, c.MaxSize)
+	}
+	if c.Sink != "" {
+		u, err := url.Parse(c.Sink)
+		if err != nil {
+			return fmt.Errorf("invalid audit.sink %q: %v", c.Sink, err)
+		}
+		if !cos.StringInSlice(u.Scheme, auditSinks) {
+			return fmt.Errorf("invalid audit.sink %q (expecting one of: %v)", c.Sink, auditSinks)
+		}
+		if u.Host == "" && u.Scheme != "syslog" {
+			return fmt.Errorf("invalid audit.sink %q: missing host", c.Sink)
+		}
+	}
+	if len(c.GetSampling) == 0 {
+		return nil
+	}
+	// normalize bucket names (to compare with Bck.Cname)
+	sampling := make(map[string]float64, len(c.GetSampling))
+	for name, rate := range c.GetSampling {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("invalid audit.get_sampling[%q]=%g (expected range [0, 1])", name, rate)
+		}
+		if name == AuditSampleAny {
+			sampling[name] = rate
+			continue
+		}
+		bck, objName, err := ParseBckObjectURI(name, ParseURIOpts{DefaultProvider: apc.AIS})
+		if err == nil && objName != "" {
+			err = errors.New("expecting bucket (not object) name")
+		}
+		if err == nil {
+			err = bck.Validate()
+		}
+		if err != nil {
+			return fmt.Errorf("invalid audit.get_sampling[%q]: %v", name, err)
+		}
+		sampling[bck.Cname("")] = rate
+	}
+	c.GetSampling = sampling
+	return nil
+}
+
+func (c *AuditConf) MaxSizeX() int64  { return cos.NonZero(int64(c.MaxSize), AuditMaxSize) }
+func (c *AuditConf) MaxTotalX() int64 { return cos.NonZero(int64(c.MaxTotal), AuditMaxTotal) }
+
+// fraction of successful object GETs (and HEADs) to record, given bucket's cname
+func (c *AuditConf) SampleRate(cname string) float64 {
+	if len(c.GetSampling) == 0 {
+		return 1
+	}
+	if rate, ok := c.GetSampling[cname]; ok {
+		return rate
+	}
+	if rate, ok := c.GetSampling[AuditSampleAny]; ok {
+		return rate
+	}
+	return 1
+}
+
+func (c *AuditConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	if c.Sink == "" {
+		return "audit: local"
+	}
+	return "audit: local, " + c.Sink
+}
+
+/////////////////
+// MetricsConf //
+/////////////////
+
+const (
+	DfltLatencyBuckets = "500us,1ms,2.5ms,5ms,10ms,25ms,50ms,100ms,250ms,500ms,1s,2.5s,5s,10s,30s,1m"
+	maxLatencyBuckets  = 64
+
+	DfltMaxTrafficSeries = 1024
+	maxTrafficSeries     = 64 * 1024
+)
+
+func (c *MetricsConf) Validate() error {
+	if c.MaxTrafficSeries < 0 || c.MaxTrafficSeries > maxTrafficSeries {
+		return fmt.Errorf("invalid metrics.max_traffic_series=%d (expecting range [0, %d])", c.MaxTrafficSeries, maxTrafficSeries)
+	}
+	if c.LatencyBuckets == "" {
+		return nil
+	}
+	_, err := parseLatencyBuckets(c.LatencyBuckets)
+	return err
+}
+
+func (c *MetricsConf) MaxTrafficSeriesX() int {
+	return cos.NonZero(c.MaxTrafficSeries, DfltMaxTrafficSeries)
+}
+
+// histogram buckets (upper bounds)
+func (c *MetricsConf) LatencyBounds() []time.Duration {
+	bounds, err := parseLatencyBuckets(cos.Right(DfltLatencyBuckets, c.LatencyBuckets))
+	debug.AssertNoErr(err)
+	if err != nil {
+		bounds, _ = parseLatencyBuckets(DfltLatencyBuckets)
+	}
+	return bounds
+}
+
+func parseLatencyBuckets(s string) ([]time.Duration, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) > maxLatencyBuckets {
+		return nil, fmt.Errorf("invalid metrics.latency_buckets: too many buckets (%d, max %d)", len(parts), maxLatencyBuckets)
+	}
+	bounds := make([]time.Duration, 0, len(parts))
+	for _, part := range parts {
+		d, err := time.ParseDuration(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid metrics.latency_buckets %q: %v", s, err)
+		}
+		if d <= 0 || (len(bounds) > 0 && d <= bounds[len(bounds)-1]) {
+			return nil, fmt.Errorf("invalid metrics.latency_buckets %q: expecting positive bounds in increasing order", s)
+		}
+		bounds = append(bounds, d)
+	}
+	return bounds, nil
+}
+
 /////////////
 // LRUConf //
 /////////////
@@ -1186,7 +2063,34 @@ func (c *LRUConf) Validate() (err error) {
 ///////////////
 
 func (c *CksumConf) Validate() (err error) {
-	return cos.ValidateCksumType(c.Type)
+	if err = cos.ValidateCksumType(c.Type); err != nil {
+		return err
+	}
+	if c.Extra == "" {
+		return nil
+	}
+	for _, ty := range strings.Split(c.Extra, ",") {
+		if ty == cos.ChecksumNone {
+			return fmt.Errorf("invalid extra checksum type %q", ty)
+		}
+		if err = cos.ValidateCksumType(ty); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// additional checksum types, excluding the primary one
+func (c *CksumConf) ExtraTypes() (types []string) {
+	if c.Extra == "" {
+		return nil
+	}
+	for _, ty := range strings.Split(c.Extra, ",") {
+		if ty != c.Type && !cos.StringInSlice(ty, types) {
+			types = append(types, ty)
+		}
+	}
+	return types
 }
 
 func (c *CksumConf) ValidateAsProps(...any) (err error) {
@@ -1214,6 +2118,9 @@ func (c *CksumConf) String() string {
 		toValidateStr = strings.Join(toValidate, ",")
 	}
 
+	if c.Extra != "" {
+		return fmt.Sprintf("Type: %s (extra: %s) | Validate: %s", c.Type, c.Extra, toValidateStr)
+	}
 	return fmt.Sprintf("Type: %s | Validate: %s", c.Type, toValidateStr)
 }
 
@@ -1254,6 +2161,39 @@ func (c *MirrorConf) Validate() error {
 	if c.Copies < 2 || c.Copies > 32 {
 		return fmt.Errorf("invalid mirror.copies: %d (expected value in range [2, 32])", c.Copies)
 	}
+	switch c.Placement {
+	case "", MirrorPlaceMpaths, MirrorPlaceTargets:
+	default:
+		return fmt.Errorf("invalid mirror.placement: %q (expected one of: %q, %q)", c.Placement, MirrorPlaceMpaths, MirrorPlaceTargets)
+	}
+	return nil
+}
+
+// N-way mirroring across targets
+func (c *MirrorConf) AcrossTargets() bool { return c.Placement == MirrorPlaceTargets }
+
+// local copies (mirror.placement=mountpaths) are full copies of the object's file
+func (c *MirrorConf) Local() bool { return c.Enabled && !c.AcrossTargets() }
+
+// chunked, packed, and deduplicated objects are not mirrored locally (see mirror/utils.go)
+func (c *MirrorConf) ValidateLocal(chunks *ChunksConf, pack *PackConf, dedup *DedupConf) error {
+	if !c.Local() {
+		return nil
+	}
+	if chunks.ObjSizeLimit > 0 {
+		return fmt.Errorf("local mirroring (mirror.enabled) and chunks.objsize_limit=%s are mutually exclusive: "+
+			"chunked objects are not mirrored locally (consider mirror.placement=%q)",
+			cos.ToSizeIEC(int64(chunks.ObjSizeLimit), 0), MirrorPlaceTargets)
+	}
+	if pack.ObjSizeLimit > 0 {
+		return fmt.Errorf("local mirroring (mirror.enabled) and pack.objsize_limit=%s are mutually exclusive: "+
+			"packed objects are not mirrored locally (consider mirror.placement=%q)",
+			cos.ToSizeIEC(int64(pack.ObjSizeLimit), 0), MirrorPlaceTargets)
+	}
+	if dedup.Enabled {
+		return fmt.Errorf("local mirroring (mirror.enabled) and dedup.enabled are mutually exclusive: "+
+			"deduplicated objects are not mirrored locally (consider mirror.placement=%q)", MirrorPlaceTargets)
+	}
 	return nil
 }
 
@@ -1269,9 +2209,126 @@ func (c *MirrorConf) String() string {
 		return confDisabled
 	}
 
+	if c.AcrossTargets() {
+		return fmt.Sprintf("%d copies (across targets)", c.Copies)
+	}
 	return fmt.Sprintf("%d copies", c.Copies)
 }
 
+//////////////
+// OIDCConf //
+//////////////
+
+const (
+	dfltOIDCUserClaim  = "sub"
+	dfltOIDCRolesClaim = "groups"
+	dfltOIDCRefresh    = cos.Duration(time.Hour)
+)
+
+func (c *OIDCConf) IsEnabled() bool { return c.JWKSURL != "" || c.PubKeyFile != "" }
+
+func (c *OIDCConf) Validate() error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	if c.JWKSURL != "" && c.PubKeyFile != "" {
+		return errors.New("invalid auth.oidc: jwks_url and pub_key_file are mutually exclusive")
+	}
+	if c.JWKSURL != "" {
+		if u, err := url.Parse(c.JWKSURL); err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+			return fmt.Errorf("invalid auth.oidc.jwks_url %q", c.JWKSURL)
+		}
+	}
+	if c.UserClaim == "" {
+		c.UserClaim = dfltOIDCUserClaim
+	}
+	if c.RolesClaim == "" {
+		c.RolesClaim = dfltOIDCRolesClaim
+	}
+	if c.Refresh == 0 {
+		c.Refresh = dfltOIDCRefresh
+	} else if c.Refresh < cos.Duration(time.Minute) {
+		return fmt.Errorf("invalid auth.oidc.jwks_refresh %s (expecting >= 1m)", c.Refresh)
+	}
+	for name, role := range c.Roles {
+		if role == nil {
+			return fmt.Errorf("invalid auth.oidc.roles: nil role %q", name)
+		}
+		if _, err := role.Access(); err != nil {
+			return fmt.Errorf("invalid auth.oidc.roles[%q]: %v", name, err)
+		}
+		for _, uri := range role.Buckets {
+			if _, err := role.ParseBck(uri); err != nil {
+				return fmt.Errorf("invalid auth.oidc.roles[%q]: %v", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// parse comma-separated permissions
+func (r *OIDCRoleConf) Access() (access apc.AccessAttrs, err error) {
+	if r.Admin {
+		return apc.AccessAll, nil
+	}
+	for _, p := range strings.Split(r.Perm, ",") {
+		a, err := apc.StrToAccess(strings.TrimSpace(p))
+		if err != nil {
+			return 0, err
+		}
+		access |= a
+	}
+	return access, nil
+}
+
+func (*OIDCRoleConf) ParseBck(uri string) (*Bck, error) {
+	bck, objName, err := ParseBckObjectURI(uri, ParseURIOpts{})
+	if err == nil && objName != "" {
+		err = fmt.Errorf("unexpected object name in %q", uri)
+	}
+	if err == nil {
+		err = bck.Validate()
+	}
+	return &bck, err
+}
+
+//////////////
+// ReplConf //
+//////////////
+
+func (c *ReplConf) ValidateAsProps(...any) error {
+	if !c.Enabled {
+		return nil
+	}
+	_, err := c.DstBck()
+	return err
+}
+
+// parse and validate replication destination
+func (c *ReplConf) DstBck() (*Bck, error) {
+	bck, objName, err := ParseBckObjectURI(c.Dst, ParseURIOpts{})
+	if err == nil && objName != "" {
+		err = fmt.Errorf("unexpected object name %q", objName)
+	}
+	if err == nil {
+		err = bck.Validate()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid replication.dst %q: %v", c.Dst, err)
+	}
+	if !bck.IsCloud() && !bck.IsRemoteAIS() {
+		return nil, fmt.Errorf("invalid replication.dst %q: expecting remote AIS or cloud bucket", c.Dst)
+	}
+	return &bck, nil
+}
+
+func (c *ReplConf) String() string {
+	if !c.Enabled {
+		return confDisabled
+	}
+	return "to " + c.Dst
+}
+
 ////////////
 // ECConf //
 ////////////
@@ -1902,9 +2959,62 @@ func (c *RebalanceConf) Validate() error {
 		return fmt.Errorf("invalid rebalance.compression: %q (expecting one of: %v)",
 			c.Compression, apc.SupportedCompression)
 	}
+	if c.Bandwidth < 0 {
+		return fmt.Errorf("invalid rebalance.bandwidth: %s (expecting non-negative)", c.Bandwidth)
+	}
+	if j := c.MaxGetLatency.D(); j < 0 || j > time.Minute {
+		return fmt.Errorf("invalid rebalance.max_get_latency=%s (expected range [0, 1m])", j)
+	}
+	if c.Window != "" {
+		if _, _, err := parseRebWindow(c.Window); err != nil {
+			return fmt.Errorf("invalid rebalance.window %q: %v (expecting \"HH:MM-HH:MM\")", c.Window, err)
+		}
+	}
 	return nil
 }
 
+// returns true if automatic rebalance is allowed to run at the given (local) time
+func (c *RebalanceConf) InWindow(now time.Time) bool {
+	if c.Window == "" {
+		return true
+	}
+	begin, end, err := parseRebWindow(c.Window)
+	if err != nil {
+		return true // (validated)
+	}
+	minute := now.Hour()*60 + now.Minute()
+	if begin <= end {
+		return minute >= begin && minute < end
+	}
+	return minute >= begin || minute < end // wraps around midnight
+}
+
+// "HH:MM-HH:MM" => minutes since midnight
+func parseRebWindow(s string) (begin, end int, err error) {
+	b, e, ok := strings.Cut(s, "-")
+	if !ok {
+		return 0, 0, errors.New("missing '-'")
+	}
+	if begin, err = parseHHMM(strings.TrimSpace(b)); err != nil {
+		return 0, 0, err
+	}
+	if end, err = parseHHMM(strings.TrimSpace(e)); err != nil {
+		return 0, 0, err
+	}
+	if begin == end {
+		err = errors.New("empty window")
+	}
+	return begin, end, err
+}
+
+func parseHHMM(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
 func (c *RebalanceConf) String() string {
 	if c.Enabled {
 		return "Enabled"
@@ -1927,6 +3037,14 @@ func (c *ResilverConf) String() string {
 
 const defaultSampleProbability = 1.0
 
+// tracing.exporter_protocol enum
+const (
+	TraceExporterGRPC = "grpc"
+	TraceExporterHTTP = "http" // with "file://" endpoint prefix: write OTLP/JSON to a local file (offline testing)
+
+	TraceFileScheme = "file://"
+)
+
 func (c *TracingConf) Validate() error {
 	if !c.Enabled {
 		return nil
@@ -1934,6 +3052,18 @@ func (c *TracingConf) Validate() error {
 	if c.ExporterEndpoint == "" {
 		return errors.New("tracing.exporter_endpoint can't be empty when tracing enabled")
 	}
+	switch c.ExporterProtocol {
+	case "", TraceExporterGRPC:
+		if strings.HasPrefix(c.ExporterEndpoint, TraceFileScheme) {
+			return fmt.Errorf("tracing.exporter_endpoint %q requires %q exporter protocol", c.ExporterEndpoint, TraceExporterHTTP)
+		}
+	case TraceExporterHTTP:
+		if c.ExporterEndpoint == TraceFileScheme {
+			return fmt.Errorf("tracing.exporter_endpoint %q: missing filename", c.ExporterEndpoint)
+		}
+	default:
+		return fmt.Errorf("invalid tracing.exporter_protocol %q (expecting %q or %q)", c.ExporterProtocol, TraceExporterGRPC, TraceExporterHTTP)
+	}
 	if c.SamplerProbabilityStr == "" {
 		c.SamplerProbability = defaultSampleProbability
 	} else {
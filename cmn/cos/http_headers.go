@@ -45,6 +45,8 @@ const (
 
 	HdrHSTS = "Strict-Transport-Security"
 
+	HdrRetryAfter = "Retry-After" // (seconds) with 503 and 429; Ref: https://www.rfc-editor.org/rfc/rfc9110#section-10.2.3
+
 	HdrLastModified = "Last-Modified" // RFC1123GMT or, same, http.TimeFormat ("Mon, 02 Jan 2006 15:04:05 GMT")
 )
 
@@ -12,6 +12,10 @@ const (
 	StreamsOutObjSize  = "stream.out.size"
 	StreamsInObjCount  = "stream.in.n"
 	StreamsInObjSize   = "stream.in.size"
+
+	// histograms (nanoseconds)
+	StreamsOutObjLatency = "stream.out.ns.hist"
+	StreamsInObjLatency  = "stream.in.ns.hist"
 )
 
 type (
@@ -114,6 +114,12 @@ type (
 	ErrGetCap struct {
 		err error
 	}
+	ErrQuotaExceeded struct {
+		entity string // bucket or namespace
+		what   string // "size" | "objects"
+		used   int64
+		limit  int64
+	}
 
 	ErrBucketAccessDenied struct{ errAccessDenied }
 	ErrObjectAccessDenied struct{ errAccessDenied }
@@ -514,6 +520,25 @@ func IsErrCapExceeded(err error) bool {
 	return ok || cos.IsErrOOS(err) // NOTE: a superset
 }
 
+// ErrQuotaExceeded
+
+func NewErrQuotaExceeded(entity, what string, used, limit int64) *ErrQuotaExceeded {
+	return &ErrQuotaExceeded{entity: entity, what: what, used: used, limit: limit}
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	if e.what == "size" {
+		return fmt.Sprintf("%s: quota exceeded (size %s, limit %s)", e.entity,
+			cos.ToSizeIEC(e.used, 2), cos.ToSizeIEC(e.limit, 2))
+	}
+	return fmt.Sprintf("%s: quota exceeded (%d objects, limit %d)", e.entity, e.used, e.limit)
+}
+
+func IsErrQuotaExceeded(err error) bool {
+	_, ok := err.(*ErrQuotaExceeded)
+	return ok
+}
+
 // ErrGetCap
 
 func NewErrGetCap(err error) *ErrGetCap {
@@ -1266,7 +1291,7 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, opts ...int /*[
 		switch {
 		case isErrNotFoundExtended(err, status):
 			status = http.StatusNotFound
-		case IsErrCapExceeded(err):
+		case IsErrCapExceeded(err), IsErrQuotaExceeded(err):
 			status = http.StatusInsufficientStorage
 		case IsErrRangeNotSatisfiable(err):
 			status = http.StatusRequestedRangeNotSatisfiable
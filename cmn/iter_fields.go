@@ -15,6 +15,8 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+
+	jsoniter "github.com/json-iterator/go"
 )
 
 const IterFieldNameSepa = "."
@@ -454,7 +456,15 @@ reflectDst:
 				dst.Set(lst)
 			}
 		case reflect.Map:
-			// do nothing (e.g. ObjAttrs.CustomMD)
+			// JSON, e.g. '{"ais://abc": 0.1}' (see AuditConf.GetSampling);
+			// otherwise, do nothing (e.g. ObjAttrs.CustomMD)
+			if s := srcVal.String(); strings.HasPrefix(s, "{") {
+				m := reflect.New(dst.Type())
+				if err := jsoniter.UnmarshalFromString(s, m.Interface()); err != nil {
+					return fmt.Errorf("invalid %q value %q: %v", f.name, s, err)
+				}
+				dst.Set(m.Elem())
+			}
 		default:
 			debug.Assertf(false, "field.name: %s, field.type: %s", f.listTag, dst.Kind())
 		}
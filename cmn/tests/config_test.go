@@ -8,9 +8,11 @@ import (
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/jsp"
 	"github.com/NVIDIA/aistore/tools/tassert"
 )
@@ -72,3 +74,406 @@ func TestValidateMpath(t *testing.T) {
 		}
 	}
 }
+
+func TestRebalanceWindow(t *testing.T) {
+	at := func(hh, mm int) time.Time { return time.Date(2025, 1, 1, hh, mm, 0, 0, time.Local) }
+	tests := []struct {
+		window string
+		now    time.Time
+		in     bool
+	}{
+		{"", at(12, 0), true},
+		{"01:00-05:00", at(3, 30), true},
+		{"01:00-05:00", at(5, 0), false},
+		{"01:00-05:00", at(0, 59), false},
+		{"22:00-06:00", at(23, 15), true},
+		{"22:00-06:00", at(2, 0), true},
+		{"22:00-06:00", at(12, 0), false},
+	}
+	for _, test := range tests {
+		c := cmn.RebalanceConf{Window: test.window}
+		if in := c.InWindow(test.now); in != test.in {
+			t.Errorf("window %q at %s: expected %t, got %t", test.window, test.now.Format("15:04"), test.in, in)
+		}
+	}
+
+	for _, window := range []string{"1-5", "25:00-01:00", "10:00", "10:00-10:00"} {
+		c := cmn.RebalanceConf{Window: window, DestRetryTime: cos.Duration(time.Minute), XactConf: cmn.XactConf{Compression: apc.CompressNever}}
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid window %q succeeded", window)
+		}
+	}
+}
+
+func TestLatencyBuckets(t *testing.T) {
+	c := cmn.MetricsConf{}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, len(c.LatencyBounds()) > 1, "expected default buckets")
+
+	c.LatencyBuckets = "1ms, 10ms,100ms,1s"
+	tassert.CheckFatal(t, c.Validate())
+	bounds := c.LatencyBounds()
+	tassert.Fatalf(t, len(bounds) == 4 && bounds[1] == 10*time.Millisecond, "unexpected buckets %v", bounds)
+
+	for _, s := range []string{"1ms,abc", "10ms,1ms", "1ms,1ms", "0,1s", "-1s"} {
+		c.LatencyBuckets = s
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid buckets %q succeeded", s)
+		}
+	}
+
+	c.LatencyBuckets = ""
+	tassert.Errorf(t, c.MaxTrafficSeriesX() == cmn.DfltMaxTrafficSeries, "expected default max traffic series")
+	c.MaxTrafficSeries = -1
+	tassert.Errorf(t, c.Validate() != nil, "validation of negative max traffic series succeeded")
+}
+
+func TestTracingExporter(t *testing.T) {
+	valid := []cmn.TracingConf{
+		{Enabled: true, ExporterEndpoint: "localhost:4317"},
+		{Enabled: true, ExporterEndpoint: "localhost:4317", ExporterProtocol: cmn.TraceExporterGRPC},
+		{Enabled: true, ExporterEndpoint: "http://localhost:4318/v1/traces", ExporterProtocol: cmn.TraceExporterHTTP},
+		{Enabled: true, ExporterEndpoint: cmn.TraceFileScheme + "/tmp/traces.json", ExporterProtocol: cmn.TraceExporterHTTP},
+	}
+	for _, c := range valid {
+		tassert.CheckError(t, c.Validate())
+	}
+	invalid := []cmn.TracingConf{
+		{Enabled: true},
+		{Enabled: true, ExporterEndpoint: cmn.TraceFileScheme + "/tmp/traces.json"},
+		{Enabled: true, ExporterEndpoint: cmn.TraceFileScheme, ExporterProtocol: cmn.TraceExporterHTTP},
+		{Enabled: true, ExporterEndpoint: "localhost:4317", ExporterProtocol: "thrift"},
+	}
+	for _, c := range invalid {
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid tracing config %+v succeeded", c)
+		}
+	}
+}
+
+func TestSlowReqTime(t *testing.T) {
+	c := cmn.LogConf{Level: "3", MaxSize: 4 * cos.MiB, MaxTotal: 128 * cos.MiB}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.SlowReqTimeX() == cmn.DfltSlowReqTime, "expected default slow request time")
+
+	c.SlowReqTime = cos.Duration(time.Second)
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.SlowReqTimeX() == time.Second, "expected 1s, got %v", c.SlowReqTimeX())
+
+	for _, d := range []time.Duration{-time.Second, 2 * time.Hour} {
+		c.SlowReqTime = cos.Duration(d)
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid slow request time %v succeeded", d)
+		}
+	}
+}
+
+func TestTenantConf(t *testing.T) {
+	var (
+		ro     = apc.AccessRO
+		teamA  = cmn.Ns{Name: "team-a"}
+		config = &cmn.ClusterConfig{
+			Quota: cmn.QuotaConf{
+				Namespaces: map[string]*cmn.QuotaLimits{"#team-a": {Objects: 100}, "#team-b": {Objects: 200}},
+			},
+			Tenants: cmn.TenantConf{
+				Namespaces: map[string]*cmn.Tenant{
+					"#team-a": {Bprops: &cmn.BpropsToSet{Access: &ro}, Quota: &cmn.QuotaLimits{Objects: 10}},
+				},
+			},
+		}
+	)
+	tassert.CheckFatal(t, config.Tenants.Validate())
+
+	// tenant's bucket defaults
+	bck := cmn.Bck{Name: "b", Provider: apc.AIS, Ns: teamA}
+	props := bck.DefaultProps(config)
+	tassert.Errorf(t, props.Access == apc.AccessRO, "expected tenant's access %v, got %v", apc.AccessRO, props.Access)
+	bck.Ns = cmn.NsGlobal
+	props = bck.DefaultProps(config)
+	tassert.Errorf(t, props.Access != apc.AccessRO, "global namespace must not inherit tenant's props")
+
+	// tenant's quota takes precedence
+	limits := config.NsLimits(teamA)
+	tassert.Fatalf(t, limits != nil && limits.Objects == 10, "expected tenant's quota, got %+v", limits)
+	limits = config.NsLimits(cmn.Ns{Name: "team-b"})
+	tassert.Fatalf(t, limits != nil && limits.Objects == 200, "expected namespace quota, got %+v", limits)
+
+	// invalid
+	for _, uname := range []string{"team-a", "@uuid#team-a", "#"} {
+		c := cmn.TenantConf{Namespaces: map[string]*cmn.Tenant{uname: {}}}
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid tenant namespace %q succeeded", uname)
+		}
+	}
+	c := cmn.TenantConf{Namespaces: map[string]*cmn.Tenant{
+		"#team-a": {Bprops: &cmn.BpropsToSet{BackendBck: &cmn.BackendBckToSet{}}},
+	}}
+	if err := c.Validate(); err == nil {
+		t.Error("validation of tenant's backend bucket succeeded")
+	}
+}
+
+func TestAdmissionConf(t *testing.T) {
+	c := cmn.AdmissionConf{}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.QueueTimeoutX() == cmn.AdmitQueueTimeout, "expected default queue timeout, got %v", c.QueueTimeoutX())
+	tassert.Errorf(t, c.RetryAfterX() == cmn.AdmitRetryAfter, "expected default retry-after, got %v", c.RetryAfterX())
+	tassert.Errorf(t, c.MaxQueuedX() == cmn.AdmitMaxQueued, "expected default max queued, got %d", c.MaxQueuedX())
+
+	for _, c := range []cmn.AdmissionConf{
+		{QueueTimeout: cos.Duration(-time.Second)},
+		{QueueTimeout: cos.Duration(2 * time.Minute)},
+		{RetryAfter: cos.Duration(2 * time.Hour)},
+		{MaxQueued: -1},
+	} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid %+v succeeded", c)
+		}
+	}
+}
+
+func TestChunksConf(t *testing.T) {
+	c := cmn.ChunksConf{}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, !c.Chunked(cos.TiB), "chunking must be disabled by default")
+	tassert.Errorf(t, c.ChunkSizeX() == cmn.DfltChunkSize, "expected default chunk size, got %d", c.ChunkSizeX())
+
+	c = cmn.ChunksConf{ObjSizeLimit: 10 * cos.GiB, ChunkSize: 100 * cos.MiB}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.Chunked(10*cos.GiB) && !c.Chunked(10*cos.GiB-1), "wrong objsize_limit boundary")
+	tassert.Errorf(t, c.ChunkSizeX() == 100*cos.MiB, "expected configured chunk size, got %d", c.ChunkSizeX())
+
+	for _, c := range []cmn.ChunksConf{
+		{ObjSizeLimit: -1},
+		{ChunkSize: cmn.MinChunkSize - 1},
+		{ChunkSize: cmn.MaxChunkSize + 1},
+	} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid %+v succeeded", c)
+		}
+	}
+}
+
+func TestMirrorLocal(t *testing.T) {
+	var (
+		config = cmn.Config{}
+		bck    = cmn.Bck{Name: "mirror-local", Provider: apc.AIS}
+		bp     = bck.DefaultProps(&config.ClusterConfig)
+	)
+	bp.Mirror = cmn.MirrorConf{Enabled: true, Copies: 2}
+	bp.Chunks.ObjSizeLimit = cos.GiB
+	tassert.Errorf(t, bp.Validate(1) != nil, "expected error: local mirroring of chunked objects")
+
+	bp.Mirror.Placement = cmn.MirrorPlaceTargets
+	tassert.CheckFatal(t, bp.Validate(3))
+
+	bp.Mirror.Placement = cmn.MirrorPlaceMpaths
+	bp.Chunks.ObjSizeLimit = 0
+	tassert.CheckFatal(t, bp.Validate(1))
+
+	bp.Pack.ObjSizeLimit = 64 * cos.KiB
+	tassert.Errorf(t, bp.Validate(1) != nil, "expected error: local mirroring of packed objects")
+	bp.Pack.ObjSizeLimit = 0
+
+	bp.Cksum.Type = cos.ChecksumSHA256
+	bp.Dedup.Enabled = true
+	tassert.Errorf(t, bp.Validate(1) != nil, "expected error: local mirroring of deduplicated objects")
+	bp.Mirror.Enabled = false
+	tassert.CheckFatal(t, bp.Validate(1))
+}
+
+func TestPackConf(t *testing.T) {
+	c := cmn.PackConf{}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, !c.Packed(0), "packing must be disabled by default")
+	tassert.Errorf(t, c.PackSizeX() == cmn.DfltPackSize, "expected default pack size, got %d", c.PackSizeX())
+	tassert.Errorf(t, c.CompactPctX() == cmn.DfltPackCompactPct, "expected default compact pct, got %d", c.CompactPctX())
+
+	c = cmn.PackConf{ObjSizeLimit: 64 * cos.KiB, PackSize: 256 * cos.MiB, CompactPct: 30}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.Packed(64*cos.KiB-1) && !c.Packed(64*cos.KiB), "wrong objsize_limit boundary")
+
+	for _, c := range []cmn.PackConf{
+		{ObjSizeLimit: -1},
+		{ObjSizeLimit: cmn.MaxPackObjSize + 1},
+		{PackSize: cmn.MinPackSize - 1},
+		{PackSize: cmn.MaxPackSize + 1},
+		{CompactPct: 100},
+	} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid %+v succeeded", c)
+		}
+	}
+}
+
+func TestCompressConf(t *testing.T) {
+	c := cmn.CompressConf{}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, !c.Enabled(), "compression must be disabled by default")
+	tassert.Errorf(t, c.FrameSizeX() == cmn.DfltComprFrameSize, "expected default frame size, got %d", c.FrameSizeX())
+
+	for _, algo := range []string{apc.ObjComprZstd, apc.ObjComprLZ4} {
+		c = cmn.CompressConf{Algo: algo, FrameSize: cos.MiB}
+		tassert.CheckFatal(t, c.Validate())
+		tassert.Errorf(t, c.Enabled(), "%s: expected enabled", algo)
+	}
+	c = cmn.CompressConf{Algo: apc.CompressNever}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, !c.Enabled(), "%q: expected disabled", c.Algo)
+
+	for _, c := range []cmn.CompressConf{
+		{Algo: "gzip"},
+		{Algo: apc.ObjComprZstd, FrameSize: cmn.MinComprFrameSize - 1},
+		{Algo: apc.ObjComprLZ4, FrameSize: cmn.MaxComprFrameSize + 1},
+	} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid %+v succeeded", c)
+		}
+	}
+}
+
+func TestDedupConf(t *testing.T) {
+	var (
+		config = cmn.Config{}
+		bck    = cmn.Bck{Name: "dedup", Provider: apc.AIS}
+		bp     = bck.DefaultProps(&config.ClusterConfig)
+	)
+	tassert.Errorf(t, !bp.Dedup.Enabled, "deduplication must be disabled by default")
+
+	toSet, err := cmn.NewBpropsToSet(cos.StrKVs{"dedup.enabled": "true"})
+	tassert.CheckFatal(t, err)
+	bp.Apply(toSet)
+	tassert.Errorf(t, bp.Dedup.Enabled, "expected enabled")
+	tassert.Errorf(t, bp.Validate(1) != nil, "expected error: dedup with non-cryptographic checksum %q", bp.Cksum.Type)
+	bp.Cksum.Type = cos.ChecksumSHA256
+	tassert.CheckFatal(t, bp.Validate(1))
+
+	toSet, err = cmn.NewBpropsToSet(cos.StrKVs{"dedup.enabled": "false"})
+	tassert.CheckFatal(t, err)
+	bp.Apply(toSet)
+	tassert.Errorf(t, !bp.Dedup.Enabled, "expected disabled")
+}
+
+func TestTierConf(t *testing.T) {
+	var c cmn.TierConf
+	tassert.Errorf(t, !c.Enabled(), "tiering must be disabled by default")
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.DemoteAgeX() == cmn.DfltTierDemoteAge && c.IntervalX() == cmn.DfltTierInterval,
+		"unexpected defaults: %s", c.String())
+
+	for _, c := range []cmn.TierConf{
+		{FastLabel: "nvme"},
+		{FastLabel: "nvme", DemoteAge: cos.Duration(time.Hour), PromoteAge: cos.Duration(time.Minute)},
+		{FastLabel: "nvme", Interval: cos.Duration(cmn.MinTierInterval), HighWM: 90, LowWM: 50},
+	} {
+		if err := c.Validate(); err != nil {
+			t.Errorf("validation of %+v failed: %v", c, err)
+		}
+	}
+	for _, c := range []cmn.TierConf{
+		{DemoteAge: cos.Duration(-time.Second)},
+		{Interval: cos.Duration(time.Second)},
+		{DemoteAge: cos.Duration(time.Minute)}, // (promote_age defaults to 1h)
+		{HighWM: 100},
+		{HighWM: 60}, // (lowwm defaults to 70)
+		{LowWM: 80},  // (highwm defaults to 80)
+		{LowWM: -1},
+	} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid %+v succeeded", c)
+		}
+	}
+}
+
+func TestMDIndexConf(t *testing.T) {
+	var c cmn.MDIndexConf
+	tassert.Errorf(t, !c.Enabled, "metadata index must be disabled by default")
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, c.FlushTimeX() == cmn.DfltMDIndexFlushTime, "unexpected default flush_time %v", c.FlushTimeX())
+	tassert.Errorf(t, c.MaxEntriesX() == cmn.DfltMDIndexMaxEntries, "unexpected default max_entries %d", c.MaxEntriesX())
+
+	for _, d := range []time.Duration{cmn.MinMDIndexFlushTime, time.Minute} {
+		c := cmn.MDIndexConf{Enabled: true, FlushTime: cos.Duration(d)}
+		if err := c.Validate(); err != nil {
+			t.Errorf("validation of %+v failed: %v", c, err)
+		}
+	}
+	for _, d := range []time.Duration{-time.Second, time.Millisecond} {
+		c := cmn.MDIndexConf{Enabled: true, FlushTime: cos.Duration(d)}
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid %+v succeeded", c)
+		}
+	}
+	for _, n := range []int{-1, cmn.MinMDIndexMaxEntries - 1} {
+		c := cmn.MDIndexConf{Enabled: true, MaxEntries: n}
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid %+v succeeded", c)
+		}
+	}
+}
+
+func TestDiskConfIOEngine(t *testing.T) {
+	dflt := cmn.DiskConf{
+		DiskUtilLowWM:   20,
+		DiskUtilHighWM:  80,
+		DiskUtilMaxWM:   95,
+		IostatTimeLong:  cos.Duration(2 * time.Second),
+		IostatTimeShort: cos.Duration(100 * time.Millisecond),
+	}
+	for _, engine := range []string{"", cmn.IOEngineSync, cmn.IOEngineUring} {
+		c := dflt
+		c.IOEngine = engine
+		if err := c.Validate(); err != nil {
+			t.Errorf("validation of io_engine %q failed: %v", engine, err)
+		}
+		tassert.Errorf(t, c.Uring() == (engine == cmn.IOEngineUring), "io_engine %q: unexpected Uring()", engine)
+	}
+	for _, engine := range []string{"aio", "IO_URING", "uring"} {
+		c := dflt
+		c.IOEngine = engine
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid io_engine %q succeeded", engine)
+		}
+	}
+}
+
+func TestReadAheadConf(t *testing.T) {
+	var (
+		config = cmn.Config{}
+		bck    = cmn.Bck{Name: "ra", Provider: apc.AIS}
+		bp     = bck.DefaultProps(&config.ClusterConfig)
+	)
+	tassert.Errorf(t, bp.ReadAhead.Count == 0, "read-ahead must be disabled by default")
+
+	toSet, err := cmn.NewBpropsToSet(cos.StrKVs{"read_ahead.count": "4"})
+	tassert.CheckFatal(t, err)
+	bp.Apply(toSet)
+	tassert.Errorf(t, bp.ReadAhead.Count == 4, "expected 4, got %d", bp.ReadAhead.Count)
+	tassert.CheckFatal(t, bp.ReadAhead.Validate())
+
+	for _, c := range []cmn.ReadAheadConf{{Count: -1}, {Count: cmn.MaxReadAhead + 1}} {
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid %+v succeeded", c)
+		}
+	}
+}
+
+func TestCksumConfExtra(t *testing.T) {
+	c := cmn.CksumConf{Type: cos.ChecksumCesXxh}
+	tassert.CheckFatal(t, c.Validate())
+	tassert.Errorf(t, len(c.ExtraTypes()) == 0, "expected no extra checksums, got %v", c.ExtraTypes())
+
+	c.Extra = "sha256,md5,xxhash2,sha256"
+	tassert.CheckFatal(t, c.Validate())
+	types := c.ExtraTypes()
+	tassert.Errorf(t, len(types) == 2 && types[0] == cos.ChecksumSHA256 && types[1] == cos.ChecksumMD5,
+		"expected [sha256 md5] (excluding primary and duplicates), got %v", types)
+
+	for _, extra := range []string{"none", "sha1", "sha256,", "sha256 md5"} {
+		c.Extra = extra
+		if err := c.Validate(); err == nil {
+			t.Errorf("validation of invalid extra checksums %q succeeded", extra)
+		}
+	}
+}
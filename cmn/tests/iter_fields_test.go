@@ -67,9 +67,17 @@ var _ = Describe("IterFields", func() {
 					"backend_bck.provider": apc.GCP,
 
 					"mirror.enabled":      false,
+					"mirror.placement":    "",
 					"mirror.copies":       int64(0),
 					"mirror.burst_buffer": 0,
 
+					"replication.dst":     "",
+					"replication.enabled": false,
+
+					"quota.size":     cos.SizeIEC(0),
+					"quota.objects":  int64(0),
+					"quota.soft_pct": int64(0),
+
 					"ec.enabled":           true,
 					"ec.parity_slices":     1024,
 					"ec.data_slices":       0,
@@ -79,6 +87,18 @@ var _ = Describe("IterFields", func() {
 					"ec.bundle_multiplier": 0,
 					"ec.disk_only":         false,
 
+					"chunks.objsize_limit": cos.SizeIEC(0),
+					"chunks.chunk_size":    cos.SizeIEC(0),
+
+					"pack.objsize_limit": cos.SizeIEC(0),
+					"pack.pack_size":     cos.SizeIEC(0),
+					"pack.compact_pct":   0,
+
+					"compress.algo":       "",
+					"compress.frame_size": cos.SizeIEC(0),
+					"dedup.enabled":       false,
+					"read_ahead.count":    0,
+
 					"versioning.enabled":           false,
 					"versioning.validate_warm_get": false,
 					"versioning.synchronize":       false,
@@ -88,6 +108,7 @@ var _ = Describe("IterFields", func() {
 					"checksum.validate_cold_get": false,
 					"checksum.validate_obj_move": false,
 					"checksum.enable_read_range": false,
+					"checksum.extra":             "",
 
 					"lru.enabled":           false,
 					"lru.dont_evict_time":   cos.Duration(0),
@@ -140,9 +161,17 @@ var _ = Describe("IterFields", func() {
 					"backend_bck.provider": (*string)(nil),
 
 					"mirror.enabled":      (*bool)(nil),
+					"mirror.placement":    (*string)(nil),
 					"mirror.copies":       (*int64)(nil),
 					"mirror.burst_buffer": (*int)(nil),
 
+					"replication.dst":     (*string)(nil),
+					"replication.enabled": (*bool)(nil),
+
+					"quota.size":     (*cos.SizeIEC)(nil),
+					"quota.objects":  (*int64)(nil),
+					"quota.soft_pct": (*int64)(nil),
+
 					"ec.enabled":           apc.Ptr(true),
 					"ec.parity_slices":     apc.Ptr(1024),
 					"ec.data_slices":       (*int)(nil),
@@ -152,6 +181,18 @@ var _ = Describe("IterFields", func() {
 					"ec.bundle_multiplier": (*int)(nil),
 					"ec.disk_only":         (*bool)(nil),
 
+					"chunks.objsize_limit": (*cos.SizeIEC)(nil),
+					"chunks.chunk_size":    (*cos.SizeIEC)(nil),
+
+					"pack.objsize_limit": (*cos.SizeIEC)(nil),
+					"pack.pack_size":     (*cos.SizeIEC)(nil),
+					"pack.compact_pct":   (*int)(nil),
+
+					"compress.algo":       (*string)(nil),
+					"compress.frame_size": (*cos.SizeIEC)(nil),
+					"dedup.enabled":       (*bool)(nil),
+					"read_ahead.count":    (*int)(nil),
+
 					"rate_limit.backend.enabled":            (*bool)(nil),
 					"rate_limit.frontend.enabled":           (*bool)(nil),
 					"rate_limit.backend.interval":           (*cos.Duration)(nil),
@@ -172,6 +213,7 @@ var _ = Describe("IterFields", func() {
 					"checksum.validate_cold_get": (*bool)(nil),
 					"checksum.validate_obj_move": (*bool)(nil),
 					"checksum.enable_read_range": (*bool)(nil),
+					"checksum.extra":             (*string)(nil),
 
 					"lru.enabled":           (*bool)(nil),
 					"lru.dont_evict_time":   (*cos.Duration)(nil),
@@ -340,6 +382,19 @@ var _ = Describe("IterFields", func() {
 					},
 				},
 			),
+			Entry("update some ConfigToSet (including map)",
+				&cmn.ConfigToSet{},
+				map[string]any{
+					"audit.enabled":      "true",
+					"audit.get_sampling": `{"ais://abc": 0.1, "*": 1}`,
+				},
+				&cmn.ConfigToSet{
+					Audit: &cmn.AuditConfToSet{
+						Enabled:     apc.Ptr(true),
+						GetSampling: map[string]float64{"ais://abc": 0.1, "*": 1},
+					},
+				},
+			),
 		)
 
 		DescribeTable("should error on update",
@@ -121,17 +121,24 @@ func NewCTFromBO(bck *cmn.Bck, objName string, b meta.Bowner, ctType ...string)
 			return
 		}
 	}
-	var digest uint64
-	ct.mi, digest, err = fs.Hrw(ct.bck.MakeUname(objName))
-	if err != nil {
-		return
-	}
-	ct.digest = digest
+	var (
+		digest uint64
+		uname  = ct.bck.MakeUname(objName)
+	)
 	if len(ctType) == 0 {
 		ct.contentType = fs.ObjectType
 	} else {
 		ct.contentType = ctType[0]
 	}
+	if ct.contentType == fs.ObjectType {
+		ct.mi, digest, err = fs.HrwObj(uname) // (see HrwFQN)
+	} else {
+		ct.mi, digest, err = fs.Hrw(uname)
+	}
+	if err != nil {
+		return
+	}
+	ct.digest = digest
 	ct.fqn = fs.CSM.Gen(ct, ct.contentType, "")
 	return
 }
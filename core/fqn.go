@@ -16,21 +16,37 @@ func ResolveFQN(fqn string, parsed *fs.ParsedFQN) (hrwFQN string, err error) {
 
 	// NOTE: _misplaced_ hrwFQN != fqn is checked elsewhere - see lom.IsHRW()
 
-	var digest uint64
-	hrwFQN, digest, err = HrwFQN(&parsed.Bck, parsed.ContentType, parsed.ObjName)
+	var (
+		mi     *fs.Mountpath
+		digest uint64
+		uname  = parsed.Bck.MakeUname(parsed.ObjName)
+	)
+	if parsed.ContentType == fs.ObjectType {
+		// HRW within the tier (see ltier.go)
+		mi, digest, err = fs.HrwTier(uname, parsed.Mountpath.Tier())
+	} else {
+		mi, digest, err = fs.Hrw(uname)
+	}
 	if err != nil {
 		return
 	}
 	parsed.Digest = digest
+	hrwFQN = mi.MakePathFQN(&parsed.Bck, parsed.ContentType, parsed.ObjName)
 	return
 }
 
+// NOTE: objects are placed on the fast tier, if any, unless the latter is full (see fs.HrwObj and ltier.go)
 func HrwFQN(bck *cmn.Bck, contentType, objName string) (fqn string, digest uint64, err error) {
 	var (
 		mi    *fs.Mountpath
 		uname = bck.MakeUname(objName)
 	)
-	if mi, digest, err = fs.Hrw(uname); err == nil {
+	if contentType == fs.ObjectType {
+		mi, digest, err = fs.HrwObj(uname)
+	} else {
+		mi, digest, err = fs.Hrw(uname)
+	}
+	if err == nil {
 		fqn = mi.MakePathFQN(bck, contentType, objName)
 	}
 	return
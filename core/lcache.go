@@ -416,7 +416,7 @@ func _flushAtime(md *lmeta, atime time.Time, mdTime int64) {
 	if err = lom.SetXattr(buf); err != nil {
 		T.FSHC(err, lom.Mountpath(), lom.FQN)
 	} else {
-		for copyFQN := range lom.md.copies {
+		for copyFQN, mi := range lom.md.copies {
 			if copyFQN == lom.FQN {
 				continue
 			}
@@ -425,6 +425,7 @@ func _flushAtime(md *lmeta, atime time.Time, mdTime int64) {
 				nlog.Errorln("set-xattr [", copyFQN, err, "]")
 				break
 			}
+			putMdx(mi, lom.Uname(), copyFQN, buf)
 		}
 	}
 	g.smm.Free(buf)
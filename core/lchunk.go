@@ -0,0 +1,887 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// Chunked objects =======================================================
+//
+// A (very) large object can be stored as a sequence of chunks, whereby:
+//   - the object's main file (lom.FQN) contains the chunk manifest (Ufest)
+//     instead of the object's content;
+//   - object metadata (lmeta) carries lmflChunk along with the object's
+//     logical size, checksum, version, and custom attributes;
+//   - each chunk is a separate file of the fs.ChunkType content type named
+//     "<object name>.<chunk-set ID>.<chunk number>" and located on the
+//     mountpath HRW(bucket, "<object name>.<chunk number>").
+//
+// Chunk placement does not depend on the chunk-set ID - which is why an S3
+// multipart upload can write each part directly onto its final mountpath and
+// then simply rename it (see ais/tgts3mpt.go).
+//
+// Each (re)write generates a new chunk-set ID; chunks that are not referenced
+// by the current manifest are orphans to be removed by space cleanup.
+//
+// Reading: lom.Open() returns a reader (io.ReaderAt, io.Seeker) over all
+// chunks; NewRangeReader() reads a given range in parallel.
+//
+// Redundancy: EC and (cross-target) mirroring read chunked objects via lom.Open(),
+// and so does global rebalance; n-way mirroring (local copies) is not supported:
+// buckets with local mirroring do not chunk (S3 multipart upload included), and
+// bucket props that combine the two are rejected (see cmn.MirrorConf.ValidateLocal).
+// ========================================================================
+
+type (
+	Uchunk struct {
+		Cksum *cos.Cksum // (nil when not computed)
+		Size  int64
+		Num   int // chunk number (e.g., S3 part number)
+	}
+	Ufest struct {
+		ID     string // chunk-set ID
+		Chunks []Uchunk
+		Size   int64 // object size (sum of all chunks)
+	}
+)
+
+const (
+	// max length of the ".<ID>.<num>" suffix appended to the object name
+	maxChunkSuffix = ".XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX.4294967295"
+
+	// parallel range read: segment size and number of segments in flight
+	rrSegSize = 4 * cos.MiB
+	rrWidth   = 4
+)
+
+// interface guard
+var (
+	_ cos.LomWriter      = (*ChunkWriter)(nil)
+	_ cos.LomReader      = (*ufestReader)(nil)
+	_ cos.ReadOpenCloser = (*ufestReader)(nil)
+	_ io.Seeker          = (*ufestReader)(nil)
+	_ cos.ReadOpenCloser = (*rangeReader)(nil)
+)
+
+//
+// LOM: chunk names and placement
+//
+
+// whether the object name leaves enough room for chunk names (see fs.IsFntl)
+// and the bucket is not mirrored locally
+func (lom *LOM) CanChunk() bool {
+	if lom.Bprops().Mirror.Local() {
+		return false
+	}
+	return !lom.IsFntl() && !fs.IsFntl(lom.ObjName+maxChunkSuffix)
+}
+
+func (lom *LOM) SetChunked(v bool) {
+	if v {
+		lom.md.lid = lom.md.lid.setlmfl(lmflChunk)
+	} else {
+		lom.md.lid = lom.md.lid.clrlmfl(lmflChunk)
+	}
+}
+
+func chunkName(objName, id string, num int) string {
+	return objName + "." + id + "." + strconv.Itoa(num)
+}
+
+// returns (object name, chunk-set ID, chunk number)
+func parseChunkName(name string) (objName, id string, num int, ok bool) {
+	base, _, ok := fs.CSM.Resolver(fs.ChunkType).ParseUniqueFQN(name)
+	if !ok {
+		return "", "", 0, false
+	}
+	i := strings.LastIndexByte(name, '.')
+	num, err := strconv.Atoi(name[i+1:])
+	if err != nil {
+		return "", "", 0, false
+	}
+	return base, name[len(base)+1 : i], num, true
+}
+
+func chunkMpath(bck *cmn.Bck, objName string, num int) (*fs.Mountpath, error) {
+	mi, _, err := fs.Hrw(bck.MakeUname(objName + "." + strconv.Itoa(num)))
+	return mi, err
+}
+
+func (lom *LOM) chunkFQN(id string, num int) (string, error) {
+	mi, err := chunkMpath(lom.Bucket(), lom.ObjName, num)
+	if err != nil {
+		return "", err
+	}
+	return mi.MakePathFQN(lom.Bucket(), fs.ChunkType, chunkName(lom.ObjName, id, num)), nil
+}
+
+// workfile on the mountpath of the given (future) chunk
+// (so that the chunk can be later added via Ufest.AddChunk() rename)
+func (lom *LOM) ChunkWorkFQN(num int, prefix string) (string, error) {
+	mi, err := chunkMpath(lom.Bucket(), lom.ObjName, num)
+	if err != nil {
+		return "", err
+	}
+	return fs.CSM.Gen(&chunkParts{lom.ObjName, lom.Bucket(), mi}, fs.WorkfileType, prefix), nil
+}
+
+// (fs.PartsFQN) to generate workfile names on a given mountpath
+type chunkParts struct {
+	objName string
+	bck     *cmn.Bck
+	mi      *fs.Mountpath
+}
+
+func (p *chunkParts) ObjectName() string       { return p.objName }
+func (p *chunkParts) Bucket() *cmn.Bck         { return p.bck }
+func (p *chunkParts) Mountpath() *fs.Mountpath { return p.mi }
+
+//
+// chunk-set IDs that are currently being written or finalized
+// (and must not be treated as orphans)
+//
+
+func (u *Ufest) inflight() { g.ufests.Store(u.ID, u) }
+
+func (u *Ufest) Done() { g.ufests.Delete(u.ID) }
+
+//////////////////////////
+// Ufest (aka manifest) //
+//////////////////////////
+
+// new (empty) manifest with a new chunk-set ID
+// the caller must call u.Done() upon finalizing (or aborting) the object
+func NewUfest() *Ufest {
+	u := &Ufest{ID: cos.GenUUID()}
+	u.inflight()
+	return u
+}
+
+// rename workfile into the chunk (see also: lom.ChunkWorkFQN)
+func (u *Ufest) AddChunk(lom *LOM, wfqn string, num int, size int64, cksum *cos.Cksum) error {
+	debug.Assert(num > 0 && (len(u.Chunks) == 0 || u.Chunks[len(u.Chunks)-1].Num < num), num)
+	fqn, err := lom.chunkFQN(u.ID, num)
+	if err != nil {
+		return err
+	}
+	if err := cos.Rename(wfqn, fqn); err != nil {
+		// (e.g., mountpath change since the workfile was created)
+		if _, _, err = cos.CopyFile(wfqn, fqn, nil, cos.ChecksumNone); err != nil {
+			return err
+		}
+		if errV := cos.RemoveFile(wfqn); errV != nil {
+			nlog.Warningln("failed to remove", wfqn, "[", errV, "]")
+		}
+	}
+	u.Chunks = append(u.Chunks, Uchunk{Cksum: cksum, Size: size, Num: num})
+	u.Size += size
+	return nil
+}
+
+// remove all chunks (best effort)
+func (u *Ufest) Remove(lom *LOM) (err error) {
+	for i := range u.Chunks {
+		c := &u.Chunks[i]
+		fqn, erf := lom.chunkFQN(u.ID, c.Num)
+		if erf == nil {
+			erf = cos.RemoveFile(fqn)
+		}
+		if erf != nil && err == nil {
+			err = erf
+		}
+	}
+	return err
+}
+
+// store manifest as the content of the (work) file that will subsequently
+// become the object's main file
+func (u *Ufest) Store(lom *LOM, fqn string) error {
+	fh, err := cos.CreateFile(fqn)
+	if err != nil {
+		return err
+	}
+	if _, err = fh.Write(u.pack()); err == nil && lom.IsFeatureSet(feat.FsyncPUT) {
+		err = fh.Sync()
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err != nil {
+		if nerr := cos.RemoveFile(fqn); nerr != nil {
+			nlog.Errorln("nested err:", nerr)
+		}
+	}
+	return err
+}
+
+// load manifest of a chunked object
+func (lom *LOM) LoadUfest() (*Ufest, error) {
+	debug.Assert(lom.IsChunked(true), lom.Cname())
+	return loadUfest(lom.FQN)
+}
+
+// load manifest from the workfile (e.g., see ChunkWriter.Close)
+func LoadUfest(wfqn string) (*Ufest, error) { return loadUfest(wfqn) }
+
+func loadUfest(fqn string) (*Ufest, error) {
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return nil, err
+	}
+	u := &Ufest{}
+	if err := u.unpack(b); err != nil {
+		return nil, fmt.Errorf("%s [%q]: %w", badChunk, fqn, err)
+	}
+	return u, nil
+}
+
+// | --------------- PREAMBLE --------------- | ------- PAYLOAD ------- |
+// | MetaverChunk | mdCksumTyXXHash | xxhash64 | ID, size, num chunks   |
+// |              |                 |          | { num, size, cksum }*  |
+func (u *Ufest) pack() []byte {
+	l := prefLen + cos.SizeofI16 + len(u.ID) + cos.SizeofI64 + cos.SizeofI32
+	for i := range u.Chunks {
+		ty, val := u.Chunks[i].Cksum.Get()
+		l += cos.SizeofI32 + cos.SizeofI64 + 2*cos.SizeofI16 + len(ty) + len(val)
+	}
+	buf := make([]byte, prefLen, l)
+	buf = _packStr(buf, u.ID)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(u.Size))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(u.Chunks)))
+	for i := range u.Chunks {
+		c := &u.Chunks[i]
+		ty, val := c.Cksum.Get()
+		buf = binary.BigEndian.AppendUint32(buf, uint32(c.Num))
+		buf = binary.BigEndian.AppendUint64(buf, uint64(c.Size))
+		buf = _packStr(buf, ty)
+		buf = _packStr(buf, val)
+	}
+	buf[0] = MetaverChunk
+	buf[1] = mdCksumTyXXHash
+	binary.BigEndian.PutUint64(buf[2:], onexxh.Checksum64S(buf[prefLen:], cos.MLCG32))
+	return buf
+}
+
+func _packStr(buf []byte, s string) []byte {
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(s)))
+	return append(buf, s...)
+}
+
+func (u *Ufest) unpack(buf []byte) (err error) {
+	if len(buf) < prefLen {
+		return fmt.Errorf("too short (%d)", len(buf))
+	}
+	if buf[0] != MetaverChunk {
+		return fmt.Errorf("unknown version %d", buf[0])
+	}
+	if buf[1] != mdCksumTyXXHash {
+		return fmt.Errorf("unknown checksum %d", buf[1])
+	}
+	var (
+		payload  = buf[prefLen:]
+		expected = binary.BigEndian.Uint64(buf[2:])
+		actual   = onexxh.Checksum64S(payload, cos.MLCG32)
+	)
+	if expected != actual {
+		return cos.NewErrMetaCksum(expected, actual, "chunk manifest")
+	}
+	var (
+		off  int
+		size int64
+		n    uint32
+	)
+	if u.ID, off, err = _unpackStr(payload, off); err != nil {
+		return err
+	}
+	if len(payload) < off+cos.SizeofI64+cos.SizeofI32 {
+		return errors.New("#1")
+	}
+	u.Size = int64(binary.BigEndian.Uint64(payload[off:]))
+	off += cos.SizeofI64
+	n = binary.BigEndian.Uint32(payload[off:])
+	off += cos.SizeofI32
+
+	u.Chunks = make([]Uchunk, n)
+	for i := range u.Chunks {
+		var (
+			c       = &u.Chunks[i]
+			ty, val string
+		)
+		if len(payload) < off+cos.SizeofI32+cos.SizeofI64 {
+			return errors.New("#2")
+		}
+		c.Num = int(binary.BigEndian.Uint32(payload[off:]))
+		off += cos.SizeofI32
+		c.Size = int64(binary.BigEndian.Uint64(payload[off:]))
+		off += cos.SizeofI64
+		if ty, off, err = _unpackStr(payload, off); err != nil {
+			return err
+		}
+		if val, off, err = _unpackStr(payload, off); err != nil {
+			return err
+		}
+		c.Cksum = cos.NewCksum(ty, val)
+		size += c.Size
+		if i > 0 && c.Num <= u.Chunks[i-1].Num {
+			return fmt.Errorf("#3 (chunk %d after %d)", c.Num, u.Chunks[i-1].Num)
+		}
+	}
+	if off != len(payload) {
+		return errors.New("#4")
+	}
+	if size != u.Size {
+		return fmt.Errorf("#5 (size %d vs %d)", size, u.Size)
+	}
+	return nil
+}
+
+func _unpackStr(payload []byte, off int) (string, int, error) {
+	if len(payload) < off+cos.SizeofI16 {
+		return "", off, errors.New("#6")
+	}
+	l := int(binary.BigEndian.Uint16(payload[off:]))
+	off += cos.SizeofI16
+	if len(payload) < off+l {
+		return "", off, errors.New("#7")
+	}
+	return string(payload[off : off+l]), off + l, nil
+}
+
+// the manifest of the object that is about to be overwritten (or nil)
+// is read directly from disk, without touching in-memory metadata
+func (lom *LOM) PrevUfest() *Ufest {
+	md, err := lom.lmfs(false)
+	if err != nil || md == nil || !md.lid.haslmfl(lmflChunk) {
+		return nil
+	}
+	u, err := loadUfest(lom.FQN)
+	if err != nil {
+		nlog.Warningln(lom.Cname(), err)
+		return nil
+	}
+	return u
+}
+
+// copy object's content => whole (non-chunked) file
+func (lom *LOM) CopyWhole(dst string, buf []byte, cksumType string) (int64, *cos.CksumHash, error) {
+	var (
+		r   cos.LomReader
+		err error
+	)
+	switch {
+	case lom.IsChunked(true):
+		r, err = lom.newUfestReader()
+	case lom.IsPacked(true):
+		r, err = lom.newPackReader()
+	case lom.IsCompressed(true):
+		r, err = lom.newComprReader()
+	case lom.IsDeduped(true):
+		return copyFile(lom.dedupFQN(), lom.mi, dst, nil, buf, cksumType)
+	default:
+		return copyFile(lom.FQN, lom.mi, dst, nil, buf, cksumType)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	defer r.Close()
+	fh, err := cos.CreateFile(dst)
+	if err != nil {
+		return 0, nil, err
+	}
+	written, cksum, err := cos.CopyAndChecksum(fh, r, buf, cksumType)
+	if errC := cos.FlushClose(fh); err == nil {
+		err = errC
+	}
+	if err != nil {
+		if nerr := cos.RemoveFile(dst); nerr != nil {
+			nlog.Errorln("nested err:", nerr)
+		}
+	}
+	return written, cksum, err
+}
+
+/////////////////
+// ChunkWriter //
+/////////////////
+
+// writes object's content directly into chunks (see lom.CreateChunked);
+// Close() stores the manifest into the provided workfile
+type ChunkWriter struct {
+	lom       *LOM
+	ufest     *Ufest
+	fh        *os.File       // current chunk
+	cksum     *cos.CksumHash // ditto
+	wfqn      string
+	cksumType string
+	chunkSize int64
+	off       int64 // offset in the current chunk
+}
+
+func (lom *LOM) CreateChunked(wfqn string, chunkSize int64) (*ChunkWriter, error) {
+	debug.Assert(chunkSize > 0 && lom.CanChunk(), lom.Cname())
+	// (may fail early due to fntl or missing bdir)
+	fh, err := lom._cf(wfqn)
+	if err != nil {
+		return nil, err
+	}
+	cos.Close(fh)
+	lom.md.clrDedup()
+	return &ChunkWriter{
+		lom:       lom,
+		ufest:     NewUfest(),
+		wfqn:      wfqn,
+		cksumType: lom.CksumType(),
+		chunkSize: chunkSize,
+	}, nil
+}
+
+func (cw *ChunkWriter) Ufest() *Ufest { return cw.ufest }
+
+func (cw *ChunkWriter) Write(p []byte) (written int, err error) {
+	for len(p) > 0 {
+		if cw.fh == nil {
+			if err = cw.next(); err != nil {
+				return written, err
+			}
+		}
+		var (
+			n int
+			l = min(int64(len(p)), cw.chunkSize-cw.off)
+		)
+		n, err = cw.fh.Write(p[:l])
+		written += n
+		cw.off += int64(n)
+		if cw.cksum != nil {
+			cw.cksum.H.Write(p[:n])
+		}
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+		if cw.off == cw.chunkSize {
+			if err = cw.fini(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (cw *ChunkWriter) next() (err error) {
+	var (
+		u   = cw.ufest
+		num = len(u.Chunks) + 1
+		fqn string
+	)
+	if fqn, err = cw.lom.chunkFQN(u.ID, num); err != nil {
+		return err
+	}
+	if cw.fh, err = cos.CreateFile(fqn); err != nil {
+		return err
+	}
+	cw.off = 0
+	if cw.cksumType != cos.ChecksumNone {
+		cw.cksum = cos.NewCksumHash(cw.cksumType)
+	}
+	// (size and checksum get updated when the chunk is done)
+	u.Chunks = append(u.Chunks, Uchunk{Num: num})
+	return nil
+}
+
+func (cw *ChunkWriter) fini() (err error) {
+	if cw.lom.IsFeatureSet(feat.FsyncPUT) {
+		err = cw.fh.Sync()
+	}
+	if errC := cw.fh.Close(); err == nil {
+		err = errC
+	}
+	cw.fh = nil
+	c := &cw.ufest.Chunks[len(cw.ufest.Chunks)-1]
+	c.Size = cw.off
+	cw.ufest.Size += cw.off
+	if cw.cksum != nil {
+		cw.cksum.Finalize()
+		c.Cksum = cw.cksum.Clone()
+		cw.cksum = nil
+	}
+	return err
+}
+
+func (cw *ChunkWriter) Sync() error {
+	if cw.fh == nil {
+		return nil
+	}
+	return cw.fh.Sync()
+}
+
+// finalize the last chunk, store the manifest, and mark the object chunked
+func (cw *ChunkWriter) Close() error {
+	if cw.fh != nil {
+		if err := cw.fini(); err != nil {
+			return err
+		}
+	}
+	if err := cw.ufest.Store(cw.lom, cw.wfqn); err != nil {
+		return err
+	}
+	cw.lom.SetChunked(true)
+	return nil
+}
+
+// remove all chunks written so far (not removing the workfile)
+func (cw *ChunkWriter) Abort() {
+	if cw.fh != nil {
+		cw.fh.Close()
+		cw.fh = nil
+	}
+	if err := cw.ufest.Remove(cw.lom); err != nil {
+		nlog.Warningln(cw.lom.Cname(), "failed to remove chunks: [", err, "]")
+	}
+	cw.ufest.Done()
+}
+
+/////////////////
+// ufestReader //
+/////////////////
+
+// reads chunked object as if it was a single file
+type ufestReader struct {
+	ufest   *Ufest
+	bck     cmn.Bck
+	objName string
+	offs    []int64    // chunk offsets
+	fhs     []*os.File // opened on demand
+	mu      sync.Mutex
+	off     int64 // Read/Seek
+}
+
+func (lom *LOM) newUfestReader() (*ufestReader, error) {
+	u, err := lom.LoadUfest()
+	if err != nil {
+		return nil, err
+	}
+	if u.Size != lom.md.Size {
+		return nil, fmt.Errorf("%s: chunk manifest size %d vs %d", lom.Cname(), u.Size, lom.md.Size)
+	}
+	return _newUfestReader(u, lom.Bucket(), lom.ObjName), nil
+}
+
+// reader over the chunks of a new (not yet finalized) object
+func NewUfestReader(u *Ufest, lom *LOM) cos.ReadOpenCloser {
+	return _newUfestReader(u, lom.Bucket(), lom.ObjName)
+}
+
+func _newUfestReader(u *Ufest, bck *cmn.Bck, objName string) *ufestReader {
+	r := &ufestReader{
+		ufest:   u,
+		bck:     *bck,
+		objName: objName,
+		offs:    make([]int64, len(u.Chunks)),
+		fhs:     make([]*os.File, len(u.Chunks)),
+	}
+	var off int64
+	for i := range u.Chunks {
+		r.offs[i] = off
+		off += u.Chunks[i].Size
+	}
+	return r
+}
+
+func (r *ufestReader) Open() (cos.ReadOpenCloser, error) {
+	return _newUfestReader(r.ufest, &r.bck, r.objName), nil
+}
+
+func (r *ufestReader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadAt(p, r.off)
+	r.off += int64(n)
+	return n, err
+}
+
+func (r *ufestReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	for n < len(p) {
+		if off >= r.ufest.Size {
+			return n, io.EOF
+		}
+		var (
+			fh *os.File
+			m  int
+			i  = sort.Search(len(r.offs), func(j int) bool { return r.offs[j] > off }) - 1
+			c  = &r.ufest.Chunks[i]
+			x  = off - r.offs[i]
+			l  = min(int64(len(p)-n), c.Size-x)
+		)
+		if fh, err = r.open(i); err != nil {
+			return n, err
+		}
+		m, err = fh.ReadAt(p[n:n+int(l)], x)
+		n += m
+		off += int64(m)
+		if err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("%s: chunk %d is truncated (%w)", r.bck.Cname(r.objName), c.Num, io.ErrUnexpectedEOF)
+			}
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (r *ufestReader) open(i int) (*os.File, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if fh := r.fhs[i]; fh != nil {
+		return fh, nil
+	}
+	var (
+		num  = r.ufest.Chunks[i].Num
+		name = chunkName(r.objName, r.ufest.ID, num)
+	)
+	mi, err := chunkMpath(&r.bck, r.objName, num)
+	if err != nil {
+		return nil, err
+	}
+	fh, err := os.Open(mi.MakePathFQN(&r.bck, fs.ChunkType, name))
+	if cos.IsNotExist(err) {
+		// not yet resilvered?
+		for _, mi := range fs.GetAvail() {
+			if fh, err = os.Open(mi.MakePathFQN(&r.bck, fs.ChunkType, name)); !cos.IsNotExist(err) {
+				break
+			}
+		}
+	}
+	if err != nil {
+		if cos.IsNotExist(err) {
+			err = fmt.Errorf("%s: missing chunk %d: %w", r.bck.Cname(r.objName), num, err)
+		}
+		return nil, err
+	}
+	r.fhs[i] = fh
+	return fh, nil
+}
+
+func (r *ufestReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	case io.SeekEnd:
+		offset += r.ufest.Size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.off = offset
+	return offset, nil
+}
+
+func (r *ufestReader) Close() (err error) {
+	r.mu.Lock()
+	for i, fh := range r.fhs {
+		if fh != nil {
+			if errC := fh.Close(); errC != nil && err == nil {
+				err = errC
+			}
+			r.fhs[i] = nil
+		}
+	}
+	r.mu.Unlock()
+	return err
+}
+
+/////////////////
+// rangeReader //
+/////////////////
+
+// reads the [off, off+length) range of a chunked object in parallel
+type (
+	rangeReader struct {
+		r       *ufestReader
+		pending chan *rrseg
+		stopCh  chan struct{}
+		cur     *rrseg
+		off     int64
+		end     int64
+	}
+	rrseg struct {
+		sgl  *memsys.SGL
+		err  error
+		done chan struct{}
+	}
+)
+
+// returns nil unless the (LOM) reader is chunked and the range spans multiple chunks
+// (the caller must close the returned reader - the underlying reader remains open)
+func NewRangeReader(lmfh cos.LomReader, off, length int64) cos.ReadOpenCloser {
+	if lh, ok := lmfh.(*LomHandle); ok {
+		lmfh = lh.LomReader
+	}
+	r, ok := lmfh.(*ufestReader)
+	if !ok || length <= rrSegSize {
+		return nil
+	}
+	i := sort.Search(len(r.offs), func(j int) bool { return r.offs[j] > off }) - 1
+	if i < 0 || r.offs[i]+r.ufest.Chunks[i].Size >= off+length {
+		return nil // single chunk
+	}
+	rr := &rangeReader{
+		r:       r,
+		pending: make(chan *rrseg, rrWidth),
+		stopCh:  make(chan struct{}),
+		off:     off,
+		end:     off + length,
+	}
+	go rr.run()
+	return rr
+}
+
+func (rr *rangeReader) run() {
+	defer close(rr.pending)
+	for off := rr.off; off < rr.end; {
+		// never cross chunk boundaries
+		i := sort.Search(len(rr.r.offs), func(j int) bool { return rr.r.offs[j] > off }) - 1
+		n := min(rrSegSize, rr.end-off, rr.r.offs[i]+rr.r.ufest.Chunks[i].Size-off)
+		seg := &rrseg{done: make(chan struct{})}
+		select {
+		case rr.pending <- seg: // (blocks when rrWidth segments are in flight)
+		case <-rr.stopCh:
+			return
+		}
+		go rr.read(seg, off, n)
+		off += n
+	}
+}
+
+func (rr *rangeReader) read(seg *rrseg, off, n int64) {
+	seg.sgl = g.pmm.NewSGL(n)
+	_, seg.err = seg.sgl.ReadFrom(io.NewSectionReader(rr.r, off, n))
+	close(seg.done)
+}
+
+func (rr *rangeReader) Read(p []byte) (int, error) {
+	for {
+		if rr.cur == nil {
+			seg, ok := <-rr.pending
+			if !ok {
+				return 0, io.EOF
+			}
+			<-seg.done
+			rr.cur = seg
+			if seg.err != nil {
+				return 0, seg.err
+			}
+		}
+		n, err := rr.cur.sgl.Read(p)
+		if n > 0 || len(p) == 0 {
+			return n, nil
+		}
+		if err != io.EOF {
+			return 0, err
+		}
+		rr.cur.sgl.Free()
+		rr.cur = nil
+	}
+}
+
+func (*rangeReader) Open() (cos.ReadOpenCloser, error) {
+	return nil, cmn.NewErrUnsupp("reopen", "range reader")
+}
+
+func (rr *rangeReader) Close() error {
+	close(rr.stopCh)
+	if rr.cur != nil {
+		rr.cur.sgl.Free()
+		rr.cur = nil
+	}
+	for seg := range rr.pending {
+		<-seg.done
+		seg.sgl.Free()
+	}
+	return nil
+}
+
+//
+// resilver and space cleanup
+//
+
+// move chunk to its current HRW mountpath
+func MoveChunk(ct *CT, buf []byte) (moved bool, _ error) {
+	objName, _, num, ok := parseChunkName(ct.ObjectName())
+	if !ok {
+		return false, fmt.Errorf("%s: invalid chunk name %q", badChunk, ct.FQN())
+	}
+	mi, err := chunkMpath(ct.Bucket(), objName, num)
+	if err != nil || mi.Path == ct.Mountpath().Path {
+		return false, err
+	}
+	var (
+		dst  = mi.MakePathFQN(ct.Bucket(), fs.ChunkType, ct.ObjectName())
+		wfqn = fs.CSM.Gen(&chunkParts{ct.ObjectName(), ct.Bucket(), mi}, fs.WorkfileType, "mv")
+	)
+	// copy, rename into place (readers may be looking for it), and remove the source
+	if _, _, err := cos.CopyFile(ct.FQN(), wfqn, buf, cos.ChecksumNone); err != nil {
+		return false, err
+	}
+	if err := cos.Rename(wfqn, dst); err != nil {
+		if nerr := cos.RemoveFile(wfqn); nerr != nil {
+			nlog.Errorln("nested err:", nerr)
+		}
+		return false, err
+	}
+	return true, cos.RemoveFile(ct.FQN())
+}
+
+// chunk that is not referenced by its object's manifest
+// (the caller must make sure that neither rebalance nor resilver is running)
+func IsOrphanChunk(bck *cmn.Bck, name string) bool {
+	objName, id, _, ok := parseChunkName(name)
+	if !ok {
+		return true
+	}
+	if _, ok := g.ufests.Load(id); ok {
+		return false
+	}
+	lom := AllocLOM(objName)
+	defer FreeLOM(lom)
+	if err := lom.InitBck(bck); err != nil {
+		return false
+	}
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return cos.IsNotExist(err) || cos.IsErrNotFound(err)
+	}
+	if !lom.IsChunked() {
+		return true
+	}
+	u, err := lom.LoadUfest()
+	if err != nil {
+		return false
+	}
+	return u.ID != id
+}
@@ -0,0 +1,232 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chunked objects", func() {
+	const (
+		tmpDir    = "/tmp/lchunk_test"
+		numMpaths = 3
+		bucket    = "LCHUNK_TEST"
+		chunkSize = 3 * cos.MiB
+		objSize   = 10*cos.MiB + 123
+	)
+
+	var (
+		mpaths []string
+		bck    = cmn.Bck{Name: bucket, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		bmd    = mock.NewBaseBownerMock(
+			meta.NewBck(bucket, apc.AIS, cmn.NsGlobal, &cmn.Bprops{Cksum: cmn.CksumConf{Type: cos.ChecksumOneXxh}, BID: 301}),
+		)
+	)
+	for i := range numMpaths {
+		mpaths = append(mpaths, fmt.Sprintf("%s/mpath%d", tmpDir, i))
+	}
+
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.ChunkType, &fs.ChunkContentResolver{}, true)
+
+	BeforeEach(func() {
+		for _, mpath := range mpaths {
+			_ = cos.CreateDir(mpath)
+			_, _ = fs.Add(mpath, "daeID")
+		}
+		_ = mock.NewTarget(bmd)
+		_ = fs.CreateBucket(&bck, false)
+	})
+
+	AfterEach(func() {
+		// (other tests' mountpaths may still be there)
+		for _, mi := range fs.GetAvail() {
+			_ = os.RemoveAll(mi.MakePathBck(&bck))
+		}
+		for _, mpath := range mpaths {
+			_, _ = fs.Remove(mpath)
+		}
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	numChunkFiles := func() (n int) {
+		for _, mi := range fs.GetAvail() {
+			_ = filepath.Walk(mi.MakePathCT(&bck, fs.ChunkType), func(_ string, info os.FileInfo, err error) error {
+				if err == nil && !info.IsDir() {
+					n++
+				}
+				return nil
+			})
+		}
+		return n
+	}
+
+	// write chunked object and return its content
+	putChunked := func(objName string) (*core.LOM, []byte) {
+		data := make([]byte, objSize)
+		_, _ = cryptorand.Read(data)
+
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		Expect(lom.CanChunk()).To(BeTrue())
+		lom.Lock(true)
+		defer lom.Unlock(true)
+
+		wfqn := fs.CSM.Gen(lom, fs.WorkfileType, "test")
+		cw, err := lom.CreateChunked(wfqn, chunkSize)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = io.Copy(cw, bytes.NewReader(data))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cw.Close()).NotTo(HaveOccurred())
+		defer cw.Ufest().Done()
+
+		Expect(cw.Ufest().Size).To(BeEquivalentTo(objSize))
+		Expect(cw.Ufest().Chunks).To(HaveLen((objSize + chunkSize - 1) / chunkSize))
+		Expect(lom.IsChunked(true)).To(BeTrue())
+
+		lom.SetSize(objSize)
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		Expect(lom.RenameFinalize(wfqn)).NotTo(HaveOccurred())
+		Expect(lom.PersistMain()).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+		return lom, data
+	}
+
+	It("should write, load, and read chunked object", func() {
+		lom, data := putChunked("dir/chunked-obj")
+		Expect(numChunkFiles()).To(Equal(4))
+
+		newLom := &core.LOM{ObjName: lom.ObjName}
+		Expect(newLom.InitBck(&bck)).NotTo(HaveOccurred())
+		newLom.Lock(false)
+		defer newLom.Unlock(false)
+		Expect(newLom.Load(false, true)).NotTo(HaveOccurred())
+		Expect(newLom.IsChunked()).To(BeTrue())
+		Expect(newLom.Lsize()).To(BeEquivalentTo(objSize))
+
+		u, err := newLom.LoadUfest()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.Size).To(BeEquivalentTo(objSize))
+		for _, c := range u.Chunks {
+			Expect(c.Cksum.IsEmpty()).To(BeFalse())
+		}
+
+		// sequential read
+		fh, err := newLom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(fh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data)).To(BeTrue())
+
+		// read at: crossing chunk boundaries
+		p := make([]byte, cos.MiB)
+		off := int64(chunkSize - cos.MiB/2)
+		n, err := fh.ReadAt(p, off)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(cos.MiB))
+		Expect(bytes.Equal(p, data[off:off+cos.MiB])).To(BeTrue())
+
+		// parallel range read
+		off, length := int64(cos.MiB+7), int64(8*cos.MiB)
+		rr := core.NewRangeReader(fh, off, length)
+		Expect(rr).NotTo(BeNil())
+		b, err = io.ReadAll(rr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rr.Close()).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data[off:off+length])).To(BeTrue())
+
+		// single chunk: not applicable
+		Expect(core.NewRangeReader(fh, 0, cos.MiB)).To(BeNil())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+
+		// content checksum
+		Expect(newLom.ValidateContentChecksum(true)).NotTo(HaveOccurred())
+	})
+
+	It("should read chunked object via handle (EC, rebalance)", func() {
+		lom, data := putChunked("chunked-obj-handle")
+
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		lh, err := lom.NewHandle(false /*loaded*/)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lom.IsChunked()).To(BeTrue())
+
+		// EC: data slices are sections of the handle
+		const sliceSize = 4*cos.MiB + 1
+		for off := int64(0); off < objSize; off += sliceSize {
+			size := min(sliceSize, objSize-off)
+			b, err := io.ReadAll(cos.NewSectionHandle(lh, off, size, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bytes.Equal(b, data[off:off+size])).To(BeTrue())
+		}
+		Expect(lh.Close()).NotTo(HaveOccurred())
+
+		// rebalance: reopen and send as a whole
+		rc, err := lh.Open()
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(rc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rc.Close()).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data)).To(BeTrue())
+	})
+
+	It("should remove chunks along with the object", func() {
+		lom, _ := putChunked("chunked-obj-rm")
+		Expect(numChunkFiles()).To(Equal(4))
+
+		lom.Lock(true)
+		Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+		Expect(lom.RemoveObj()).NotTo(HaveOccurred())
+		lom.Unlock(true)
+		Expect(numChunkFiles()).To(BeZero())
+	})
+
+	It("should detect orphan chunks", func() {
+		lom, _ := putChunked("chunked-obj-orphans")
+		lom.Lock(true)
+		Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+		u, err := lom.LoadUfest()
+		lom.Unlock(true)
+		Expect(err).NotTo(HaveOccurred())
+
+		name := fmt.Sprintf("%s.%s.%d", lom.ObjName, u.ID, u.Chunks[0].Num)
+		Expect(core.IsOrphanChunk(&bck, name)).To(BeFalse())
+		Expect(core.IsOrphanChunk(&bck, lom.ObjName+".some-other-id.1")).To(BeTrue())
+		Expect(core.IsOrphanChunk(&bck, "missing-obj."+u.ID+".1")).To(BeTrue())
+	})
+
+	It("should fail to read corrupted manifest", func() {
+		lom, _ := putChunked("chunked-obj-corrupted")
+		b, err := os.ReadFile(lom.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		b[len(b)-1]++
+		Expect(os.WriteFile(lom.FQN, b, cos.PermRWR)).NotTo(HaveOccurred())
+
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+		_, err = lom.Open()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,217 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Multiple checksums ===================================================
+//
+// In addition to its primary checksum (lom.Checksum()), an object may carry
+// checksums of other types (bucket's `checksum.extra`), e.g., xxhash2 for
+// in-cluster validation and sha256 for clients:
+//   - all configured types are computed in a single pass when the object is
+//     written (see ais/tgtobj.go) and, for existing objects, by x-recompute-cksum
+//     that also upgrades the primary checksum when bucket's `checksum.type` changes;
+//   - additional checksums are stored with the object's metadata (packedCksums)
+//     and bound to the object's size and primary checksum: changing either
+//     (e.g., overwrite or append) invalidates them;
+//   - returned upon request (apc.QparamCksumType; S3 checksum mode).
+// ======================================================================
+
+// lmeta.xcks: size, primary type and value, followed by additional (type, value)
+// pairs - all separated by `stringSepa`
+
+func (md *lmeta) xcksums() (cksums []*cos.Cksum) {
+	if md.xcks == "" {
+		return nil
+	}
+	parts := strings.Split(md.xcks, stringSepa)
+	if len(parts) < 5 || len(parts)%2 == 0 {
+		return nil
+	}
+	// bound to size and primary checksum
+	ty, val := md.Cksum.Get()
+	if parts[0] != strconv.FormatInt(md.Size, 10) || parts[1] != ty || parts[2] != val {
+		return nil
+	}
+	cksums = make([]*cos.Cksum, 0, (len(parts)-3)/2)
+	for i := 3; i < len(parts); i += 2 {
+		if cos.ValidateCksumType(parts[i]) != nil {
+			return nil
+		}
+		cksums = append(cksums, cos.NewCksum(parts[i], parts[i+1]))
+	}
+	return cksums
+}
+
+func (md *lmeta) setXcksums(cksums []*cos.Cksum) {
+	if len(cksums) == 0 {
+		md.xcks = ""
+		return
+	}
+	var (
+		sb      strings.Builder
+		ty, val = md.Cksum.Get()
+	)
+	sb.WriteString(strconv.FormatInt(md.Size, 10))
+	for _, s := range []string{ty, val} {
+		sb.WriteString(stringSepa)
+		sb.WriteString(s)
+	}
+	for _, cksum := range cksums {
+		sb.WriteString(stringSepa)
+		sb.WriteString(cksum.Ty())
+		sb.WriteString(stringSepa)
+		sb.WriteString(cksum.Val())
+	}
+	md.xcks = sb.String()
+}
+
+// the object's checksum of a given type (primary or additional), if available
+func (lom *LOM) CksumOf(ty string) *cos.Cksum {
+	if cksum := lom.md.Cksum; !cksum.IsEmpty() && cksum.Ty() == ty {
+		return cksum
+	}
+	for _, cksum := range lom.md.xcksums() {
+		if cksum.Ty() == ty {
+			return cksum
+		}
+	}
+	return nil
+}
+
+// additional checksums (excluding the primary one)
+func (lom *LOM) Cksums() []*cos.Cksum { return lom.md.xcksums() }
+
+// replace additional checksums
+// (the caller must first set the object's size and primary checksum)
+func (lom *LOM) SetCksums(cksums ...*cos.Cksum) { lom.md.setXcksums(cksums) }
+
+// compute checksums of the given types in a single pass
+func (lom *LOM) ComputeCksums(types []string, locked bool) ([]*cos.Cksum, error) {
+	if !locked {
+		lom.Lock(false)
+		defer lom.Unlock(false)
+	}
+	lmfh, err := lom.Open()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		hashes    = make([]*cos.CksumHash, len(types))
+		writers   = make([]io.Writer, len(types))
+		buf, slab = g.pmm.AllocSize(lom.Lsize())
+	)
+	for i, ty := range types {
+		hashes[i] = cos.NewCksumHash(ty)
+		writers[i] = hashes[i].H
+	}
+	_, err = cos.CopyBuffer(cos.NewWriterMulti(writers...), lmfh, buf)
+	slab.Free(buf)
+	cos.Close(lmfh)
+	if err != nil {
+		return nil, err
+	}
+	cksums := make([]*cos.Cksum, len(types))
+	for i, h := range hashes {
+		h.Finalize()
+		cksums[i] = h.Clone()
+	}
+	return cksums, nil
+}
+
+// checksum types configured for the bucket but missing in the object's metadata
+func (lom *LOM) missingCksums(conf *cmn.CksumConf) (types []string) {
+	if conf.Type != cos.ChecksumNone && lom.CksumOf(conf.Type) == nil {
+		types = append(types, conf.Type)
+	}
+	for _, ty := range conf.ExtraTypes() {
+		if lom.CksumOf(ty) == nil {
+			types = append(types, ty)
+		}
+	}
+	return types
+}
+
+// (x-recompute-cksum) compute and store checksums that the object is missing
+// given the bucket's `checksum.type` and `checksum.extra`; the former becomes
+// the object's primary checksum (with the previous one retained as additional)
+// returns the number of bytes read (zero if there was nothing to do)
+func (lom *LOM) RecomputeCksums() (int64, error) {
+	var (
+		conf     = lom.CksumConf()
+		computed []*cos.Cksum
+		missing  []string
+		size     int64
+		prim     *cos.Cksum
+	)
+	lom.Lock(false)
+	err := lom.Load(false /*cache it*/, true /*locked*/)
+	if err == nil {
+		missing = lom.missingCksums(conf)
+		size, prim = lom.Lsize(), lom.md.Cksum
+		if len(missing) > 0 {
+			computed, err = lom.ComputeCksums(missing, true /*locked*/)
+		}
+	}
+	lom.Unlock(false)
+	if err != nil {
+		return 0, err
+	}
+	if len(missing) == 0 && (conf.Type == cos.ChecksumNone || prim.Type() == conf.Type) {
+		return 0, nil
+	}
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return 0, err
+	}
+	if lom.Lsize() != size || !lom.md.Cksum.Equal(prim) {
+		return 0, nil // updated in the meantime
+	}
+
+	all := lom.md.xcksums()
+	all = append(all, computed...)
+	if !prim.IsEmpty() {
+		all = append(all, prim)
+	}
+	primary := prim
+	if conf.Type != cos.ChecksumNone {
+		for _, cksum := range all {
+			if cksum.Ty() == conf.Type {
+				primary = cksum
+				break
+			}
+		}
+	}
+	extra := make([]*cos.Cksum, 0, len(all))
+outer:
+	for _, cksum := range all {
+		if cksum.Ty() == primary.Type() {
+			continue
+		}
+		for _, ck := range extra {
+			if ck.Ty() == cksum.Ty() {
+				continue outer
+			}
+		}
+		extra = append(extra, cksum)
+	}
+	lom.SetCksum(primary)
+	lom.SetCksums(extra...)
+
+	if err := lom.syncMetaWithCopies(); err != nil {
+		return 0, err
+	}
+	return size, lom.PersistMain()
+}
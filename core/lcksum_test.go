@@ -0,0 +1,112 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Multiple checksums", func() {
+	const (
+		tmpDir = "/tmp/lcksum_test"
+		mpath  = tmpDir + "/1"
+	)
+
+	var (
+		data = []byte("the quick brown fox jumps over the lazy dog")
+		bck  = cmn.Bck{Name: "LCKSUM_TEST", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		bmd  = mock.NewBaseBownerMock(
+			meta.NewBck(bck.Name, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum: cmn.CksumConf{Type: cos.ChecksumSHA256, Extra: "crc32c,md5"},
+				BID:   509,
+			}),
+		)
+	)
+
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	BeforeEach(func() {
+		_ = cos.CreateDir(mpath)
+		_, _ = fs.Add(mpath, "daeID")
+		_ = mock.NewTarget(bmd)
+		_ = fs.CreateBucket(&bck, false)
+	})
+
+	AfterEach(func() {
+		_, _ = fs.Remove(mpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	// written with an (outdated) xxhash checksum
+	put := func(objName string) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		Expect(os.WriteFile(lom.FQN, data, cos.PermRWR)).NotTo(HaveOccurred())
+		lom.SetSize(int64(len(data)))
+		lom.SetCksum(cos.NewCksum(cos.ChecksumOneXxh, cos.ChecksumB2S(data, cos.ChecksumOneXxh)))
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		Expect(lom.PersistMain()).NotTo(HaveOccurred())
+		return lom
+	}
+
+	load := func(objName string) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		lom.UncacheDel()
+		Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+		return lom
+	}
+
+	expected := func(ty string) *cos.Cksum { return cos.NewCksum(ty, cos.ChecksumB2S(data, ty)) }
+
+	It("should store additional checksums bound to size and primary checksum", func() {
+		lom := put("obj")
+		lom.SetCksums(expected(cos.ChecksumMD5))
+		Expect(lom.PersistMain()).NotTo(HaveOccurred())
+
+		lom = load("obj")
+		Expect(lom.CksumOf(cos.ChecksumOneXxh).Equal(expected(cos.ChecksumOneXxh))).To(BeTrue())
+		Expect(lom.CksumOf(cos.ChecksumMD5).Equal(expected(cos.ChecksumMD5))).To(BeTrue())
+		Expect(lom.CksumOf(cos.ChecksumSHA256)).To(BeNil())
+
+		// content changed: additional checksums no longer valid
+		lom.SetSize(lom.Lsize() + 1)
+		Expect(lom.CksumOf(cos.ChecksumMD5)).To(BeNil())
+		Expect(lom.Cksums()).To(BeEmpty())
+	})
+
+	It("should recompute missing checksums and upgrade the primary one", func() {
+		put("obj")
+		lom := load("obj")
+		size, err := lom.RecomputeCksums()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(size).To(BeEquivalentTo(len(data)))
+
+		lom = load("obj")
+		Expect(lom.Checksum().Equal(expected(cos.ChecksumSHA256))).To(BeTrue())
+		for _, ty := range []string{cos.ChecksumOneXxh, cos.ChecksumCRC32C, cos.ChecksumMD5} {
+			Expect(lom.CksumOf(ty).Equal(expected(ty))).To(BeTrue(), ty)
+		}
+		Expect(lom.Cksums()).To(HaveLen(3))
+
+		// nothing to do
+		size, err = lom.RecomputeCksums()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(size).To(BeZero())
+	})
+})
@@ -0,0 +1,440 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Compressed objects ===================================================
+//
+// With bucket property `compress.algo` set, objects written via PUT (and
+// everything that writes via PUT: cold GET, copy, rebalance) are stored as
+// a sequence of independently compressed frames, whereby:
+//   - each frame holds `compress.frame_size` bytes of the object's content
+//     (the last frame may be shorter);
+//   - frames are followed by the frame index (compressed sizes) and a fixed-size
+//     footer (below), so that a range read decompresses only the frames it needs;
+//   - lmeta carries lmflCompr and the physical (stored) size, while lom.Lsize()
+//     and the object's checksum remain those of the original (logical) content;
+//   - lom.Open() returns a decompressing reader (io.ReaderAt, io.Seeker).
+//
+// Not compressed: chunked, packed, and erasure-coded objects. Local copies
+// (n-way mirroring) are byte-for-byte; all other copies are made from the
+// decompressed content (see lom.copy2fqn).
+//
+// layout:  frame 0 | ... | frame N-1 | N x uint32 | footer
+// footer:  magic (4) | version (1) | algo (1) | reserved (2) | frame size (4) | N (4) | logical size (8)
+// ======================================================================
+
+const (
+	comprMagic   = 0x6169737a // "aisz"
+	comprVersion = 1
+	comprFooter  = 24
+
+	comprRaw = uint32(1 << 31) // frame stored as is (in the frame index)
+)
+
+const (
+	comprZstd = byte(iota + 1)
+	comprLZ4
+)
+
+var (
+	zenc     *zstd.Encoder
+	zdec     *zstd.Decoder
+	zstdOnce sync.Once
+)
+
+func comprAlgo(algo string) (byte, error) {
+	switch algo {
+	case apc.ObjComprZstd:
+		zstdOnce.Do(_initZstd)
+		return comprZstd, nil
+	case apc.ObjComprLZ4:
+		return comprLZ4, nil
+	default:
+		return 0, fmt.Errorf("unsupported compression %q", algo)
+	}
+}
+
+// (both EncodeAll and DecodeAll are safe for concurrent use)
+func _initZstd() {
+	var err error
+	zenc, err = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	debug.AssertNoErr(err)
+	zdec, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	debug.AssertNoErr(err)
+}
+
+//
+// LOM
+//
+
+func (lom *LOM) IsCompressed(special ...bool) bool {
+	debug.Assert(len(special) > 0 || lom.loaded())
+	return lom.md.lid.haslmfl(lmflCompr)
+}
+
+// physical (stored) size: same as lom.Lsize() unless compressed or deduplicated
+// (and excepting chunked and packed objects)
+func (lom *LOM) StoredSize() int64 {
+	switch {
+	case lom.IsCompressed(true):
+		return lom.md.psize
+	case lom.IsDeduped(true):
+		return 0 // stub (see lom.DedupShare)
+	}
+	return lom.md.Size
+}
+
+// whether a new object of a given size (-1 if unknown) is to be compressed
+// (deduplication takes precedence)
+func (lom *LOM) CanCompress(size int64) bool {
+	bprops := lom.Bprops()
+	if !bprops.Compress.Enabled() || bprops.EC.Enabled || lom.CanDedup(size) {
+		return false
+	}
+	return size < 0 || !bprops.Pack.Packed(size)
+}
+
+func (md *lmeta) setCompr(psize int64) {
+	md.lid = md.lid.setlmfl(lmflCompr)
+	md.psize = psize
+}
+
+func (md *lmeta) clrCompr() {
+	md.lid = md.lid.clrlmfl(lmflCompr)
+	md.psize = 0
+}
+
+/////////////////
+// ComprWriter //
+/////////////////
+
+// compresses object's content into the provided workfile (see lom.CreateCompressed);
+// Close() writes the frame index and the footer and marks the object compressed
+type ComprWriter struct {
+	lom   *LOM
+	fh    *os.File
+	frame []byte // current frame (uncompressed)
+	zbuf  []byte // compressed
+	sizes []uint32
+	size  int64 // logical
+	psize int64 // physical
+	fsize int64 // frame size
+	algo  byte
+	fsync bool
+}
+
+func (lom *LOM) CreateCompressed(wfqn string) (*ComprWriter, error) {
+	conf := &lom.Bprops().Compress
+	algo, err := comprAlgo(conf.Algo)
+	if err != nil {
+		return nil, err
+	}
+	fh, err := lom._cf(wfqn)
+	if err != nil {
+		return nil, err
+	}
+	lom.SetChunked(false)
+	lom.md.clrCompr()
+	lom.md.clrDedup()
+	fsize := conf.FrameSizeX()
+	return &ComprWriter{
+		lom:   lom,
+		fh:    fh,
+		frame: make([]byte, 0, fsize),
+		fsize: fsize,
+		algo:  algo,
+	}, nil
+}
+
+func (cw *ComprWriter) Write(p []byte) (written int, err error) {
+	for len(p) > 0 {
+		l := min(len(p), int(cw.fsize)-len(cw.frame))
+		cw.frame = append(cw.frame, p[:l]...)
+		written += l
+		p = p[l:]
+		if int64(len(cw.frame)) == cw.fsize {
+			if err = cw.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+func (cw *ComprWriter) flush() (err error) {
+	var (
+		src = cw.frame
+		zb  []byte
+	)
+	switch cw.algo {
+	case comprZstd:
+		zb = zenc.EncodeAll(src, cw.zbuf[:0])
+	case comprLZ4:
+		if n := lz4.CompressBlockBound(len(src)); cap(cw.zbuf) < n {
+			cw.zbuf = make([]byte, n)
+		}
+		var n int
+		if n, err = lz4.CompressBlock(src, cw.zbuf[:cap(cw.zbuf)], nil); err != nil {
+			return err
+		}
+		zb = cw.zbuf[:n] // (n == 0: incompressible)
+	}
+	cw.zbuf = zb[:0]
+	zsize := uint32(len(zb))
+	if len(zb) == 0 || len(zb) >= len(src) {
+		zb, zsize = src, uint32(len(src))|comprRaw
+	}
+	if _, err = cw.fh.Write(zb); err != nil {
+		return err
+	}
+	cw.sizes = append(cw.sizes, zsize)
+	cw.size += int64(len(src))
+	cw.psize += int64(len(zb))
+	cw.frame = cw.frame[:0]
+	return nil
+}
+
+// (deferred until Close - when the frame index and footer are written)
+func (cw *ComprWriter) Sync() error {
+	cw.fsync = true
+	return nil
+}
+
+func (cw *ComprWriter) Close() (err error) {
+	if cw.fh == nil {
+		return nil
+	}
+	defer func() {
+		if errC := cw.fh.Close(); err == nil {
+			err = errC
+		}
+		cw.fh = nil
+	}()
+	if len(cw.frame) > 0 {
+		if err = cw.flush(); err != nil {
+			return err
+		}
+	}
+	trailer := make([]byte, len(cw.sizes)*cos.SizeofI32+comprFooter)
+	for i, zsize := range cw.sizes {
+		binary.BigEndian.PutUint32(trailer[i*cos.SizeofI32:], zsize)
+	}
+	footer := trailer[len(cw.sizes)*cos.SizeofI32:]
+	binary.BigEndian.PutUint32(footer, comprMagic)
+	footer[4], footer[5] = comprVersion, cw.algo
+	binary.BigEndian.PutUint32(footer[8:], uint32(cw.fsize))
+	binary.BigEndian.PutUint32(footer[12:], uint32(len(cw.sizes)))
+	binary.BigEndian.PutUint64(footer[16:], uint64(cw.size))
+	if _, err = cw.fh.Write(trailer); err != nil {
+		return err
+	}
+	if cw.fsync || cw.lom.IsFeatureSet(feat.FsyncPUT) {
+		if err = cw.fh.Sync(); err != nil {
+			return err
+		}
+	}
+	cw.lom.md.setCompr(cw.psize + int64(len(trailer)))
+	return nil
+}
+
+// close without writing the trailer (not removing the workfile)
+func (cw *ComprWriter) Abort() {
+	if cw.fh != nil {
+		cw.fh.Close()
+		cw.fh = nil
+	}
+}
+
+/////////////////
+// comprReader //
+/////////////////
+
+// reads compressed object as if it was a regular (uncompressed) file
+type comprReader struct {
+	fh    *os.File
+	cname string
+	offs  []int64  // frame offsets in the file
+	sizes []uint32 // compressed frame sizes (with comprRaw bit)
+	frame []byte   // decompressed frame #cur
+	zbuf  []byte
+	mu    sync.Mutex
+	size  int64 // logical
+	fsize int64 // frame size
+	off   int64 // Read/Seek
+	cur   int
+	algo  byte
+}
+
+// interface guard
+var _ cos.LomReader = (*comprReader)(nil)
+
+func (lom *LOM) newComprReader() (*comprReader, error) {
+	fh, err := os.Open(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	r, err := _newComprReader(fh, lom.md.psize, lom.Cname())
+	if err == nil && r.size != lom.md.Size {
+		err = fmt.Errorf("%s: compressed size %d vs %d", lom.Cname(), r.size, lom.md.Size)
+	}
+	if err != nil {
+		cos.Close(fh)
+		return nil, err
+	}
+	return r, nil
+}
+
+func _newComprReader(fh *os.File, psize int64, cname string) (*comprReader, error) {
+	if psize < comprFooter {
+		return nil, fmt.Errorf("%s: invalid compressed size %d", cname, psize)
+	}
+	var footer [comprFooter]byte
+	if _, err := fh.ReadAt(footer[:], psize-comprFooter); err != nil {
+		return nil, fmt.Errorf("%s: failed to read compression footer: %w", cname, err)
+	}
+	if binary.BigEndian.Uint32(footer[:]) != comprMagic || footer[4] != comprVersion {
+		return nil, fmt.Errorf("%s: invalid compression footer", cname)
+	}
+	r := &comprReader{
+		fh:    fh,
+		cname: cname,
+		algo:  footer[5],
+		fsize: int64(binary.BigEndian.Uint32(footer[8:])),
+		size:  int64(binary.BigEndian.Uint64(footer[16:])),
+		cur:   -1,
+	}
+	if r.algo == comprZstd {
+		zstdOnce.Do(_initZstd)
+	} else if r.algo != comprLZ4 {
+		return nil, fmt.Errorf("%s: unknown compression %d", cname, r.algo)
+	}
+	var (
+		n    = int64(binary.BigEndian.Uint32(footer[12:]))
+		ioff = psize - comprFooter - n*int64(cos.SizeofI32)
+	)
+	if r.fsize <= 0 || ioff < 0 || n != (r.size+r.fsize-1)/r.fsize {
+		return nil, fmt.Errorf("%s: invalid compression footer (%d, %d, %d)", cname, r.fsize, n, r.size)
+	}
+	idx := make([]byte, n*int64(cos.SizeofI32))
+	if _, err := fh.ReadAt(idx, ioff); err != nil {
+		return nil, fmt.Errorf("%s: failed to read frame index: %w", cname, err)
+	}
+	r.sizes = make([]uint32, n)
+	r.offs = make([]int64, n+1)
+	for i := range r.sizes {
+		r.sizes[i] = binary.BigEndian.Uint32(idx[i*cos.SizeofI32:])
+		r.offs[i+1] = r.offs[i] + int64(r.sizes[i]&^comprRaw)
+	}
+	if r.offs[n] != ioff {
+		return nil, fmt.Errorf("%s: corrupted frame index (%d vs %d)", cname, r.offs[n], ioff)
+	}
+	return r, nil
+}
+
+func (r *comprReader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadAt(p, r.off)
+	r.off += int64(n)
+	return n, err
+}
+
+func (r *comprReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if off < 0 {
+		return 0, errors.New("negative offset")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for n < len(p) {
+		if off >= r.size {
+			return n, io.EOF
+		}
+		i := int(off / r.fsize)
+		if err = r.load(i); err != nil {
+			return n, err
+		}
+		m := copy(p[n:], r.frame[off-int64(i)*r.fsize:])
+		n += m
+		off += int64(m)
+	}
+	return n, nil
+}
+
+// read and decompress frame #i (caller must hold the lock)
+func (r *comprReader) load(i int) (err error) {
+	if i == r.cur {
+		return nil
+	}
+	var (
+		zsize = int(r.sizes[i] &^ comprRaw)
+		size  = int(min(r.fsize, r.size-int64(i)*r.fsize))
+	)
+	if cap(r.zbuf) < zsize {
+		r.zbuf = make([]byte, zsize)
+	}
+	if cap(r.frame) < int(r.fsize) {
+		r.frame = make([]byte, r.fsize)
+	}
+	r.cur = -1
+	zb := r.zbuf[:zsize]
+	if _, err = r.fh.ReadAt(zb, r.offs[i]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return fmt.Errorf("%s: failed to read frame %d: %w", r.cname, i, err)
+	}
+	switch {
+	case r.sizes[i]&comprRaw != 0:
+		r.frame = append(r.frame[:0], zb...)
+	case r.algo == comprZstd:
+		r.frame, err = zdec.DecodeAll(zb, r.frame[:0])
+	default:
+		var n int
+		n, err = lz4.UncompressBlock(zb, r.frame[:cap(r.frame)])
+		r.frame = r.frame[:n]
+	}
+	if err == nil && len(r.frame) != size {
+		err = fmt.Errorf("size %d vs %d", len(r.frame), size)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: failed to decompress frame %d: %w", r.cname, i, err)
+	}
+	r.cur = i
+	return nil
+}
+
+func (r *comprReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.off = offset
+	return offset, nil
+}
+
+func (r *comprReader) Close() error { return r.fh.Close() }
@@ -0,0 +1,212 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Compressed objects", func() {
+	const (
+		tmpDir    = "/tmp/lcompr_test"
+		numMpaths = 2
+		frameSize = 64 * cos.KiB
+		objSize   = 5*frameSize + 123
+	)
+
+	var (
+		mpaths []string
+		bcks   = map[string]cmn.Bck{
+			apc.ObjComprZstd: {Name: "LCOMPR_ZSTD", Provider: apc.AIS, Ns: cmn.NsGlobal},
+			apc.ObjComprLZ4:  {Name: "LCOMPR_LZ4", Provider: apc.AIS, Ns: cmn.NsGlobal},
+		}
+		bmd = mock.NewBaseBownerMock(
+			meta.NewBck(bcks[apc.ObjComprZstd].Name, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum:    cmn.CksumConf{Type: cos.ChecksumOneXxh},
+				Compress: cmn.CompressConf{Algo: apc.ObjComprZstd, FrameSize: frameSize},
+				BID:      303,
+			}),
+			meta.NewBck(bcks[apc.ObjComprLZ4].Name, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum:    cmn.CksumConf{Type: cos.ChecksumOneXxh},
+				Compress: cmn.CompressConf{Algo: apc.ObjComprLZ4, FrameSize: frameSize},
+				BID:      304,
+			}),
+		)
+	)
+	for i := range numMpaths {
+		mpaths = append(mpaths, fmt.Sprintf("%s/mpath%d", tmpDir, i))
+	}
+
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	BeforeEach(func() {
+		for _, mpath := range mpaths {
+			_ = cos.CreateDir(mpath)
+			_, _ = fs.Add(mpath, "daeID")
+		}
+		_ = mock.NewTarget(bmd)
+		for _, bck := range bcks {
+			_ = fs.CreateBucket(&bck, false)
+		}
+	})
+
+	AfterEach(func() {
+		// (other tests' mountpaths may still be there)
+		for _, mi := range fs.GetAvail() {
+			for _, bck := range bcks {
+				_ = os.RemoveAll(mi.MakePathBck(&bck))
+			}
+		}
+		for _, mpath := range mpaths {
+			_, _ = fs.Remove(mpath)
+		}
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	// compressible text followed by incompressible (random) frame
+	genData := func() []byte {
+		var (
+			sb   strings.Builder
+			rnd  = make([]byte, frameSize)
+			line = 0
+		)
+		for sb.Len() < objSize-frameSize {
+			fmt.Fprintf(&sb, "{\"id\": %d, \"name\": \"object-%d\", \"tags\": [\"a\", \"b\"]}\n", line, line%17)
+			line++
+		}
+		_, _ = cryptorand.Read(rnd)
+		data := append([]byte(sb.String())[:objSize-frameSize], rnd...)
+		Expect(data).To(HaveLen(objSize))
+		return data
+	}
+
+	// write compressed object and return its content
+	putCompressed := func(algo, objName string) (*core.LOM, []byte) {
+		data := genData()
+		bck := bcks[algo]
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		Expect(lom.CanCompress(objSize)).To(BeTrue())
+		lom.Lock(true)
+		defer lom.Unlock(true)
+
+		wfqn := fs.CSM.Gen(lom, fs.WorkfileType, "test")
+		cw, err := lom.CreateCompressed(wfqn)
+		Expect(err).NotTo(HaveOccurred())
+		cksum := cos.NewCksumHash(cos.ChecksumOneXxh)
+		_, err = io.Copy(io.MultiWriter(cw, cksum.H), bytes.NewReader(data))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cw.Close()).NotTo(HaveOccurred())
+		cksum.Finalize()
+
+		lom.SetSize(objSize)
+		lom.SetCksum(cksum.Clone())
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		Expect(lom.RenameFinalize(wfqn)).NotTo(HaveOccurred())
+		Expect(lom.PersistMain()).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+		return lom, data
+	}
+
+	for _, algo := range []string{apc.ObjComprZstd, apc.ObjComprLZ4} {
+		It("should write, load, and read "+algo+" compressed object", func() {
+			lom, data := putCompressed(algo, "dir/compressed-obj")
+
+			newLom := &core.LOM{ObjName: lom.ObjName}
+			bck := bcks[algo]
+			Expect(newLom.InitBck(&bck)).NotTo(HaveOccurred())
+			newLom.Lock(false)
+			defer newLom.Unlock(false)
+			Expect(newLom.Load(false, true)).NotTo(HaveOccurred())
+			Expect(newLom.IsCompressed()).To(BeTrue())
+			Expect(newLom.Lsize()).To(BeEquivalentTo(objSize))
+
+			finfo, err := os.Stat(newLom.FQN)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(newLom.StoredSize()).To(Equal(finfo.Size()))
+			Expect(newLom.StoredSize()).To(BeNumerically("<", objSize/2))
+
+			// sequential read
+			fh, err := newLom.Open()
+			Expect(err).NotTo(HaveOccurred())
+			b, err := io.ReadAll(fh)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bytes.Equal(b, data)).To(BeTrue())
+
+			// range reads: within a frame, across frames, and the (incompressible) tail
+			for _, rng := range [][2]int64{{100, 200}, {frameSize - 10, 2*frameSize + 20}, {objSize - frameSize - 5, objSize}} {
+				p := make([]byte, rng[1]-rng[0])
+				n, err := fh.ReadAt(p, rng[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(n).To(Equal(len(p)))
+				Expect(bytes.Equal(p, data[rng[0]:rng[1]])).To(BeTrue())
+			}
+			_, err = fh.ReadAt(make([]byte, 10), objSize-5)
+			Expect(err).To(Equal(io.EOF))
+			Expect(fh.Close()).NotTo(HaveOccurred())
+
+			// checksum is computed over the original content
+			Expect(newLom.ValidateContentChecksum(true)).NotTo(HaveOccurred())
+		})
+	}
+
+	It("should copy compressed object as uncompressed", func() {
+		lom, data := putCompressed(apc.ObjComprZstd, "compressed-obj-cp")
+		dstBck := bcks[apc.ObjComprLZ4]
+		dstFQN := lom.Mountpath().MakePathFQN(&dstBck, fs.ObjectType, lom.ObjName)
+
+		lom.Lock(true)
+		Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+		dst, err := lom.Copy2FQN(dstFQN, nil)
+		lom.Unlock(true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dst.IsCompressed()).To(BeFalse())
+		core.FreeLOM(dst)
+
+		b, err := os.ReadFile(dstFQN)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data)).To(BeTrue())
+	})
+
+	It("should detect corrupted compressed object", func() {
+		lom, _ := putCompressed(apc.ObjComprLZ4, "compressed-obj-corrupted")
+		f, err := os.OpenFile(lom.FQN, os.O_WRONLY, 0)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = f.WriteAt([]byte("garbage"), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Close()).NotTo(HaveOccurred())
+
+		lom.Lock(false)
+		Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+		Expect(lom.ValidateContentChecksum(true)).To(HaveOccurred())
+		lom.Unlock(false)
+
+		// truncated: no footer
+		Expect(os.Truncate(lom.FQN, 100)).NotTo(HaveOccurred())
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		Expect(lom.Load(false, true)).To(HaveOccurred())
+		_, err = lom.Open()
+		Expect(err).To(HaveOccurred())
+	})
+})
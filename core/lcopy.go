@@ -74,12 +74,17 @@ func (lom *LOM) AddCopy(copyFQN string, mpi *fs.Mountpath) error {
 }
 
 func (lom *LOM) DelCopies(copiesFQN ...string) (err error) {
-	numCopies := lom.NumCopies()
+	var (
+		numCopies = lom.NumCopies()
+		mis       = make([]*fs.Mountpath, len(copiesFQN))
+	)
 	// 1. Delete all copies from the metadata
-	for _, copyFQN := range copiesFQN {
-		if _, ok := lom.md.copies[copyFQN]; !ok {
+	for i, copyFQN := range copiesFQN {
+		mi, ok := lom.md.copies[copyFQN]
+		if !ok {
 			return fmt.Errorf("lom %s(num: %d): copy %s does not exist", lom, numCopies, copyFQN)
 		}
+		mis[i] = mi
 		lom.delCopyMd(copyFQN)
 	}
 
@@ -90,11 +95,12 @@ func (lom *LOM) DelCopies(copiesFQN ...string) (err error) {
 	}
 
 	// 3. Remove the copies
-	for _, copyFQN := range copiesFQN {
+	for i, copyFQN := range copiesFQN {
 		if err1 := cos.RemoveFile(copyFQN); err1 != nil {
 			nlog.Errorln(err1) // TODO: LRU should take care of that later.
 			continue
 		}
+		delMdx(mis[i], lom.Uname())
 	}
 	return
 }
@@ -126,6 +132,7 @@ func (lom *LOM) DelExtraCopies(fqn ...string) (removed bool, err error) {
 			err = err1
 			continue
 		}
+		delMdx(mi, lom.Uname())
 		if len(fqn) > 0 && fqn[0] == copyFQN {
 			removed = true
 		}
@@ -293,7 +300,24 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 	}
 
 	workFQN := fs.CSM.Gen(dst, fs.WorkfileType, fs.WorkfileCopy)
-	_, dstCksum, err = cos.CopyFile(lom.FQN, workFQN, buf, cksumType)
+	dst.md.lid = dst.md.lid.clrlmfl(lmflPack) // (copies are never packed)
+	dst.md.clrDedup()
+	if !dst.isMirror(lom) && cksumType == dst.CksumType() && dst.CanDedup(lom.Lsize()) {
+		return lom.copy2dedup(dst, workFQN, buf)
+	}
+	switch {
+	case lom.IsChunked() && dst.isMirror(lom):
+		// (same object, same chunks)
+		_, dstCksum, err = copyFile(lom.FQN, lom.mi, workFQN, dst.mi, buf, cksumType)
+	case lom.IsCompressed() && dst.isMirror(lom):
+		// (same compressed content; object checksum is the one of the decompressed)
+		_, _, err = cos.CopyFile(lom.FQN, workFQN, buf, cos.ChecksumNone)
+		cksumType = cos.ChecksumNone
+	default:
+		_, dstCksum, err = lom.CopyWhole(workFQN, buf, cksumType)
+		dst.SetChunked(false)
+		dst.md.clrCompr()
+	}
 	if err != nil {
 		return err
 	}
@@ -337,6 +361,8 @@ func (lom *LOM) copy2fqn(dst *LOM, buf []byte) (err error) {
 		if errRemove := cos.RemoveFile(dst.FQN); errRemove != nil {
 			nlog.Errorln("nested err:", errRemove)
 		}
+	} else {
+		dst.delOtherTier()
 	}
 	return err
 }
@@ -408,7 +434,7 @@ func (lom *LOM) haveMpath(mpath string) bool {
 func (lom *LOM) ToMpath() (mi *fs.Mountpath, fixHrw bool) {
 	var (
 		avail         = fs.GetAvail()
-		hrwMi, _, err = fs.Hrw(cos.UnsafeB(*lom.md.uname))
+		hrwMi, _, err = fs.HrwTier(cos.UnsafeB(*lom.md.uname), lom.mi.Tier()) // (see ltier.go)
 	)
 	if err != nil {
 		nlog.Errorln(err)
@@ -0,0 +1,283 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"errors"
+	"os"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Deduplicated objects =================================================
+//
+// With bucket property `dedup.enabled` set (ais:// buckets only), objects
+// written via PUT (and copy, rebalance) are stored in the mountpath's
+// content-addressed store (see fs/dedup.go), whereby:
+//   - the content key is the object's cryptographic (sha256 or sha512) checksum,
+//     computed by the target (never trusted from the client); on a match, the
+//     content is compared byte-wise before sharing (see fs.DedupPut);
+//   - objects with identical content on the same mountpath share a single
+//     refcounted copy;
+//   - the object itself is a zero-size "stub" file at lom.FQN that carries
+//     the object's own metadata (lmeta) including lmflDedup and the key;
+//   - the shared content is never modified in place: overwriting (including
+//     APPEND) writes new content and drops the reference to the old one
+//     (copy-on-write); removing the object drops the reference as well.
+//
+// Not deduplicated: chunked, packed, and erasure-coded objects; objects in
+// buckets with a non-cryptographic checksum or local mirroring (see
+// cmn.MirrorConf.ValidateLocal). Deduplicated objects are not compressed.
+// ======================================================================
+
+func (lom *LOM) IsDeduped(special ...bool) bool {
+	debug.Assert(len(special) > 0 || lom.loaded())
+	return lom.md.lid.haslmfl(lmflDedup)
+}
+
+// whether a new object of a given size (-1 if unknown) is to be deduplicated
+func (lom *LOM) CanDedup(size int64) bool {
+	bprops := lom.Bprops()
+	if !bprops.Dedup.Enabled || bprops.EC.Enabled || bprops.Mirror.Local() || bprops.Dedup.ValidateCksum(bprops.Cksum.Type) != nil {
+		return false
+	}
+	return lom.Bck().IsAIS() && size != 0
+}
+
+func (md *lmeta) setDedup(key string) {
+	md.lid = md.lid.setlmfl(lmflDedup)
+	md.dkey = key
+}
+
+func (md *lmeta) clrDedup() {
+	md.lid = md.lid.clrlmfl(lmflDedup)
+	md.dkey = ""
+}
+
+func (lom *LOM) dedupFQN() string { return lom.mi.DedupFQN(lom.md.dkey) }
+
+// the file that holds the object's content (compare with lom.FQN)
+func (lom *LOM) ContentFQN() string {
+	if lom.IsDeduped(true) {
+		return lom.dedupFQN()
+	}
+	return lom.FQN
+}
+
+// workfile => content store + stub (compare with RenameFinalize + PersistMain)
+// (caller must set size, checksum, and atime)
+func (lom *LOM) DedupFinalize(wfqn string) error {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname(), " is not wlocked")
+	lom.md.clrDedup()
+	cksum := lom.md.Cksum
+	if cksum.IsEmpty() || cksum.Ty() != lom.CksumType() {
+		// not sharing - storing as a regular object
+		nlog.Warningln(lom.Cname(), "cannot deduplicate without", lom.CksumType(), "checksum [", cksum, "]")
+		return lom.finalizeRegular(wfqn)
+	}
+	key := fs.DedupKey(cksum.Get())
+	if _, err := lom.mi.DedupPut(key, wfqn, lom.md.Size); err != nil {
+		if !errors.Is(err, fs.ErrDedupConflict) {
+			T.FSHC(err, lom.Mountpath(), "")
+			return cmn.NewErrFailedTo(T, "dedup", lom.Cname(), err)
+		}
+		// ditto
+		nlog.Errorln(lom.Cname(), err)
+		return lom.finalizeRegular(wfqn)
+	}
+
+	// stub (reusing the workfile name)
+	fh, err := cos.CreateFile(wfqn)
+	if err == nil {
+		err = fh.Close()
+	}
+	if err == nil {
+		lom.md.setDedup(key)
+		err = lom.RenameFinalize(wfqn)
+	}
+	if err == nil {
+		err = lom.PersistMain()
+	}
+	if err != nil {
+		lom.md.clrDedup()
+		if nerr := lom.mi.DedupDecRef(key); nerr != nil {
+			nlog.Errorln("nested err:", nerr)
+		}
+		if nerr := cos.RemoveFile(wfqn); nerr != nil && !cos.IsNotExist(nerr) {
+			nlog.Errorln("nested err:", nerr)
+		}
+	}
+	return err
+}
+
+func (lom *LOM) finalizeRegular(wfqn string) error {
+	if err := lom.RenameFinalize(wfqn); err != nil {
+		return err
+	}
+	return lom.PersistMain()
+}
+
+// the content key of the object that is about to be overwritten or removed (or "")
+// is read directly from disk, without touching in-memory metadata (compare with PrevUfest)
+func (lom *LOM) prevDedup() string {
+	if !lom.mi.DedupInUse() {
+		return ""
+	}
+	md, err := lom.lmfs(false)
+	if err != nil || md == nil || !md.lid.haslmfl(lmflDedup) {
+		return ""
+	}
+	return md.dkey
+}
+
+func (lom *LOM) decref(key string) {
+	if key == "" {
+		return
+	}
+	if err := lom.mi.DedupDecRef(key); err != nil {
+		nlog.Warningln(lom.Cname(), "failed to release deduplicated content: [", err, "]")
+	}
+}
+
+// add reference to the same content for another object on the same mountpath
+// (caller must persist dst metadata)
+func (lom *LOM) dedupLink(dst *LOM, wfqn string) error {
+	debug.Assert(lom.IsDeduped() && lom.mi == dst.mi)
+	key := lom.md.dkey
+	if err := lom.mi.DedupIncRef(key, lom.md.Size); err != nil {
+		return err
+	}
+	fh, err := cos.CreateFile(wfqn)
+	if err == nil {
+		err = fh.Close()
+	}
+	if err == nil {
+		dst.md.setDedup(key)
+		err = dst.RenameFinalize(wfqn)
+	}
+	if err != nil {
+		dst.md.clrDedup()
+		lom.decref(key)
+		if nerr := cos.RemoveFile(wfqn); nerr != nil && !cos.IsNotExist(nerr) {
+			nlog.Errorln("nested err:", nerr)
+		}
+	}
+	return err
+}
+
+// copy => deduplicated destination (see lom.copy2fqn)
+func (lom *LOM) copy2dedup(dst *LOM, wfqn string, buf []byte) error {
+	dst.SetChunked(false)
+	dst.md.clrCompr()
+	dst.md.copies = nil
+	if lom.IsDeduped() && lom.mi == dst.mi {
+		// same mountpath: one more reference
+		if err := lom.dedupLink(dst, wfqn); err != nil {
+			return err
+		}
+		err := dst.PersistMain()
+		if err != nil {
+			dst.decref(dst.md.dkey)
+			if nerr := cos.RemoveFile(dst.FQN); nerr != nil && !cos.IsNotExist(nerr) {
+				nlog.Errorln("nested err:", nerr)
+			}
+		}
+		return err
+	}
+	_, cksum, err := lom.CopyWhole(wfqn, buf, dst.CksumType())
+	if err != nil {
+		return err
+	}
+	if !cksum.Equal(lom.Checksum()) {
+		if nerr := cos.RemoveFile(wfqn); nerr != nil {
+			nlog.Errorln("nested err:", nerr)
+		}
+		return cos.NewErrDataCksum(&cksum.Cksum, lom.Checksum())
+	}
+	dst.SetCksum(cksum.Clone())
+	return dst.DedupFinalize(wfqn)
+}
+
+// move deduplicated object to another mountpath (resilver):
+// reference (or store) the content there, create the stub, and release the source
+// (caller must wlock)
+func (lom *LOM) MoveDeduped(mi *fs.Mountpath) error {
+	debug.Assert(lom.IsDeduped())
+	var (
+		key  = lom.md.dkey
+		size = lom.md.Size
+		bck  = lom.Bucket()
+		wfqn = mi.MakePathFQN(bck, fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
+		dst  = mi.MakePathFQN(bck, fs.ObjectType, lom.ObjName)
+	)
+	err := mi.DedupIncRef(key, size)
+	if cos.IsNotExist(err) {
+		if _, _, err = cos.CopyFile(lom.dedupFQN(), wfqn, nil, cos.ChecksumNone); err == nil {
+			if _, err = mi.DedupPut(key, wfqn, size); err != nil {
+				if nerr := cos.RemoveFile(wfqn); nerr != nil && !cos.IsNotExist(nerr) {
+					nlog.Errorln("nested err:", nerr)
+				}
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// stub
+	var fh *os.File
+	if fh, err = cos.CreateFile(wfqn); err != nil {
+		goto rerr
+	}
+	if err = fh.Close(); err != nil {
+		goto rerr
+	}
+	err = lom.moveMD(wfqn) // (see ltier.go)
+	if err == nil {
+		err = cos.Rename(wfqn, dst)
+	}
+	if err != nil {
+		goto rerr
+	}
+
+	// release source
+	if err := cos.RemoveFile(lom.FQN); err != nil {
+		nlog.Warningln(lom.Cname(), "failed to remove source stub: [", err, "]")
+	}
+	lom.decref(key)
+	lom.UncacheDel()
+	return nil
+
+rerr:
+	if nerr := mi.DedupDecRef(key); nerr != nil {
+		nlog.Errorln("nested err:", nerr)
+	}
+	if nerr := cos.RemoveFile(wfqn); nerr != nil && !cos.IsNotExist(nerr) {
+		nlog.Errorln("nested err:", nerr)
+	}
+	return err
+}
+
+// this object's share of the deduplicated content, given the current number of references
+// (used to report the space saved by deduplication - see bucket summary)
+func (lom *LOM) DedupShare() (share int64, refs uint64) {
+	debug.Assert(lom.IsDeduped(true))
+	refs, err := lom.mi.DedupRefs(lom.md.dkey)
+	if err != nil || refs == 0 {
+		return lom.md.Size, 1
+	}
+	return lom.md.Size / int64(refs), refs
+}
+
+// the content key of a deduplicated object (see space cleanup)
+func (lom *LOM) DedupKey() string {
+	debug.Assert(lom.IsDeduped(true))
+	return lom.md.dkey
+}
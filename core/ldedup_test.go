@@ -0,0 +1,226 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deduplicated objects", func() {
+	const (
+		tmpDir  = "/tmp/ldedup_test"
+		objSize = 100*cos.KiB + 7
+	)
+
+	var (
+		mpath = tmpDir + "/mpath"
+		bck   = cmn.Bck{Name: "LDEDUP_TEST", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		bck2  = cmn.Bck{Name: "LDEDUP_TEST2", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		bmd   = mock.NewBaseBownerMock(
+			meta.NewBck(bck.Name, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum: cmn.CksumConf{Type: cos.ChecksumSHA256},
+				Dedup: cmn.DedupConf{Enabled: true},
+				BID:   305,
+			}),
+			meta.NewBck(bck2.Name, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum: cmn.CksumConf{Type: cos.ChecksumSHA256},
+				Dedup: cmn.DedupConf{Enabled: true},
+				BID:   306,
+			}),
+		)
+	)
+
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	BeforeEach(func() {
+		_ = cos.CreateDir(mpath)
+		_, _ = fs.Add(mpath, "daeID")
+		_ = mock.NewTarget(bmd)
+		_ = fs.CreateBucket(&bck, false)
+		_ = fs.CreateBucket(&bck2, false)
+	})
+
+	AfterEach(func() {
+		_, _ = fs.Remove(mpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	newData := func() []byte {
+		data := make([]byte, objSize)
+		_, _ = cryptorand.Read(data)
+		return data
+	}
+
+	put := func(b cmn.Bck, objName string, data []byte) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&b)).NotTo(HaveOccurred())
+		Expect(lom.CanDedup(int64(len(data)))).To(BeTrue())
+		lom.Lock(true)
+		defer lom.Unlock(true)
+
+		wfqn := fs.CSM.Gen(lom, fs.WorkfileType, "test")
+		wfh, err := cos.CreateFile(wfqn)
+		Expect(err).NotTo(HaveOccurred())
+		cksum := cos.NewCksumHash(cos.ChecksumSHA256)
+		_, err = io.Copy(io.MultiWriter(wfh, cksum.H), bytes.NewReader(data))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wfh.Close()).NotTo(HaveOccurred())
+		cksum.Finalize()
+
+		lom.SetSize(int64(len(data)))
+		lom.SetCksum(cksum.Clone())
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		Expect(lom.DedupFinalize(wfqn)).NotTo(HaveOccurred())
+		Expect(cos.Stat(wfqn)).To(HaveOccurred())
+		lom.UncacheUnless()
+		return lom
+	}
+
+	load := func(b cmn.Bck, objName string) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&b)).NotTo(HaveOccurred())
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+		return lom
+	}
+
+	read := func(lom *core.LOM) []byte {
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		fh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(fh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+		return b
+	}
+
+	// (other tests' mountpaths may still be there)
+	// name of an object that maps to a given mountpath
+	colocated := func(b cmn.Bck, mi *fs.Mountpath, prefix string) string {
+		for i := 0; ; i++ {
+			name := fmt.Sprintf("%s-%d", prefix, i)
+			lom := &core.LOM{ObjName: name}
+			Expect(lom.InitBck(&b)).NotTo(HaveOccurred())
+			if lom.Mountpath() == mi {
+				return name
+			}
+		}
+	}
+
+	refs := func(lom *core.LOM) uint64 {
+		n, err := lom.Mountpath().DedupRefs(lom.DedupKey())
+		Expect(err).NotTo(HaveOccurred())
+		return n
+	}
+
+	It("should share identical content", func() {
+		data := newData()
+		mi := put(bck, "obj-a", data).Mountpath()
+		nameB := colocated(bck, mi, "dir/obj-b")
+		put(bck, nameB, data)
+
+		a, b := load(bck, "obj-a"), load(bck, nameB)
+		Expect(a.IsDeduped()).To(BeTrue())
+		Expect(b.IsDeduped()).To(BeTrue())
+		Expect(a.DedupKey()).To(Equal(b.DedupKey()))
+		Expect(a.Lsize()).To(BeEquivalentTo(objSize))
+		Expect(a.StoredSize()).To(BeZero())
+		Expect(refs(a)).To(BeEquivalentTo(2))
+
+		share, n := a.DedupShare()
+		Expect(n).To(BeEquivalentTo(2))
+		Expect(share).To(BeEquivalentTo(objSize / 2))
+
+		Expect(bytes.Equal(read(a), data)).To(BeTrue())
+		Expect(bytes.Equal(read(b), data)).To(BeTrue())
+
+		a.Lock(false)
+		Expect(a.ValidateContentChecksum(true)).NotTo(HaveOccurred())
+		a.Unlock(false)
+	})
+
+	It("should copy-on-write upon overwrite", func() {
+		data, data2 := newData(), newData()
+		mi := put(bck, "obj-a", data).Mountpath()
+		nameB := colocated(bck, mi, "obj-b")
+		put(bck, nameB, data)
+
+		// overwrite one of the two
+		put(bck, "obj-a", data2)
+		a, b := load(bck, "obj-a"), load(bck, nameB)
+		Expect(a.DedupKey()).NotTo(Equal(b.DedupKey()))
+		Expect(refs(a)).To(BeEquivalentTo(1))
+		Expect(refs(b)).To(BeEquivalentTo(1))
+		Expect(bytes.Equal(read(a), data2)).To(BeTrue())
+		Expect(bytes.Equal(read(b), data)).To(BeTrue())
+
+		// overwrite with the same content
+		put(bck, nameB, data)
+		b = load(bck, nameB)
+		Expect(refs(b)).To(BeEquivalentTo(1))
+		Expect(bytes.Equal(read(b), data)).To(BeTrue())
+	})
+
+	It("should release content upon removal", func() {
+		data := newData()
+		mi := put(bck, "obj-a", data).Mountpath()
+		nameB := colocated(bck, mi, "obj-b")
+		put(bck, nameB, data)
+
+		a := load(bck, "obj-a")
+		blob := a.Mountpath().DedupFQN(a.DedupKey())
+		for _, name := range []string{"obj-a", nameB} {
+			lom := load(bck, name)
+			lom.Lock(true)
+			Expect(lom.RemoveObj()).NotTo(HaveOccurred())
+			lom.Unlock(true)
+			if name == "obj-a" {
+				Expect(refs(a)).To(BeEquivalentTo(1))
+			}
+		}
+		Expect(cos.Stat(blob)).To(HaveOccurred())
+	})
+
+	It("should reference the same content when copying", func() {
+		data := newData()
+		src := put(bck, "obj-src", data)
+		nameDst := colocated(bck2, src.Mountpath(), "obj-dst")
+		dstFQN := src.Mountpath().MakePathFQN(&bck2, fs.ObjectType, nameDst)
+
+		src.Lock(true)
+		Expect(src.Load(false, true)).NotTo(HaveOccurred())
+		dst, err := src.Copy2FQN(dstFQN, nil)
+		src.Unlock(true)
+		Expect(err).NotTo(HaveOccurred())
+		core.FreeLOM(dst)
+
+		d := load(bck2, nameDst)
+		Expect(d.IsDeduped()).To(BeTrue())
+		Expect(refs(d)).To(BeEquivalentTo(2))
+		Expect(bytes.Equal(read(d), data)).To(BeTrue())
+		finfo, err := os.Stat(dstFQN)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(finfo.Size()).To(BeZero())
+	})
+})
@@ -19,6 +19,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/archive"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
 	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/fs"
@@ -86,10 +87,26 @@ func (lh *LomHandle) Open() (cos.ReadOpenCloser, error) { return lh.lom.NewHandl
 // see also: lom.GetROC()
 func (lom *LOM) Open() (fh cos.LomReader, err error) {
 	debug.Assert(lom.IsLocked() > apc.LockNone, lom.Cname(), " is not locked")
-	fh, err = os.Open(lom.FQN)
+	if lom.IsChunked(true) {
+		return lom.newUfestReader()
+	}
+	if lom.IsPacked(true) {
+		return lom.newPackReader()
+	}
+	if lom.IsCompressed(true) {
+		return lom.newComprReader()
+	}
+	if lom.IsDeduped(true) {
+		fh, err := os.Open(lom.dedupFQN())
+		if err != nil {
+			return nil, err
+		}
+		return lom.uringRd(fh), nil
+	}
+	osfh, err := os.Open(lom.FQN)
 	switch {
 	case err == nil:
-		return fh, nil
+		return lom.uringRd(osfh), nil
 	case cos.IsNotExist(err):
 		if e := lom._checkBdir(); e != nil {
 			err = e
@@ -109,12 +126,32 @@ func (lom *LOM) Open() (fh cos.LomReader, err error) {
 
 func (lom *LOM) Create() (cos.LomWriter, error) {
 	debug.Assert(lom.IsLocked() == apc.LockWrite, "must be wlocked: ", lom.Cname())
-	return lom._cf(lom.FQN)
+	prev := lom.prevDedup()
+	fh, err := lom._cf(lom.FQN)
+	if err != nil {
+		return nil, err
+	}
+	lom.delPacked()
+	lom.md.clrCompr()
+	lom.md.clrDedup()
+	lom.decref(prev)
+	return lom.uringWr(fh), nil
 }
 
-func (lom *LOM) CreateWork(wfqn string) (cos.LomWriter, error) { return lom._cf(wfqn) } // -> lom
-func (lom *LOM) CreatePart(wfqn string) (*os.File, error)      { return lom._cf(wfqn) } // TODO: differentiate
-func (lom *LOM) CreateSlice(wfqn string) (*os.File, error)     { return lom._cf(wfqn) } // --/--
+func (lom *LOM) CreatePart(wfqn string) (*os.File, error)  { return lom._cf(wfqn) } // TODO: differentiate
+func (lom *LOM) CreateSlice(wfqn string) (*os.File, error) { return lom._cf(wfqn) } // --/--
+
+// workfile => lom (whole, non-chunked; compare with lom.CreateChunked)
+func (lom *LOM) CreateWork(wfqn string) (cos.LomWriter, error) {
+	lom.SetChunked(false)
+	lom.md.clrCompr()
+	lom.md.clrDedup()
+	fh, err := lom._cf(wfqn)
+	if err != nil {
+		return nil, err
+	}
+	return lom.uringWr(fh), nil
+}
 
 func (lom *LOM) _cf(fqn string) (fh *os.File, err error) {
 	fh, err = os.OpenFile(fqn, _openFlags, cos.PermRWR)
@@ -186,7 +223,20 @@ func (*LOM) AppendWork(wfqn string) (fh cos.LomWriter, err error) {
 //
 
 func (lom *LOM) RemoveMain() error {
-	return cos.RemoveFile(lom.FQN)
+	prev := lom.prevDedup()
+	err := cos.RemoveFile(lom.FQN)
+	if err == nil {
+		lom.decref(prev)
+		delMdx(lom.mi, lom.Uname())
+	}
+	if p := lom.packs(); p.Has(lom.ObjName) {
+		if erp := p.Del(lom.ObjName); erp != nil && !cos.IsNotExist(erp) && err == nil {
+			err = erp
+		}
+	}
+	lom.md.lid = lom.md.lid.clrlmfl(lmflPack)
+	lom.md.clrDedup()
+	return err
 }
 
 func (lom *LOM) RemoveObj(force ...bool) (err error) {
@@ -200,11 +250,25 @@ func (lom *LOM) RemoveObj(force ...bool) (err error) {
 		// NOTE: making "rlock" exception to be able to forcefully rm corrupted object in the GET path
 		return len(force) > 0 && force[0] && locked == apc.LockRead
 	})
+	if lom.IsChunked(true) {
+		// (all copies, if any, refer to the same chunks)
+		if u, erc := lom.LoadUfest(); erc == nil {
+			if erc = u.Remove(lom); erc != nil {
+				nlog.Warningln(lom.Cname(), "failed to remove chunks: [", erc, "]")
+			}
+		} else if !cos.IsNotExist(erc) {
+			nlog.Warningln(erc)
+		}
+	}
 	err = lom.RemoveMain()
-	for copyFQN := range lom.md.copies {
+	lom.delOtherTier()
+	for copyFQN, mi := range lom.md.copies {
 		if erc := cos.RemoveFile(copyFQN); erc != nil && !cos.IsNotExist(erc) && err == nil {
 			err = erc
 		}
+		if mi != lom.mi {
+			delMdx(mi, lom.Uname())
+		}
 	}
 	lom.md.lid = 0
 	return err
@@ -286,9 +350,13 @@ func (lom *LOM) RenameFinalize(wfqn string) error {
 		nlog.Warningln(e, "(", bdir, ")")
 		return e
 	}
+	prev := lom.prevDedup()
 	err := lom.RenameToMain(wfqn)
 	switch {
 	case err == nil:
+		lom.delPacked()
+		lom.decref(prev)
+		lom.delOtherTier()
 		return nil
 	case cos.IsErrMv(err):
 		return err
@@ -352,5 +420,16 @@ func (lom *LOM) NewArchpathReader(lmfh cos.LomReader, archpath, mime string) (cs
 func (lom *LOM) Chtimes(atime, mtime time.Time) (err error) { return os.Chtimes(lom.FQN, atime, mtime) }
 func (lom *LOM) GetXattr(buf []byte) ([]byte, error)        { return fs.GetXattrBuf(lom.FQN, xattrLOM, buf) }
 func (lom *LOM) GetXattrN(name string) ([]byte, error)      { return fs.GetXattr(lom.FQN, name) }
-func (lom *LOM) SetXattr(data []byte) error                 { return fs.SetXattr(lom.FQN, xattrLOM, data) }
 func (lom *LOM) SetXattrN(name string, data []byte) error   { return fs.SetXattr(lom.FQN, name, data) }
+
+// (packed object: update metadata in the pack record)
+func (lom *LOM) SetXattr(data []byte) error {
+	if lom.md.lid.haslmfl(lmflPack) {
+		return lom.packs().SetMd(lom.ObjName, data, lom.Bprops().Pack.PackSizeX(), lom.IsFeatureSet(feat.FsyncPUT))
+	}
+	err := fs.SetXattr(lom.FQN, xattrLOM, data)
+	if err == nil && !lom.IsFntl() {
+		putMdx(lom.mi, lom.Uname(), lom.FQN, data)
+	}
+	return err
+}
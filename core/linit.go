@@ -90,7 +90,7 @@ func (lom *LOM) InitBck(bck *cmn.Bck) (err error) {
 	}
 	uname := lom.bck.MakeUname(lom.ObjName)
 	lom.md.uname = cos.UnsafeSptr(uname)
-	lom.mi, lom.digest, err = fs.Hrw(uname)
+	lom.mi, lom.digest, err = fs.HrwObj(uname) // (see ltier.go)
 	if err != nil {
 		return
 	}
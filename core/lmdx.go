@@ -0,0 +1,147 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Persistent metadata index ============================================
+//
+// With config.MDIndex enabled, object metadata (packed lmeta - same bytes as
+// the xattr) is also stored in the per-mountpath index (see fs/mdx.go):
+//   - lom.Load() and LoadUnsafe() that miss the in-memory cache stat the
+//     object's file and look up the index; the record is used if and only if
+//     the file's size and mtime match - otherwise, xattr is read (and added to
+//     the index, to be served next time);
+//   - every xattr update (PersistMain, atime flush, copies) invalidates the
+//     record and queues the new one; removing the object deletes it;
+//   - queued updates are written by housekeeping every `md_index.flush_time`;
+//   - the in-memory index is bounded (`md_index.max_entries`): evicted entries
+//     are served from xattrs, same as misses;
+//   - the index is closed cleanly at shutdown (Term) and, only then, gets
+//     loaded upon restart - serving the first (and subsequent) loads
+//     without touching xattrs.
+//
+// Not indexed: packed objects (metadata in the pack record) and objects with
+// names too long to be stored as is.
+// ======================================================================
+
+func (lom *LOM) mdx() *fs.MDX {
+	if lom.IsFntl() {
+		return nil
+	}
+	return lom.mi.MDX()
+}
+
+// (having stat-ed the object's file)
+func (lom *LOM) fromMdx(x *fs.MDX, size int64, mtime time.Time) bool {
+	b, ok := x.Get(lom.Uname(), size, mtime.UnixNano())
+	if !ok {
+		T.StatsUpdater().Inc(LcacheMdxMissCount)
+		return false
+	}
+	if _, err := lom.unpack(b, g.maxLmeta.Load(), true /*populate*/); err != nil {
+		nlog.Warningln(x.String(), lom.Cname(), "[", err, "]")
+		T.StatsUpdater().Inc(LcacheMdxMissCount)
+		return false
+	}
+	lom.md.lid = lom.md.lid.clrlmfl(lmflPack)
+	T.StatsUpdater().Inc(LcacheMdxHitCount)
+	return true
+}
+
+// (just loaded from xattr)
+func (lom *LOM) addMdx(x *fs.MDX, size int64, mtime time.Time) {
+	if lom.IsPacked(true) {
+		return
+	}
+	buf := lom.pack()
+	x.Add(lom.Uname(), buf, size, mtime.UnixNano())
+	g.smm.Free(buf)
+}
+
+// (xattr updated)
+func putMdx(mi *fs.Mountpath, uname, fqn string, md []byte) {
+	if mi == nil {
+		return
+	}
+	if x := mi.MDX(); x != nil {
+		x.Put(uname, fqn, md)
+	}
+}
+
+// (file removed)
+func delMdx(mi *fs.Mountpath, uname string) {
+	if mi == nil {
+		return
+	}
+	if x := mi.MDX(); x != nil {
+		x.Del(uname)
+	}
+}
+
+//
+// housekeeping: write queued updates; compact
+//
+
+func hkMdx(int64) time.Duration {
+	config := cmn.GCO.Get()
+	if !config.MDIndex.Enabled {
+		for _, mi := range fs.GetAvail() {
+			_ = mi.MDX() // (discard, if open)
+		}
+		return config.MDIndex.FlushTimeX()
+	}
+	var (
+		bmd     = T.Bowner().Get()
+		present = make(map[string]bool, 4)
+	)
+	keep := func(uname string) bool {
+		bck, _ := cmn.ParseUname(uname)
+		k := string(bck.MakeUname(""))
+		if v, ok := present[k]; ok {
+			return v
+		}
+		_, ok := bmd.Get(meta.CloneBck(&bck))
+		present[k] = ok
+		return ok
+	}
+	for _, mi := range fs.GetAvail() {
+		x := mi.MDX()
+		if x == nil {
+			continue
+		}
+		if err := x.Flush(); err != nil {
+			nlog.Errorln(x.String(), "failed to flush: [", err, "]")
+			T.FSHC(err, mi, "")
+			continue
+		}
+		if !x.NeedsCompaction() {
+			continue
+		}
+		reclaimed, err := x.Compact(keep)
+		if err != nil {
+			nlog.Errorln(x.String(), "failed to compact: [", err, "]")
+			T.FSHC(err, mi, "")
+			continue
+		}
+		nlog.Infoln(x.String(), "compacted, reclaimed", cos.ToSizeIEC(reclaimed, 2))
+	}
+	return config.MDIndex.FlushTimeX()
+}
+
+// at shutdown: write queued updates and mark the index clean
+func closeMdx() {
+	for _, mi := range fs.GetAvail() {
+		mi.CloseMDX()
+	}
+}
@@ -0,0 +1,126 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("metadata index", func() {
+	const (
+		tmpDir = "/tmp/lmdx_test"
+		mpath  = tmpDir + "/1"
+	)
+
+	var (
+		bck = cmn.Bck{Name: "LMDX_TEST", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		bmd = mock.NewBaseBownerMock(
+			meta.NewBck(bck.Name, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum: cmn.CksumConf{Type: cos.ChecksumOneXxh},
+				BID:   408,
+			}),
+		)
+	)
+
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	setEnabled := func(enabled bool) {
+		config := cmn.GCO.BeginUpdate()
+		config.MDIndex.Enabled = enabled
+		cmn.GCO.CommitUpdate(config)
+	}
+
+	BeforeEach(func() {
+		_ = cos.CreateDir(mpath)
+		setEnabled(true)
+		_, _ = fs.Add(mpath, "daeID")
+		_ = mock.NewTarget(bmd)
+		_ = fs.CreateBucket(&bck, false)
+	})
+
+	AfterEach(func() {
+		setEnabled(false)
+		_, _ = fs.Remove(mpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	put := func(objName string) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		Expect(os.WriteFile(lom.FQN, []byte("0123456789"), cos.PermRWR)).NotTo(HaveOccurred())
+		lom.SetSize(10)
+		lom.SetVersion("7")
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		Expect(lom.PersistMain()).NotTo(HaveOccurred())
+		Expect(lom.Mountpath().MDX().Flush()).NotTo(HaveOccurred())
+		lom.UncacheDel()
+		return lom
+	}
+
+	load := func(objName string) (*core.LOM, error) {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		return lom, lom.Load(false, false)
+	}
+
+	// with xattr garbled, the only way to load is the index
+	garble := func(lom *core.LOM) {
+		Expect(fs.SetXattr(lom.FQN, xattrLOM, []byte("garbage"))).NotTo(HaveOccurred())
+	}
+
+	It("should load object metadata from the index", func() {
+		lom := put("obj")
+		garble(lom)
+
+		lom2, err := load("obj")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lom2.Lsize()).To(BeEquivalentTo(10))
+		Expect(lom2.Version()).To(Equal("7"))
+	})
+
+	It("should not use stale entries", func() {
+		lom := put("obj")
+		garble(lom)
+
+		// modified behind the scenes
+		mtime := time.Now().Add(-time.Hour)
+		Expect(os.Chtimes(lom.FQN, mtime, mtime)).NotTo(HaveOccurred())
+		_, err := load("obj")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should add entries upon loading from xattr", func() {
+		lom := put("obj")
+		x := lom.Mountpath().MDX()
+		x.Del(lom.Uname())
+		Expect(x.Flush()).NotTo(HaveOccurred())
+		Expect(x.Len()).To(BeZero())
+
+		_, err := load("obj")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(x.Flush()).NotTo(HaveOccurred())
+		Expect(x.Len()).To(Equal(1))
+
+		// removing the object removes the entry
+		lom.Lock(true)
+		Expect(lom.RemoveObj()).NotTo(HaveOccurred())
+		lom.Unlock(true)
+		Expect(x.Flush()).NotTo(HaveOccurred())
+		Expect(x.Len()).To(BeZero())
+	})
+})
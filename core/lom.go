@@ -23,6 +23,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/memsys"
 )
@@ -45,15 +46,20 @@ const (
 	LcacheEvictedCount   = "lcache.evicted.n"
 	LcacheErrCount       = "err.lcache.n" // errPrefix + "lcache.n"
 	LcacheFlushColdCount = "lcache.flush.cold.n"
+	LcacheMdxHitCount    = "lcache.mdx.hit.n"  // served from persistent metadata index (see lmdx.go)
+	LcacheMdxMissCount   = "lcache.mdx.miss.n" // ditto, not found or stale
 )
 
 type (
-	lmeta struct { // sizeof = 72
+	lmeta struct { // sizeof = 96
 		copies fs.MPI
 		uname  *string
+		dkey   string // content key of a deduplicated object (see ldedup.go)
+		xcks   string // additional checksums (see lcksum.go)
 		cmn.ObjAttrs
 		atimefs uint64 // (high bit `lomDirtyMask` | int64: atime)
 		lid     lomBID // (for bitwise structure, see lombid.go)
+		psize   int64  // physical size of a compressed object (see lcompr.go)
 	}
 	LOM struct {
 		mi      *fs.Mountpath
@@ -73,6 +79,7 @@ type (
 		locker   nameLocker
 		lchk     lchk
 		maxLmeta atomic.Int64
+		ufests   sync.Map // chunk-set IDs in flight (see lchunk.go)
 	}
 )
 
@@ -107,6 +114,9 @@ func Tinit(t Target, config *cmn.Config, runHK bool) {
 	}
 	if runHK {
 		g.lchk.init(config)
+		hk.Reg("packs"+hk.NameSuffix, hkCompactPacks, packCompactIval)  // (see lpack.go)
+		hk.Reg("mdx"+hk.NameSuffix, hkMdx, config.MDIndex.FlushTimeX()) // (see lmdx.go)
+		hk.Reg("uring"+hk.NameSuffix, hkUring, uringHkIval)             // (see luring.go)
 	}
 	for i := range recordSepa {
 		recdupSepa[i] = recordSepa[i]
@@ -119,6 +129,7 @@ func Term() {
 		time.Sleep(sleep)
 	}
 	g.lchk.term()
+	closeMdx()
 }
 
 /////////
@@ -144,11 +155,15 @@ func (lom *LOM) Lsize(special ...bool) int64 {
 func (lom *LOM) Fstat(getAtime bool) (size, atimefs int64, mtime time.Time, _ error) {
 	finfo, err := os.Stat(lom.FQN)
 	if err == nil {
-		size = finfo.Size() // NOTE: chunk?
+		size = finfo.Size() // NOTE: chunked object - size of the manifest
 		mtime = finfo.ModTime()
 		if getAtime {
 			atimefs = ios.GetATime(finfo).UnixNano()
 		}
+	} else if cos.IsNotExist(err) {
+		if psize, patime, ok := lom.fstatPacked(); ok {
+			return psize, patime, time.Unix(0, patime), nil
+		}
 	}
 	return size, atimefs, mtime, err
 }
@@ -210,10 +225,10 @@ func (lom *LOM) ObjectName() string       { return lom.ObjName }
 func (lom *LOM) Bucket() *cmn.Bck         { return (*cmn.Bck)(&lom.bck) }
 func (lom *LOM) Mountpath() *fs.Mountpath { return lom.mi }
 
-// chunks vs whole // TODO: NIY
+// chunks vs whole (see lchunk.go)
 func (lom *LOM) IsChunked(special ...bool) bool {
 	debug.Assert(len(special) > 0 || lom.loaded())
-	return false
+	return lom.md.lid.haslmfl(lmflChunk)
 }
 
 func ParseObjLoc(loc string) (tname, mpname string) {
@@ -419,11 +434,7 @@ func (lom *LOM) Load(cacheit, locked bool) error {
 	)
 	// fast path
 	if lmd != nil {
-		lom.md = *lmd
-		if lom.IsFntl() {
-			lom.fixupFntl()
-		}
-		return lom._checkBucket(bmd)
+		return lom.fromLmd(lmd, bmd)
 	}
 
 	// slow path
@@ -431,7 +442,16 @@ func (lom *LOM) Load(cacheit, locked bool) error {
 		defer lom.Unlock(false)
 	}
 	if err := lom.FromFS(); err != nil {
-		return err
+		if !cos.IsErrNotFound(err) {
+			return err
+		}
+		// tiered mountpaths (see ltier.go)
+		if lcache, lmd, err = lom.loadOtherTier(err); err != nil {
+			return err
+		}
+		if lmd != nil {
+			return lom.fromLmd(lmd, bmd)
+		}
 	}
 
 	// MetaverLOM = 1: always zero (not storing lom.md.lid)
@@ -448,6 +468,14 @@ func (lom *LOM) Load(cacheit, locked bool) error {
 	return nil
 }
 
+func (lom *LOM) fromLmd(lmd *lmeta, bmd *meta.BMD) error {
+	lom.md = *lmd
+	if lom.IsFntl() {
+		lom.fixupFntl()
+	}
+	return lom._checkBucket(bmd)
+}
+
 func (lom *LOM) _checkBucket(bmd *meta.BMD) error {
 	bck := &lom.bck
 	bprops, present := bmd.Get(bck)
@@ -472,24 +500,31 @@ func (lom *LOM) LoadUnsafe() (err error) {
 	)
 	// fast path
 	if lmd != nil {
-		lom.md = *lmd
-		if lom.IsFntl() {
-			lom.fixupFntl()
+		return lom.fromLmd(lmd, bmd)
+	}
+
+	// persistent metadata index (see lmdx.go) - one stat instead of getxattr
+	if x := lom.mdx(); x != nil {
+		if size, _, mtime, err := lom.Fstat(false); err == nil && lom.fromMdx(x, size, mtime) {
+			return lom._loadedUnsafe(bmd)
 		}
-		return lom._checkBucket(bmd)
 	}
 
 	// read and decode xattr; NOTE: fs.GetXattr* vs fs.SetXattr race possible and must be
 	// either a) handled or b) benign from the caller's perspective
 	if _, err = lom.lmfs(true); err == nil {
-		// MetaverLOM = 1: always zero (not storing lom.md.lid)
-		debug.Assert(lom.bid() == 0 || lom.bid() == lom.Bprops().BID, lom.bid())
-		lom.setbid(lom.Bprops().BID)
-		err = lom._checkBucket(bmd)
+		err = lom._loadedUnsafe(bmd)
 	}
 	return err
 }
 
+func (lom *LOM) _loadedUnsafe(bmd *meta.BMD) error {
+	// MetaverLOM = 1: always zero (not storing lom.md.lid)
+	debug.Assert(lom.bid() == 0 || lom.bid() == lom.Bprops().BID, lom.bid())
+	lom.setbid(lom.Bprops().BID)
+	return lom._checkBucket(bmd)
+}
+
 //
 // lom cache -------------------------------------------------------------
 //
@@ -569,7 +604,7 @@ func (lom *LOM) fromCache() (lcache *sync.Map, lmd *lmeta) {
 }
 
 func (lom *LOM) FromFS() error {
-	size, atimefs, _, err := lom.Fstat(true /*get-atime*/)
+	size, atimefs, mtime, err := lom.Fstat(true /*get-atime*/)
 	if err == nil {
 		goto exist
 	}
@@ -590,7 +625,7 @@ func (lom *LOM) FromFS() error {
 		// temp substitute to check existence
 		short := lom.ShortenFntl()
 		saved := lom.PushFntl(short)
-		size, atimefs, _, err = lom.Fstat(true)
+		size, atimefs, mtime, err = lom.Fstat(true)
 		if err == nil {
 			goto exist
 		}
@@ -609,6 +644,11 @@ func (lom *LOM) FromFS() error {
 	}
 
 exist:
+	// persistent metadata index (see lmdx.go)
+	x := lom.mdx()
+	if x != nil && lom.fromMdx(x, size, mtime) {
+		goto fstat
+	}
 	if _, err = lom.lmfs(true); err != nil {
 		// retry once
 		if cmn.IsErrLmetaNotFound(err) {
@@ -622,8 +662,12 @@ exist:
 		}
 		return err
 	}
+	if x != nil {
+		lom.addMdx(x, size, mtime)
+	}
+fstat:
 	// fstat & atime
-	if lom.md.Size != size { // corruption or tampering
+	if lom.StoredSize() != size && !lom.IsChunked(true) { // corruption or tampering
 		return cmn.NewErrLmetaCorrupted(lom.whingeSize(size))
 	}
 	lom.md.Atime = atimefs
@@ -26,7 +26,7 @@ import (
 
 const (
 	MetaverLOM   = 1 // LOM
-	MetaverChunk = 2 // LOM chunk // TODO: niy
+	MetaverChunk = 2 // chunk manifest (see lchunk.go)
 )
 
 // On-disk metadata layout - changing any of this must be done with respect
@@ -51,8 +51,7 @@ const mdCksumTyXXHash = 1
 
 // on-disk xattr names
 const (
-	xattrLOM   = "user.ais.lom"
-	xattrChunk = "user.ais.chunk"
+	xattrLOM = "user.ais.lom"
 )
 
 const (
@@ -81,6 +80,9 @@ const (
 	packedCustom
 	packedNum
 	packedChunk
+	packedCompr
+	packedDedup
+	packedCksums
 )
 
 // packing format: separators
@@ -151,6 +153,11 @@ func (lom *LOM) lmfs(populate bool) (md *lmeta, err error) {
 	b, err = lom.GetXattr(buf)
 	if err != nil {
 		slab.Free(buf)
+		if cos.IsNotExist(err) {
+			if md, errP := lom.lmpack(populate); !cos.IsNotExist(errP) {
+				return md, errP
+			}
+		}
 		if err != syscall.ERANGE {
 			return whingeLmeta(lom.Cname(), err)
 		}
@@ -165,6 +172,9 @@ func (lom *LOM) lmfs(populate bool) (md *lmeta, err error) {
 	}
 	md, err = lom.unpack(b, mdSize, populate)
 	slab.Free(buf)
+	if err == nil {
+		md.lid = md.lid.clrlmfl(lmflPack)
+	}
 	return md, err
 }
 
@@ -244,24 +254,29 @@ func (lom *LOM) Persist() (err error) {
 func (lom *LOM) persistMdOnCopies() (copyFQN string, err error) {
 	buf := lom.pack()
 	// replicate across copies
-	for copyFQN = range lom.md.copies {
-		if copyFQN == lom.FQN {
+	for fqn, mi := range lom.md.copies {
+		if fqn == lom.FQN {
 			continue
 		}
+		copyFQN = fqn
 		if err = fs.SetXattr(copyFQN, xattrLOM, buf); err != nil {
 			break
 		}
+		putMdx(mi, lom.Uname(), copyFQN, buf)
 	}
 	g.smm.Free(buf)
 	return
 }
 
 func (lom *LOM) flushAtime(atime time.Time) error {
-	_, _, mtime, err := lom.Fstat(false /*get-atime*/)
-	if err != nil {
-		return err
+	finfo, err := os.Stat(lom.FQN)
+	if err == nil {
+		return os.Chtimes(lom.FQN, atime, finfo.ModTime())
+	}
+	if p := lom.packs(); cos.IsNotExist(err) && p.Has(lom.ObjName) {
+		return p.SetAtime(lom.ObjName, atime.UnixNano())
 	}
-	return os.Chtimes(lom.FQN, atime, mtime)
+	return err
 }
 
 func (lom *LOM) pack() (buf []byte) {
@@ -326,6 +341,10 @@ func (md *lmeta) unpack(buf []byte) error {
 		return cos.NewErrMetaCksum(expectedCksum, actualCksum, md.String())
 	}
 
+	md.lid = md.lid.clrlmfl(lmflChunk)
+	md.clrCompr()
+	md.clrDedup()
+	md.xcks = ""
 	for off := 0; !last; {
 		var (
 			record []byte
@@ -403,6 +422,20 @@ func (md *lmeta) unpack(buf []byte) error {
 				}
 			}
 			md.SetCustomMD(custom)
+		case packedChunk:
+			md.lid = md.lid.setlmfl(lmflChunk)
+		case packedCompr:
+			if len(record) != cos.SizeofI16+cos.SizeofI64 {
+				return errors.New(badLmeta + " #5.2")
+			}
+			md.setCompr(int64(binary.BigEndian.Uint64(record[cos.SizeofI16:])))
+		case packedDedup:
+			if len(record) <= cos.SizeofI16 {
+				return errors.New(badLmeta + " #5.3")
+			}
+			md.setDedup(string(record[cos.SizeofI16:]))
+		case packedCksums:
+			md.xcks = string(record[cos.SizeofI16:])
 		default:
 			return errors.New(badLmeta + " #6")
 		}
@@ -436,6 +469,31 @@ func (md *lmeta) pack(mdSize int64) (buf []byte) {
 	binary.BigEndian.PutUint64(b8[:], uint64(md.Size))
 	buf = _packRecord(buf, packedSize, cos.UnsafeS(b8[:]), false)
 
+	// chunked
+	if md.lid.haslmfl(lmflChunk) {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _packRecord(buf, packedChunk, "", false)
+	}
+
+	// compressed (physical size)
+	if md.lid.haslmfl(lmflCompr) {
+		binary.BigEndian.PutUint64(b8[:], uint64(md.psize))
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _packRecord(buf, packedCompr, cos.UnsafeS(b8[:]), false)
+	}
+
+	// deduplicated (content key)
+	if md.lid.haslmfl(lmflDedup) {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _packRecord(buf, packedDedup, md.dkey, false)
+	}
+
+	// additional checksums (iff still valid)
+	if md.xcks != "" && md.xcksums() != nil {
+		buf = g.smm.Append(buf, recordSepa)
+		buf = _packRecord(buf, packedCksums, md.xcks, false)
+	}
+
 	// copies
 	if len(md.copies) > 0 {
 		buf = g.smm.Append(buf, recordSepa)
@@ -41,6 +41,10 @@ type (
 
 const (
 	lmflFntl = lomFlags(1 << iota)
+	lmflChunk
+	lmflPack
+	lmflCompr
+	lmflDedup
 	lmflReserved
 )
 
@@ -0,0 +1,221 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Packed objects =======================================================
+//
+// With bucket property `pack.objsize_limit` set, small objects get appended
+// into per-mountpath pack files (see fs/pack.go) instead of being stored
+// as separate files with their own inodes and xattrs. Whereby:
+//   - packed object has no file of its own: lom.FQN does not exist;
+//   - packed lmeta (same as xattr) is stored in the pack record, next to
+//     the object's data; atime is stored in the record header;
+//   - lmflPack is a runtime flag (not persisted) that gets set when loading
+//     from the pack (compare with lmflChunk);
+//   - deleting or overwriting a packed object leaves a dead record that
+//     gets reclaimed by background compaction (below).
+//
+// Regular file always takes precedence: writing the object as a regular
+// file removes its packed record, and vice versa.
+// ======================================================================
+
+const packCompactIval = 10 * time.Minute
+
+type packReader struct {
+	*io.SectionReader
+	fh *os.File
+}
+
+// interface guard
+var _ cos.LomReader = (*packReader)(nil)
+
+func (r *packReader) Close() error { return r.fh.Close() }
+
+func (lom *LOM) IsPacked(special ...bool) bool {
+	debug.Assert(len(special) > 0 || lom.loaded())
+	return lom.md.lid.haslmfl(lmflPack)
+}
+
+func (lom *LOM) packs() *fs.Packs { return lom.mi.Packs(lom.Bucket()) }
+
+// whether a new object of a given size is to be packed
+// (not packing: erasure-coded and locally mirrored buckets, chunked and compressed objects, and long names)
+func (lom *LOM) CanPack(size int64) bool {
+	bprops := lom.Bprops()
+	if !bprops.Pack.Packed(size) || bprops.EC.Enabled || bprops.Mirror.Local() {
+		return false
+	}
+	return !lom.IsChunked(true) && !lom.IsCompressed(true) && !fs.IsFntl(lom.ObjName)
+}
+
+// workfile => pack (compare with RenameFinalize + PersistMain)
+// (caller must set size and atime)
+func (lom *LOM) PackFinalize(wfqn string) error {
+	debug.Assert(lom.IsLocked() == apc.LockWrite, lom.Cname(), " is not wlocked")
+	data, err := os.ReadFile(wfqn)
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) != lom.md.Size {
+		return fmt.Errorf("%s: packing size mismatch (%d vs %d)", lom.Cname(), len(data), lom.md.Size)
+	}
+	atime := lom.AtimeUnix()
+	if atime < 0 {
+		atime = -atime // (prefetch)
+	}
+	lom.md.lid = lom.md.lid.setlmfl(lmflPack)
+	lom.md.clrDedup()
+	var (
+		conf  = &lom.Bprops().Pack
+		fsync = lom.IsFeatureSet(feat.FsyncPUT)
+		buf   = lom.pack()
+	)
+	err = lom.packs().Put(lom.ObjName, buf, data, atime, conf.PackSizeX(), fsync)
+	g.smm.Free(buf)
+	if err != nil {
+		lom.md.lid = lom.md.lid.clrlmfl(lmflPack)
+		T.FSHC(err, lom.Mountpath(), "")
+		return cmn.NewErrFailedTo(T, "pack", lom.Cname(), err)
+	}
+	if err := cos.RemoveFile(wfqn); err != nil {
+		nlog.Warningln(lom.Cname(), "failed to remove work file: [", err, "]")
+	}
+	// previous (regular) version, if any
+	prev := lom.prevDedup()
+	if err := cos.RemoveFile(lom.FQN); err != nil {
+		nlog.Warningln(lom.Cname(), "failed to remove previous version: [", err, "]")
+	} else {
+		lom.decref(prev)
+		delMdx(lom.mi, lom.Uname())
+	}
+	lom.md.clearDirty()
+	lom.Recache()
+	return nil
+}
+
+// regular file takes precedence - remove packed record, if any
+func (lom *LOM) delPacked() {
+	lom.md.lid = lom.md.lid.clrlmfl(lmflPack)
+	p := lom.packs()
+	if !p.Has(lom.ObjName) {
+		return
+	}
+	if err := p.Del(lom.ObjName); err != nil && !cos.IsNotExist(err) {
+		nlog.Warningln(lom.Cname(), "failed to remove packed record: [", err, "]")
+	}
+}
+
+// move packed object to another mountpath (resilver)
+// (caller must wlock)
+func (lom *LOM) MovePacked(mi *fs.Mountpath) error {
+	debug.Assert(lom.IsPacked())
+	src := lom.packs()
+	fh, e, err := src.Open(lom.ObjName)
+	if err != nil {
+		return err
+	}
+	data := make([]byte, e.Size)
+	_, err = fh.ReadAt(data, e.DataOff())
+	cos.Close(fh)
+	if err != nil {
+		return err
+	}
+	buf := lom.pack()
+	err = mi.Packs(lom.Bucket()).Put(lom.ObjName, buf, data, e.Atime, lom.Bprops().Pack.PackSizeX(), false /*fsync*/)
+	g.smm.Free(buf)
+	if err != nil {
+		return err
+	}
+	if err := src.Del(lom.ObjName); err != nil && !cos.IsNotExist(err) {
+		return err
+	}
+	lom.UncacheDel()
+	return nil
+}
+
+func (lom *LOM) newPackReader() (*packReader, error) {
+	fh, e, err := lom.packs().Open(lom.ObjName)
+	if err != nil {
+		return nil, err
+	}
+	if e.Size != lom.md.Size {
+		cos.Close(fh)
+		return nil, fmt.Errorf("%s: packed size %d vs %d", lom.Cname(), e.Size, lom.md.Size)
+	}
+	return &packReader{SectionReader: io.NewSectionReader(fh, e.DataOff(), e.Size), fh: fh}, nil
+}
+
+// (compare with lom.lmfs)
+func (lom *LOM) lmpack(populate bool) (*lmeta, error) {
+	fh, e, err := lom.packs().Open(lom.ObjName)
+	if err != nil {
+		return nil, err
+	}
+	b, err := e.ReadMd(fh, lom.ObjName)
+	cos.Close(fh)
+	if err != nil {
+		return nil, err
+	}
+	md, err := lom.unpack(b, g.maxLmeta.Load(), populate)
+	if err == nil {
+		md.lid = md.lid.setlmfl(lmflPack)
+	}
+	return md, err
+}
+
+// (compare with lom.Fstat)
+func (lom *LOM) fstatPacked() (size, atime int64, ok bool) {
+	e, ok := lom.packs().Get(lom.ObjName)
+	return e.Size, e.Atime, ok
+}
+
+//
+// housekeeping: compact packs with enough dead space
+//
+
+func hkCompactPacks(int64) time.Duration {
+	bmd := T.Bowner().Get()
+	for _, mi := range fs.GetAvail() {
+		mi.RangePacks(func(p *fs.Packs) {
+			bprops, present := bmd.Get(meta.CloneBck(p.Bck()))
+			if !present {
+				return
+			}
+			if _, dead := p.Usage(); dead == 0 {
+				return
+			}
+			go CompactPacks(p, bprops.Pack.CompactPctX(), bprops.Pack.PackSizeX())
+		})
+	}
+	return packCompactIval
+}
+
+func CompactPacks(p *fs.Packs, pct int, packSize int64) (reclaimed int64) {
+	moved, reclaimed, err := p.Compact(pct, packSize)
+	switch {
+	case err != nil:
+		nlog.Errorln(p.String(), "compaction failed: [", err, "]")
+		T.FSHC(err, p.Mountpath(), "")
+	case reclaimed > 0:
+		nlog.Infoln(p.String(), "compacted: moved", moved, "reclaimed", cos.ToSizeIEC(reclaimed, 2))
+	}
+	return reclaimed
+}
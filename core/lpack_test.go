@@ -0,0 +1,155 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Packed objects", func() {
+	const (
+		tmpDir    = "/tmp/lpack_test"
+		numMpaths = 2
+		bucket    = "LPACK_TEST"
+		objSize   = 4*cos.KiB + 17
+	)
+
+	var (
+		mpaths []string
+		bck    = cmn.Bck{Name: bucket, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		bmd    = mock.NewBaseBownerMock(
+			meta.NewBck(bucket, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum: cmn.CksumConf{Type: cos.ChecksumOneXxh},
+				Pack:  cmn.PackConf{ObjSizeLimit: 64 * cos.KiB},
+				BID:   302,
+			}),
+		)
+	)
+	for i := range numMpaths {
+		mpaths = append(mpaths, fmt.Sprintf("%s/mpath%d", tmpDir, i))
+	}
+
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+	fs.CSM.Reg(fs.PackType, &fs.PackContentResolver{}, true)
+
+	BeforeEach(func() {
+		for _, mpath := range mpaths {
+			_ = cos.CreateDir(mpath)
+			_, _ = fs.Add(mpath, "daeID")
+		}
+		_ = mock.NewTarget(bmd)
+		_ = fs.CreateBucket(&bck, false)
+	})
+
+	AfterEach(func() {
+		// (other tests' mountpaths may still be there)
+		for _, mi := range fs.GetAvail() {
+			_ = os.RemoveAll(mi.MakePathBck(&bck))
+		}
+		for _, mpath := range mpaths {
+			_, _ = fs.Remove(mpath)
+		}
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	// write packed object and return its content
+	putPacked := func(objName string) (*core.LOM, []byte) {
+		data := make([]byte, objSize)
+		_, _ = cryptorand.Read(data)
+
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		Expect(lom.CanPack(objSize)).To(BeTrue())
+		lom.Lock(true)
+		defer lom.Unlock(true)
+
+		wfqn := fs.CSM.Gen(lom, fs.WorkfileType, "test")
+		wfh, err := cos.CreateFile(wfqn)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = wfh.Write(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wfh.Close()).NotTo(HaveOccurred())
+		lom.SetSize(objSize)
+		lom.SetCksum(cos.NewCksum(cos.ChecksumOneXxh, "0123456789abcdef"))
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		Expect(lom.PackFinalize(wfqn)).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+
+		// no files of its own
+		Expect(cos.Stat(wfqn)).To(HaveOccurred())
+		Expect(cos.Stat(lom.FQN)).To(HaveOccurred())
+		return lom, data
+	}
+
+	It("should write, load, and read packed object", func() {
+		lom, data := putPacked("dir/packed-obj")
+
+		newLom := &core.LOM{ObjName: lom.ObjName}
+		Expect(newLom.InitBck(&bck)).NotTo(HaveOccurred())
+		newLom.Lock(false)
+		defer newLom.Unlock(false)
+		Expect(newLom.Load(false, true)).NotTo(HaveOccurred())
+		Expect(newLom.IsPacked()).To(BeTrue())
+		Expect(newLom.Lsize()).To(BeEquivalentTo(objSize))
+		Expect(newLom.Checksum().Value()).To(Equal("0123456789abcdef"))
+
+		size, atime, _, err := newLom.Fstat(true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(size).To(BeEquivalentTo(objSize))
+		Expect(atime).To(Equal(lom.AtimeUnix()))
+
+		fh, err := newLom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(fh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data)).To(BeTrue())
+
+		// range read
+		p := make([]byte, 100)
+		n, err := fh.ReadAt(p, 1000)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(100))
+		Expect(bytes.Equal(p, data[1000:1100])).To(BeTrue())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+	})
+
+	It("should not pack objects that are too large", func() {
+		lom := &core.LOM{ObjName: "large-obj"}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		Expect(lom.CanPack(64*cos.KiB - 1)).To(BeTrue())
+		Expect(lom.CanPack(64 * cos.KiB)).To(BeFalse())
+	})
+
+	It("should remove packed object", func() {
+		lom, _ := putPacked("packed-obj-rm")
+
+		lom.Lock(true)
+		Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+		Expect(lom.RemoveObj()).NotTo(HaveOccurred())
+		lom.Unlock(true)
+
+		newLom := &core.LOM{ObjName: lom.ObjName}
+		Expect(newLom.InitBck(&bck)).NotTo(HaveOccurred())
+		err := newLom.Load(false, false)
+		Expect(cos.IsNotExist(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,173 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Tiered mountpaths ====================================================
+//
+// With config.Tier.FastLabel set (and both tiers non-empty - see fs/tier.go),
+// an object is stored at its HRW location within one of the two tiers:
+//   - lom.InitBck() resolves to the fast tier, where new objects are written -
+//     or, when the fast-tier mountpath is full, to the capacity tier (see fs.HrwObj);
+//   - lom.Load() that does not find the object there looks up the other
+//     tier (cache, then fstat) and, if found, switches the LOM to the latter;
+//   - writing (RenameFinalize) and removing the object removes its other-tier
+//     version, if any, so that there's never more than one;
+//   - tier migration (demotion and promotion) is done by space/tier.go.
+//
+// Not migrated: chunked, packed, deduplicated, mirrored, erasure-coded objects,
+// and objects with names too long to be stored as is.
+// ======================================================================
+
+func (lom *LOM) Tier() int { return lom.mi.Tier() }
+
+// the object's HRW location on the other tier, if any
+func (lom *LOM) OtherTier() *fs.Mountpath {
+	if !lom.IsHRW() {
+		return nil
+	}
+	tier := lom.mi.Tier()
+	if tier == fs.TierNone {
+		return nil
+	}
+	mi, _, err := fs.HrwTier(lom.bck.MakeUname(lom.ObjName), fs.OtherTier(tier))
+	if err != nil || mi == lom.mi {
+		return nil
+	}
+	return mi
+}
+
+func (lom *LOM) setMpath(mi *fs.Mountpath) {
+	lom.mi = mi
+	lom.FQN = mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+	lom.HrwFQN = &lom.FQN
+}
+
+// object not found at its location: try the other tier
+// - returns cached metadata, if any, or loads it from there;
+// - otherwise, restores the original location and returns the original error
+func (lom *LOM) loadOtherTier(errNotFound error) (lcache *sync.Map, lmd *lmeta, _ error) {
+	mi := lom.OtherTier()
+	if mi == nil {
+		return nil, nil, errNotFound
+	}
+	var (
+		saved = lom.mi
+		fqn   = lom.FQN
+	)
+	lom.setMpath(mi)
+	if lcache, lmd = lom.fromCache(); lmd != nil {
+		return lcache, lmd, nil
+	}
+	if err := lom.FromFS(); err != nil {
+		lom.mi, lom.FQN = saved, fqn
+		lom.HrwFQN = &lom.FQN
+		return nil, nil, errNotFound
+	}
+	return lcache, nil, nil
+}
+
+// remove the other-tier version of the object that's just been written or removed
+// (that is, stale or not yet migrated)
+func (lom *LOM) delOtherTier() {
+	mi := lom.OtherTier()
+	if mi == nil {
+		return
+	}
+	mi.LomCaches.Get(lom.CacheIdx()).Delete(lom.digest)
+	fqn := mi.MakePathFQN(lom.Bucket(), fs.ObjectType, lom.ObjName)
+	if err := cos.RemoveFile(fqn); err != nil {
+		nlog.Warningln(lom.Cname(), "failed to remove other-tier", fqn, "[", err, "]")
+	}
+	delMdx(mi, lom.Uname())
+}
+
+// whether the object can be migrated between tiers (see space/tier.go)
+func (lom *LOM) CanMigrate() bool {
+	if lom.IsChunked() || lom.IsPacked() || lom.IsDeduped() || lom.IsFntl() || lom.HasCopies() {
+		return false
+	}
+	return !lom.MirrorConf().Enabled && !lom.ECEnabled()
+}
+
+// move the object to its HRW location on the other tier (demote or promote)
+// - copies stored content as is (e.g., compressed), validating checksum when possible;
+// - persists metadata (including atime) at the destination prior to removing the source;
+// - caller must wlock
+func (lom *LOM) MoveTier(buf []byte) (*fs.Mountpath, error) {
+	debug.Assert(lom.CanMigrate())
+	mi := lom.OtherTier()
+	if mi == nil {
+		return nil, nil
+	}
+	var (
+		bck       = lom.Bucket()
+		wfqn      = mi.MakePathFQN(bck, fs.WorkfileType, fs.WorkfileCopy+"."+lom.ObjName)
+		dst       = mi.MakePathFQN(bck, fs.ObjectType, lom.ObjName)
+		cksum     = lom.Checksum()
+		cksumType = cos.ChecksumNone
+	)
+	if lom.mi.Tier() == fs.TierCapacity && cos.Stat(dst) == nil {
+		// fast-tier version takes precedence; the one being promoted is stale
+		lom.UncacheDel()
+		delMdx(lom.mi, lom.Uname())
+		return nil, cos.RemoveFile(lom.FQN)
+	}
+	if !cksum.IsEmpty() && !lom.IsCompressed() {
+		cksumType = cksum.Ty()
+	}
+	_, cksumHash, err := copyFile(lom.FQN, lom.mi, wfqn, mi, buf, cksumType)
+	if err != nil {
+		goto rerr
+	}
+	if cksumType != cos.ChecksumNone && !cksumHash.Equal(cksum) {
+		err = cos.NewErrDataCksum(&cksumHash.Cksum, cksum, lom.Cname())
+		goto rerr
+	}
+	if err = lom.moveMD(wfqn); err != nil {
+		goto rerr
+	}
+	if err = cos.Rename(wfqn, dst); err != nil {
+		goto rerr
+	}
+	delMdx(mi, lom.Uname()) // (re-indexed upon first load)
+
+	// release source
+	lom.UncacheDel()
+	if err := cos.RemoveFile(lom.FQN); err != nil {
+		nlog.Warningln(lom.Cname(), "failed to remove source", lom.FQN, "[", err, "]")
+	}
+	delMdx(lom.mi, lom.Uname())
+	lom.setMpath(mi)
+	return mi, nil
+
+rerr:
+	if nerr := cos.RemoveFile(wfqn); nerr != nil {
+		nlog.Errorln("nested err:", nerr)
+	}
+	return nil, err
+}
+
+// persist in-memory metadata (which may be dirty) and atime
+func (lom *LOM) moveMD(wfqn string) error {
+	buf := lom.pack()
+	err := fs.SetXattr(wfqn, xattrLOM, buf)
+	g.smm.Free(buf)
+	if err != nil {
+		return err
+	}
+	atime := time.Unix(0, lom.AtimeUnix())
+	return os.Chtimes(wfqn, atime, atime)
+}
@@ -0,0 +1,220 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"io"
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tiered mountpaths", func() {
+	const (
+		tmpDir    = "/tmp/ltier_test"
+		fastLabel = "nvme"
+		objSize   = 64*cos.KiB + 3
+	)
+
+	var (
+		fastMpath = tmpDir + "/fast"
+		capMpath  = tmpDir + "/capacity"
+		bck       = cmn.Bck{Name: "LTIER_TEST", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		bmd       = mock.NewBaseBownerMock(
+			meta.NewBck(bck.Name, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum: cmn.CksumConf{Type: cos.ChecksumOneXxh},
+				BID:   405,
+			}),
+		)
+		buf = make([]byte, 32*cos.KiB)
+	)
+
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	setFastLabel := func(label string) {
+		config := cmn.GCO.BeginUpdate()
+		config.Tier.FastLabel = label
+		cmn.GCO.CommitUpdate(config)
+	}
+
+	BeforeEach(func() {
+		_ = cos.CreateDir(fastMpath)
+		_ = cos.CreateDir(capMpath)
+		_, _ = fs.AddMpath("daeID", fastMpath, fastLabel, func() {})
+		_, _ = fs.Add(capMpath, "daeID")
+		setFastLabel(fastLabel)
+		_ = mock.NewTarget(bmd)
+		_ = fs.CreateBucket(&bck, false)
+	})
+
+	AfterEach(func() {
+		setFastLabel("")
+		_, _ = fs.Remove(fastMpath)
+		_, _ = fs.Remove(capMpath)
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	write := func(fqn string, data []byte) {
+		fh, err := cos.CreateFile(fqn)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = fh.Write(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+	}
+
+	put := func(objName string, data []byte) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		lom.Lock(true)
+		defer lom.Unlock(true)
+
+		wfqn := fs.CSM.Gen(lom, fs.WorkfileType, "test")
+		write(wfqn, data)
+		cksum := cos.NewCksumHash(cos.ChecksumOneXxh)
+		cksum.H.Write(data)
+		cksum.Finalize()
+
+		lom.SetSize(int64(len(data)))
+		lom.SetCksum(cksum.Clone())
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		Expect(lom.RenameFinalize(wfqn)).NotTo(HaveOccurred())
+		Expect(lom.PersistMain()).NotTo(HaveOccurred())
+		lom.UncacheUnless()
+		return lom
+	}
+
+	load := func(objName string) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+		return lom
+	}
+
+	read := func(lom *core.LOM) []byte {
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		fh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		b, err := io.ReadAll(fh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+		return b
+	}
+
+	move := func(lom *core.LOM) *fs.Mountpath {
+		lom.Lock(true)
+		defer lom.Unlock(true)
+		Expect(lom.Load(false, true)).NotTo(HaveOccurred())
+		Expect(lom.CanMigrate()).To(BeTrue())
+		mi, err := lom.MoveTier(buf)
+		Expect(err).NotTo(HaveOccurred())
+		return mi
+	}
+
+	newData := func() []byte {
+		data := make([]byte, objSize)
+		_, _ = cryptorand.Read(data)
+		return data
+	}
+
+	It("should place new objects on the fast tier", func() {
+		Expect(fs.Tiered()).To(BeTrue())
+		lom := put("obj", newData())
+		Expect(lom.Mountpath().Path).To(Equal(fastMpath))
+		Expect(lom.Tier()).To(Equal(fs.TierFast))
+		Expect(lom.IsHRW()).To(BeTrue())
+	})
+
+	It("should demote, find, and promote", func() {
+		data := newData()
+		lom := put("dir/obj", data)
+		fastFQN := lom.FQN
+		atime := lom.AtimeUnix()
+
+		// demote
+		mi := move(lom)
+		Expect(mi).NotTo(BeNil())
+		Expect(mi.Tier()).To(Equal(fs.TierCapacity))
+		Expect(cos.Stat(fastFQN)).To(HaveOccurred())
+
+		// lookup falls back to the capacity tier
+		lom = load("dir/obj")
+		Expect(lom.Mountpath()).To(Equal(mi))
+		Expect(lom.IsHRW()).To(BeTrue())
+		Expect(lom.Lsize()).To(BeEquivalentTo(objSize))
+		Expect(lom.AtimeUnix()).To(Equal(atime))
+		Expect(bytes.Equal(read(lom), data)).To(BeTrue())
+
+		// promote
+		mi = move(lom)
+		Expect(mi).NotTo(BeNil())
+		Expect(mi.Path).To(Equal(fastMpath))
+		lom = load("dir/obj")
+		Expect(lom.FQN).To(Equal(fastFQN))
+		Expect(bytes.Equal(read(lom), data)).To(BeTrue())
+	})
+
+	It("should keep a single version across tiers", func() {
+		data, data2 := newData(), newData()
+		lom := put("obj", data)
+		mi := move(lom)
+		capFQN := mi.MakePathFQN(&bck, fs.ObjectType, "obj")
+		Expect(cos.Stat(capFQN)).NotTo(HaveOccurred())
+
+		// overwrite goes to the fast tier and removes the demoted version
+		put("obj", data2)
+		Expect(cos.Stat(capFQN)).To(HaveOccurred())
+		lom = load("obj")
+		Expect(lom.Mountpath().Path).To(Equal(fastMpath))
+		Expect(bytes.Equal(read(lom), data2)).To(BeTrue())
+
+		// removal removes both
+		move(lom)
+		put("obj", data)
+		lom = load("obj")
+		lom.Lock(true)
+		Expect(lom.RemoveObj()).NotTo(HaveOccurred())
+		lom.Unlock(true)
+		Expect(cos.Stat(capFQN)).To(HaveOccurred())
+		Expect(cos.Stat(lom.FQN)).To(HaveOccurred())
+	})
+
+	It("should not promote a stale version", func() {
+		data, data2 := newData(), newData()
+		stale := put("obj", data)
+		move(stale)
+		capFQN := stale.FQN
+
+		// (simulating a racing write that has not removed the capacity-tier version)
+		fresh := &core.LOM{ObjName: "obj"}
+		Expect(fresh.InitBck(&bck)).NotTo(HaveOccurred())
+		write(fresh.FQN, data2)
+
+		stale.Lock(true)
+		mi, err := stale.MoveTier(buf)
+		stale.Unlock(true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mi).To(BeNil())
+		Expect(cos.Stat(capFQN)).To(HaveOccurred())
+		b, err := os.ReadFile(fresh.FQN)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data2)).To(BeTrue())
+	})
+})
@@ -0,0 +1,101 @@
+// Package core provides core metadata and in-cluster API
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core
+
+import (
+	"os"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// io_uring (config.Disk.IOEngine) =======================================
+//
+// Object reads (lom.Open) and writes (lom.Create, lom.CreateWork) of plain
+// files, as well as checksummed copies (copyFile - mirroring, tier migration),
+// go through the corresponding mountpath's ring (see fs/uring.go) - whether
+// done by user requests or xactions.
+// Chunked, packed, and compressed objects are read and written as before;
+// same goes for metadata (xattrs) and directory walks (mountpath joggers).
+// ======================================================================
+
+const uringHkIval = time.Minute
+
+// read and write via the mountpath's io_uring, if configured (see fs/uring.go)
+func (lom *LOM) uringRd(fh *os.File) cos.LomReader {
+	if f := lom.mi.UringFile(fh); f != nil {
+		return f
+	}
+	return fh
+}
+
+func (lom *LOM) uringWr(fh *os.File) cos.LomWriter {
+	if f := lom.mi.UringFile(fh); f != nil {
+		return f
+	}
+	return fh
+}
+
+// same as cos.CopyFile, with user-space copying (that is, checksumming) done via
+// the source and destination mountpaths' io_uring, if configured
+// - otherwise, cos.CopyFile, whereby the kernel copies (see copy_file_range(2))
+// - nil mountpath (e.g., destination outside) means regular syscalls
+func copyFile(src string, smi *fs.Mountpath, dst string, dmi *fs.Mountpath, buf []byte, cksumType string) (int64, *cos.CksumHash, error) {
+	if cksumType == cos.ChecksumNone || cksumType == "" || (!hasRing(smi) && !hasRing(dmi)) {
+		return cos.CopyFile(src, dst, buf, cksumType)
+	}
+	srcfh, err := os.Open(src)
+	if err != nil {
+		return 0, nil, err
+	}
+	dstfh, err := cos.CreateFile(dst)
+	if err != nil {
+		cos.Close(srcfh)
+		return 0, nil, err
+	}
+	var (
+		r cos.LomReader = srcfh
+		w cos.LomWriter = dstfh
+	)
+	if smi != nil {
+		if f := smi.UringFile(srcfh); f != nil {
+			r = f
+		}
+	}
+	if dmi != nil {
+		if f := dmi.UringFile(dstfh); f != nil {
+			w = f
+		}
+	}
+	written, cksum, err := cos.CopyAndChecksum(w, r, buf, cksumType)
+	cos.Close(srcfh)
+	if err == nil {
+		err = cos.FlushClose(dstfh)
+	} else {
+		cos.Close(dstfh)
+	}
+	if err != nil {
+		if nerr := cos.RemoveFile(dst); nerr != nil {
+			nlog.Errorln("nested err:", nerr)
+		}
+		return 0, nil, err
+	}
+	return written, cksum, nil
+}
+
+func hasRing(mi *fs.Mountpath) bool { return mi != nil && mi.Ring() != nil }
+
+// housekeeping: close idle rings when switched back to sync (see fs.Mountpath.Ring)
+func hkUring(int64) time.Duration {
+	if !cmn.GCO.Get().Disk.Uring() {
+		for _, mi := range fs.GetAvail() {
+			_ = mi.Ring()
+		}
+	}
+	return uringHkIval
+}
@@ -0,0 +1,197 @@
+// Package core_test provides tests for cluster package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package core_test
+
+import (
+	"archive/tar"
+	"bytes"
+	cryptorand "crypto/rand"
+	"io"
+	"os"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/archive"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/ios/uring"
+	"github.com/NVIDIA/aistore/memsys"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("io_uring", func() {
+	const (
+		tmpDir  = "/tmp/luring_test"
+		objSize = 3*cos.MiB + 17
+	)
+
+	var (
+		mpaths = []string{tmpDir + "/1", tmpDir + "/2"}
+		bck    = cmn.Bck{Name: "LURING_TEST", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		bmd    = mock.NewBaseBownerMock(
+			meta.NewBck(bck.Name, apc.AIS, cmn.NsGlobal, &cmn.Bprops{
+				Cksum: cmn.CksumConf{Type: cos.ChecksumOneXxh},
+				BID:   406,
+			}),
+		)
+		buf = make([]byte, 32*cos.KiB)
+	)
+
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}, true)
+
+	setEngine := func(engine string) {
+		config := cmn.GCO.BeginUpdate()
+		config.Disk.IOEngine = engine
+		cmn.GCO.CommitUpdate(config)
+	}
+
+	BeforeEach(func() {
+		if !uring.Supported() {
+			Skip("io_uring not supported")
+		}
+		for _, mpath := range mpaths {
+			_ = cos.CreateDir(mpath)
+			_, _ = fs.Add(mpath, "daeID")
+		}
+		setEngine(cmn.IOEngineUring)
+		_ = mock.NewTarget(bmd)
+		_ = fs.CreateBucket(&bck, false)
+	})
+
+	AfterEach(func() {
+		setEngine("")
+		for _, mpath := range mpaths {
+			_, _ = fs.Remove(mpath)
+		}
+		_ = os.RemoveAll(tmpDir)
+	})
+
+	newData := func() []byte {
+		data := make([]byte, objSize)
+		_, _ = cryptorand.Read(data)
+		return data
+	}
+
+	put := func(objName string, data []byte) *core.LOM {
+		lom := &core.LOM{ObjName: objName}
+		Expect(lom.InitBck(&bck)).NotTo(HaveOccurred())
+		lom.Lock(true)
+		defer lom.Unlock(true)
+
+		wfqn := fs.CSM.Gen(lom, fs.WorkfileType, "test")
+		w, err := lom.CreateWork(wfqn)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w).To(BeAssignableToTypeOf(&uring.File{}))
+		_, err = w.Write(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Sync()).NotTo(HaveOccurred())
+		Expect(w.Close()).NotTo(HaveOccurred())
+
+		cksum := cos.NewCksumHash(cos.ChecksumOneXxh)
+		cksum.H.Write(data)
+		cksum.Finalize()
+		lom.SetSize(int64(len(data)))
+		lom.SetCksum(cksum.Clone())
+		Expect(lom.RenameFinalize(wfqn)).NotTo(HaveOccurred())
+		Expect(lom.PersistMain()).NotTo(HaveOccurred())
+		return lom
+	}
+
+	read := func(lom *core.LOM) []byte {
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		fh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fh).To(BeAssignableToTypeOf(&uring.File{}))
+		b, err := io.ReadAll(fh)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+		return b
+	}
+
+	It("should write and read objects via the mountpath's ring", func() {
+		data := newData()
+		lom := put("obj", data)
+		Expect(bytes.Equal(read(lom), data)).To(BeTrue())
+	})
+
+	It("should read archived file from a shard without extension via the ring (GET with archpath)", func() {
+		var (
+			tbuf  bytes.Buffer
+			tw    = tar.NewWriter(&tbuf)
+			files = map[string][]byte{"a.txt": newData()[:1000], "dir/b.bin": newData()}
+		)
+		for name, b := range files {
+			Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(b))})).NotTo(HaveOccurred())
+			_, err := tw.Write(b)
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(tw.Close()).NotTo(HaveOccurred())
+		lom := put("shard-000001", tbuf.Bytes())
+
+		// same sequence as ais/tgtobj.go (getArch)
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		fh, err := lom.Open()
+		Expect(err).NotTo(HaveOccurred())
+		defer fh.Close()
+		Expect(fh).To(BeAssignableToTypeOf(&uring.File{}))
+
+		mime, err := archive.MimeFile(fh, memsys.PageMM(), "", lom.ObjName) // (by magic)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mime).To(Equal(archive.ExtTar))
+		ar, err := archive.NewReader(mime, fh, lom.Lsize())
+		Expect(err).NotTo(HaveOccurred())
+		csl, err := ar.ReadOne("dir/b.bin")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(csl).NotTo(BeNil())
+		b, err := io.ReadAll(csl)
+		Expect(err).NotTo(HaveOccurred())
+		csl.Close()
+		Expect(bytes.Equal(b, files["dir/b.bin"])).To(BeTrue())
+	})
+
+	It("should copy (and checksum) objects via the ring", func() {
+		data := newData()
+		lom := put("obj", data)
+		dst := tmpDir + "/whole"
+		lom.Lock(false)
+		_, cksum, err := lom.CopyWhole(dst, buf, cos.ChecksumOneXxh)
+		lom.Unlock(false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cksum.Equal(lom.Checksum())).To(BeTrue())
+
+		b, err := os.ReadFile(dst)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bytes.Equal(b, data)).To(BeTrue())
+	})
+
+	It("should use regular files when the engine is sync", func() {
+		data := newData()
+		lom := put("obj", data)
+		ring := lom.Mountpath().Ring()
+		Expect(ring).NotTo(BeNil())
+		setEngine(cmn.IOEngineSync)
+		lom.Lock(false)
+		fh, err := lom.Open()
+		lom.Unlock(false)
+		Expect(err).NotTo(HaveOccurred())
+		_, ok := fh.(*os.File)
+		Expect(ok).To(BeTrue())
+		Expect(fh.Close()).NotTo(HaveOccurred())
+
+		// switched back: new ring
+		setEngine(cmn.IOEngineUring)
+		again := lom.Mountpath().Ring()
+		Expect(again).NotTo(BeNil())
+		Expect(again).NotTo(BeIdenticalTo(ring))
+	})
+})
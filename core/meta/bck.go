@@ -207,11 +207,32 @@ func (b *Bck) init(bmd *BMD) error {
 // find an already existing bucket by name (and nothing else)
 // returns an error when name cannot be unambiguously resolved to a single bucket
 func InitByNameOnly(bckName string, bowner Bowner) (bck *Bck, ecode int, err error) {
+	return InitByNameNs(bckName, nil, bowner)
+}
+
+// same as above, with an optional namespace filter (nil: any namespace)
+func InitByNameNs(bckName string, ns *cmn.Ns, bowner Bowner) (bck *Bck, ecode int, err error) {
 	bmd := bowner.Get()
 	all := bmd.getAllByName(bckName)
+	if ns != nil {
+		n := 0
+		for i := range all {
+			if all[i].Ns == *ns {
+				all[n] = all[i]
+				n++
+			}
+		}
+		if all = all[:n]; n == 0 {
+			all = nil
+		}
+	}
 	switch {
 	case all == nil:
-		err = cmn.NewErrBckNotFound(&cmn.Bck{Name: bckName})
+		nbck := &cmn.Bck{Name: bckName}
+		if ns != nil {
+			nbck.Ns = *ns
+		}
+		err = cmn.NewErrBckNotFound(nbck)
 		ecode = http.StatusNotFound
 	case len(all) == 1:
 		bck = &all[0]
@@ -38,6 +38,22 @@ func (smap *Smap) HrwMultiHome(uname []byte) (si *Snode, netName string, err err
 	return si, si.nmr.name(), nil
 }
 
+// same as above but selects one of the top `count` HRW targets - in particular,
+// to spread reads across targets that store replicas (see mirror.placement)
+func (smap *Smap) HrwMultiHomeN(uname []byte, count int, rnd uint64) (si *Snode, netName string, err error) {
+	count = min(count, smap.CountActiveTs())
+	if count <= 1 {
+		return smap.HrwMultiHome(uname)
+	}
+	var sis Nodes
+	if sis, err = smap.HrwTargetList(cos.UnsafeSptr(uname), count); err != nil {
+		return smap.HrwMultiHome(uname)
+	}
+	si = sis[rnd%uint64(len(sis))]
+	debug.Assert(si.nmr != nil, si.StringEx(), " in ", smap.StringEx())
+	return si, si.nmr.name(), nil
+}
+
 func (smap *Smap) HrwHash2T(digest uint64) (si *Snode, err error) {
 	var maxH uint64
 	for _, tsi := range smap.Tmap {
@@ -0,0 +1,48 @@
+// Package meta_test: unit tests for the package
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package meta_test
+
+import (
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HRW", func() {
+	const numTargets, copies = 5, 3
+
+	smap := &meta.Smap{Tmap: make(meta.NodeMap, numTargets), Pmap: make(meta.NodeMap)}
+	for i := range numTargets {
+		tsi := &meta.Snode{}
+		tsi.Init("t"+strconv.Itoa(i), apc.Target)
+		tsi.InitNetNamer()
+		smap.Tmap[tsi.ID()] = tsi
+	}
+
+	It("should select one of the top HRW targets", func() {
+		for i := range 1000 {
+			uname := []byte("obj-" + strconv.Itoa(i))
+			owner, err := smap.HrwName2T(uname)
+			Expect(err).NotTo(HaveOccurred())
+			sis, err := smap.HrwTargetList(cos.UnsafeSptr(uname), copies)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sis[0].ID()).To(Equal(owner.ID()))
+
+			for rnd := range uint64(copies) {
+				tsi, _, err := smap.HrwMultiHomeN(uname, copies, rnd)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tsi.ID()).To(Equal(sis[rnd].ID()))
+			}
+			tsi, _, err := smap.HrwMultiHomeN(uname, 1, uint64(i))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tsi.ID()).To(Equal(owner.ID()))
+		}
+	})
+})
@@ -90,7 +90,7 @@ func (bd *DBDriver) List(collection, pattern string) ([]string, int, error) {
 		if strings.HasPrefix(k, filter) {
 			_, key := kvdb.ParsePath(k)
 			if key != "" {
-				keys = append(keys, k)
+				keys = append(keys, key)
 			}
 		}
 	}
@@ -106,7 +106,7 @@ func (bd *DBDriver) DeleteCollection(collection string) (int, error) {
 		return code, err
 	}
 	for _, k := range keys {
-		delete(bd.values, k)
+		delete(bd.values, bd.makePath(collection, k))
 	}
 	return http.StatusOK, nil
 }
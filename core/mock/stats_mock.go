@@ -29,6 +29,7 @@ func (*StatsTracker) Get(string) int64
 func (*StatsTracker) Inc(string)                                                {}
 func (*StatsTracker) IncWith(string, map[string]string)                         {}
 func (*StatsTracker) IncBck(string, *cmn.Bck)                                   {}
+func (*StatsTracker) AddBckTraffic(int, *cmn.Bck, string, int64)                {}
 func (*StatsTracker) Add(string, int64)                                         {}
 func (*StatsTracker) SetFlag(string, cos.NodeStateFlags)                        {}
 func (*StatsTracker) ClrFlag(string, cos.NodeStateFlags)                        {}
@@ -36,6 +37,7 @@ func (*StatsTracker) SetClrFlag(string, cos.NodeStateFlags, cos.NodeStateFlags)
 func (*StatsTracker) AddWith(...cos.NamedVal64)                                 {}
 func (*StatsTracker) RegExtMetric(*meta.Snode, string, string, *stats.Extra)    {}
 func (*StatsTracker) GetMetricNames() cos.StrKVs                                { return nil }
+func (*StatsTracker) GetBckTraffic() stats.BckTrafficList                       { return nil }
 func (*StatsTracker) GetStats() *stats.Node                                     { return nil }
 func (*StatsTracker) ResetStats(bool)                                           {}
 func (*StatsTracker) PromHandler() http.Handler                                 { return nil }
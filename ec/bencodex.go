@@ -5,6 +5,7 @@
 package ec
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sync"
@@ -353,7 +354,7 @@ func (j *rcvyJogger) run() {
 		l, c := len(j.workCh), cap(j.workCh)
 		j.chanFull.Check(l, c)
 
-		err := ECM.Recover(lom)
+		err := ECM.Recover(context.Background(), lom)
 		r.setLast(lom, err)
 		core.FreeLOM(lom)
 
@@ -137,6 +137,7 @@ type (
 
 		putTime time.Time // time when the object is put into main queue
 		tm      time.Time // to measure different steps
+		trace   string    // W3C trace context of the originating request, if any (see tracing.Traceparent)
 		IsCopy  bool      // replicate or use erasure coding
 		rebuild bool      // true - internal request to re-encode, e.g., from ec-encode xaction
 	}
@@ -178,6 +179,7 @@ type (
 		size     int64              // size of the data
 		obj      *slice             // internal info about SGL slice
 		metadata *Metadata          // object's metadata
+		trace    string             // (see transport.ObjHdr.Trace)
 		isSlice  bool               // is it slice or replica
 		reqType  intraReqType       // request's type, slice/meta request/response
 	}
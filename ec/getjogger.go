@@ -45,6 +45,7 @@ type (
 		nodes    map[string]*Metadata // EC metafiles downloaded from other targets
 		slices   []*slice             // slices downloaded from other targets
 		idToNode map[int]string       // existing sliceID <-> target
+		trace    string               // (see request.trace)
 		toDisk   bool                 // use memory or disk for temporary files
 	}
 )
@@ -76,6 +77,7 @@ func (c *getJogger) newCtx(req *request) (*restoreCtx, error) {
 	ctx := allocRestoreCtx()
 	ctx.toDisk = useDisk(0 /*size of the original object is unknown*/, c.parent.config)
 	ctx.lom = lom
+	ctx.trace = req.trace
 	err = lom.Load(false /*cache it*/, false /*locked*/)
 	if cos.IsNotExist(err) {
 		err = nil
@@ -209,6 +211,7 @@ func (c *getJogger) copyMissingReplicas(ctx *restoreCtx, reader cos.ReadOpenClos
 		reader:   srcReader,
 		size:     ctx.lom.Lsize(),
 		metadata: ctx.meta,
+		trace:    ctx.trace,
 		reqType:  reqPut,
 	}
 	return c.parent.writeRemote(daemons, ctx.lom, src, cb)
@@ -224,7 +227,7 @@ func (c *getJogger) restoreReplicaFromMem(ctx *restoreCtx) error {
 		iReqBuf := newIntraReq(reqGet, ctx.meta, ctx.lom.Bck()).NewPack(g.smm)
 
 		w := g.smm.NewSGL(cos.KiB)
-		if _, err := c.parent.readRemote(ctx.lom, node, uname, iReqBuf, w); err != nil {
+		if _, err := c.parent.readRemote(ctx.lom, node, uname, iReqBuf, w, ctx.trace); err != nil {
 			nlog.Errorf("%s failed to read from %s", core.T, node)
 			w.Free()
 			g.smm.Free(iReqBuf)
@@ -285,7 +288,7 @@ loop: //nolint:gocritic // keeping label for readability
 			break loop
 		}
 		iReqBuf := newIntraReq(reqGet, ctx.meta, ctx.lom.Bck()).NewPack(g.smm)
-		size, err = c.parent.readRemote(ctx.lom, node, uname, iReqBuf, wfh)
+		size, err = c.parent.readRemote(ctx.lom, node, uname, iReqBuf, wfh, ctx.trace)
 		g.smm.Free(iReqBuf)
 
 		if err == nil && size > 0 {
@@ -412,6 +415,7 @@ func (c *getJogger) requestSlices(ctx *restoreCtx) error {
 		ObjName: ctx.lom.ObjName,
 		Opaque:  request,
 		Opcode:  reqGet,
+		Trace:   ctx.trace,
 	}
 	hdr.Bck.Copy(ctx.lom.Bucket())
 
@@ -752,6 +756,7 @@ func (c *getJogger) uploadRestoredSlices(ctx *restoreCtx, slices []*slice) error
 			reader:   reader,
 			size:     sl.n,
 			metadata: sliceMeta,
+			trace:    ctx.trace,
 			isSlice:  true,
 			reqType:  reqPut,
 		}
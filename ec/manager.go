@@ -5,6 +5,7 @@
 package ec
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -20,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/nl"
+	"github.com/NVIDIA/aistore/tracing"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact"
@@ -327,7 +329,9 @@ func (mgr *Manager) BMDChanged() error {
 	return nil
 }
 
-func (mgr *Manager) Recover(lom *core.LOM) error {
+// restore the object from existing slices or replicas;
+// `ctx` carries the caller's trace context, if any
+func (mgr *Manager) Recover(ctx context.Context, lom *core.LOM) error {
 	if !lom.ECEnabled() {
 		return ErrorECDisabled
 	}
@@ -339,6 +343,7 @@ func (mgr *Manager) Recover(lom *core.LOM) error {
 	req := allocateReq(ActRestore, lom.LIF())
 	errCh := make(chan error) // unbuffered
 	req.ErrCh = errCh
+	req.trace = tracing.Traceparent(ctx)
 	xctn := mgr.RestoreBckGetXact(lom.Bck())
 	xctn.decode(req, lom)
 
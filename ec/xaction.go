@@ -170,7 +170,8 @@ func (r *xactECBase) dataResponse(act intraReqType, hdr *transport.ObjHdr, fqn s
 	}
 	debug.Assert((objAttrs.Size == 0 && reader == nil) || (objAttrs.Size != 0 && reader != nil))
 
-	rHdr := transport.ObjHdr{ObjName: objName, ObjAttrs: objAttrs, Opcode: act}
+	// (continue the requester's trace, if any)
+	rHdr := transport.ObjHdr{ObjName: objName, ObjAttrs: objAttrs, Opcode: act, Trace: hdr.Trace}
 	rHdr.Bck.Copy(bck.Bucket())
 	rHdr.Opaque = ireq.NewPack(g.smm)
 
@@ -234,8 +235,9 @@ func (r *xactECBase) sendByDaemonID(daemonIDs []string, o *transport.Obj, reader
 //     name, it puts the data to its writer and notifies when download is done
 //   - request - request to send
 //   - writer - an opened writer that will receive the replica/slice/meta
-func (r *xactECBase) readRemote(lom *core.LOM, daemonID, uname string, request []byte, writer io.Writer) (int64, error) {
-	hdr := transport.ObjHdr{ObjName: lom.ObjName, Opaque: request, Opcode: reqGet}
+//   - trace - trace context to propagate, if any
+func (r *xactECBase) readRemote(lom *core.LOM, daemonID, uname string, request []byte, writer io.Writer, trace string) (int64, error) {
+	hdr := transport.ObjHdr{ObjName: lom.ObjName, Opaque: request, Opcode: reqGet, Trace: trace}
 	hdr.Bck.Copy(lom.Bucket())
 
 	o := transport.AllocSend()
@@ -339,6 +341,7 @@ func (r *xactECBase) writeRemote(daemonIDs []string, lom *core.LOM, src *dataSou
 		ObjAttrs: objAttrs,
 		Opaque:   putData,
 		Opcode:   src.reqType,
+		Trace:    src.trace,
 	}
 	hdr.Bck.Copy(lom.Bucket())
 	oldCallback := cb
@@ -296,7 +296,7 @@ func (pc *pushComm) doRequest(lom *core.LOM, targs string, latestVer, sync bool,
 		}
 
 		// body = http.NoBody
-		path = url.PathEscape(lom.FQN)
+		path = url.PathEscape(lom.ContentFQN())
 	default:
 		e := fmt.Errorf("%s: unexpected argument type %q", pc.msg, pc.msg.ArgType())
 		debug.AssertNoErr(e) // is validated at construction time
@@ -396,7 +396,7 @@ func (rc *redirectComm) redirectArgs(lom *core.LOM, latestVer bool) (path string
 	case ArgTypeDefault, ArgTypeURL:
 		path = url.PathEscape(lom.Uname())
 	case ArgTypeFQN:
-		path = url.PathEscape(lom.FQN)
+		path = url.PathEscape(lom.ContentFQN())
 	default:
 		err := fmt.Errorf("%s: unexpected argument type %q", rc.msg, rc.msg.ArgType())
 		debug.AssertNoErr(err)
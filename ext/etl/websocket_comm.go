@@ -283,7 +283,7 @@ func (wss *wsSession) createTask(lom *core.LOM, latestVer, sync bool, woc io.Wri
 			cos.Close(woc)
 			return nil, ecode, err
 		}
-		task.ctrlmsg.FQN = url.PathEscape(lom.FQN)
+		task.ctrlmsg.FQN = url.PathEscape(lom.ContentFQN())
 	}
 
 	debug.IncCounter(task.txctn.ID() + "-task") // count for tasks in this session
@@ -38,6 +38,8 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+	ChunkType    = "ch"
+	PackType     = "pk"
 )
 
 type (
@@ -73,6 +75,8 @@ type (
 	WorkfileContentResolver struct{}
 	ECSliceContentResolver  struct{}
 	ECMetaContentResolver   struct{}
+	ChunkContentResolver    struct{}
+	PackContentResolver     struct{}
 )
 
 var CSM *contentSpecMgr
@@ -83,6 +87,8 @@ var (
 	_ ContentResolver = (*WorkfileContentResolver)(nil)
 	_ ContentResolver = (*ECSliceContentResolver)(nil)
 	_ ContentResolver = (*ECMetaContentResolver)(nil)
+	_ ContentResolver = (*ChunkContentResolver)(nil)
+	_ ContentResolver = (*PackContentResolver)(nil)
 )
 
 func (f *contentSpecMgr) Resolver(contentType string) ContentResolver {
@@ -217,3 +223,33 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+// chunk of a chunked object: "<base>.<chunk-set ID>.<chunk number>"
+// (the caller provides the two-part prefix, see core/lchunk.go)
+func (*ChunkContentResolver) GenUniqueFQN(base, prefix string) string {
+	return base + "." + prefix
+}
+
+func (*ChunkContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	i := strings.LastIndexByte(base, '.')
+	if i <= 0 {
+		return "", false, false
+	}
+	if _, err := strconv.ParseUint(base[i+1:], 10, 32); err != nil {
+		return "", false, false
+	}
+	j := strings.LastIndexByte(base[:i], '.')
+	if j <= 0 || j == i-1 {
+		return "", false, false
+	}
+	return base[:j], false, true
+}
+
+func (*PackContentResolver) GenUniqueFQN(base, _ string) string { return base }
+
+func (*PackContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	if _, err := strconv.ParseUint(base, 10, 32); err != nil {
+		return "", false, false
+	}
+	return base, false, true
+}
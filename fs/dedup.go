@@ -0,0 +1,322 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	iofs "io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// Content-addressed store of deduplicated objects (see core/ldedup.go):
+//
+//	<mountpath>/.$dedup/<checksum type>/<first 2 chars of checksum value>/<checksum value>
+//
+// - each blob holds the content shared by all objects (stubs) on the same mountpath
+// - blobs are immutable: writing a new version of a deduplicated object creates (or references) another blob
+// - the number of references is stored in the blob's xattr and gets updated under per-key lock
+// - the last reference removes the blob; blobs orphaned by crashes and bucket deletion
+//   are removed by space cleanup (see space/cleanup.go)
+
+const (
+	dedupRoot  = ".$dedup"
+	xattrRefs  = "user.ais.refs"
+	dedupLocks = 64
+)
+
+const (
+	dedupUnknown = iota
+	dedupInUse
+	dedupNotUsed
+)
+
+type dedupStore struct {
+	locks [dedupLocks]sync.Mutex
+	state atomic.Int32
+}
+
+var ErrDedupConflict = errors.New("dedup: content mismatch")
+
+// (checksum type, checksum value) => key
+func DedupKey(ty, val string) string { return ty + "/" + val }
+
+func dedupKeyOK(key string) bool {
+	i := strings.IndexByte(key, '/')
+	return i > 0 && len(key)-i > 2 && !strings.Contains(key[i+1:], "/") && !strings.Contains(key, "..")
+}
+
+func (mi *Mountpath) DedupRoot() string { return filepath.Join(mi.Path, dedupRoot) }
+
+func (mi *Mountpath) DedupFQN(key string) string {
+	i := strings.IndexByte(key, '/')
+	return filepath.Join(mi.Path, dedupRoot, key[:i], key[i+1:i+3], key[i+1:])
+}
+
+// whether this mountpath has (or ever had) deduplicated content
+func (mi *Mountpath) DedupInUse() bool {
+	switch mi.dedup.state.Load() {
+	case dedupInUse:
+		return true
+	case dedupNotUsed:
+		return false
+	}
+	if err := cos.Stat(mi.DedupRoot()); err == nil {
+		mi.dedup.state.Store(dedupInUse)
+		return true
+	}
+	mi.dedup.state.CAS(dedupUnknown, dedupNotUsed)
+	return mi.dedup.state.Load() == dedupInUse
+}
+
+func (mi *Mountpath) dedupLock(key string) *sync.Mutex {
+	return &mi.dedup.locks[onexxh.Checksum64S(cos.UnsafeB(key), cos.MLCG32)%dedupLocks]
+}
+
+// store the workfile's content under a given key, or reference existing identical content;
+// either way, the workfile is gone upon success
+// returns ErrDedupConflict when the existing content differs (checksum collision or damage)
+func (mi *Mountpath) DedupPut(key, wfqn string, size int64) (hit bool, _ error) {
+	if !dedupKeyOK(key) {
+		return false, fmt.Errorf("dedup: invalid key %q", key)
+	}
+	var (
+		fqn = mi.DedupFQN(key)
+		mu  = mi.dedupLock(key)
+	)
+	mi.dedup.state.Store(dedupInUse)
+	mu.Lock()
+	defer mu.Unlock()
+
+	finfo, err := os.Stat(fqn)
+	if err == nil {
+		if finfo.Size() != size {
+			return false, fmt.Errorf("%w (%q: %d vs %d)", ErrDedupConflict, key, finfo.Size(), size)
+		}
+		// never trust the key alone
+		if eq, err := sameContent(fqn, wfqn); err != nil || !eq {
+			if err == nil {
+				err = fmt.Errorf("%w (%q: same size, different bytes)", ErrDedupConflict, key)
+			}
+			return false, err
+		}
+		if err := incRefs(fqn, 1); err != nil {
+			return false, err
+		}
+		if err := cos.RemoveFile(wfqn); err != nil {
+			nlog.Warningln("dedup: failed to remove work file:", err)
+		}
+		return true, nil
+	}
+	if !cos.IsNotExist(err) {
+		return false, err
+	}
+	if err := setRefs(wfqn, 1); err != nil {
+		return false, err
+	}
+	if err := cos.CreateDir(filepath.Dir(fqn)); err != nil {
+		return false, err
+	}
+	now := time.Now()
+	if err := os.Chtimes(wfqn, now, now); err != nil {
+		return false, err
+	}
+	return false, os.Rename(wfqn, fqn)
+}
+
+// add reference to existing content (of a given size)
+func (mi *Mountpath) DedupIncRef(key string, size int64) error {
+	if !dedupKeyOK(key) {
+		return fmt.Errorf("dedup: invalid key %q", key)
+	}
+	var (
+		fqn = mi.DedupFQN(key)
+		mu  = mi.dedupLock(key)
+	)
+	mu.Lock()
+	defer mu.Unlock()
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return err
+	}
+	if finfo.Size() != size {
+		return fmt.Errorf("%w (%q: %d vs %d)", ErrDedupConflict, key, finfo.Size(), size)
+	}
+	return incRefs(fqn, 1)
+}
+
+// remove reference; the last one removes the content
+func (mi *Mountpath) DedupDecRef(key string) error {
+	if !dedupKeyOK(key) {
+		return fmt.Errorf("dedup: invalid key %q", key)
+	}
+	var (
+		fqn = mi.DedupFQN(key)
+		mu  = mi.dedupLock(key)
+	)
+	mu.Lock()
+	defer mu.Unlock()
+	refs, err := getRefs(fqn)
+	switch {
+	case err == nil && refs > 1:
+		return setRefs(fqn, refs-1)
+	case err == nil:
+		// last reference
+		return cos.RemoveFile(fqn)
+	case cos.IsNotExist(err):
+		return nil
+	default:
+		// unreadable refcount: keep the content that other objects may still reference
+		// (unreferenced content gets removed by space cleanup)
+		return err
+	}
+}
+
+// number of references (as stored) to the content
+func (mi *Mountpath) DedupRefs(key string) (uint64, error) {
+	if !dedupKeyOK(key) {
+		return 0, fmt.Errorf("dedup: invalid key %q", key)
+	}
+	return getRefs(mi.DedupFQN(key))
+}
+
+// walk the store: callback receives content key and file info
+func (mi *Mountpath) WalkDedup(cb func(key string, finfo iofs.FileInfo)) error {
+	root := mi.DedupRoot()
+	err := filepath.WalkDir(root, func(fqn string, de iofs.DirEntry, err error) error {
+		if err != nil {
+			if cos.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if de.IsDir() {
+			return nil
+		}
+		rel, _ := filepath.Rel(root, fqn)
+		parts := strings.Split(rel, string(filepath.Separator))
+		if len(parts) != 3 {
+			return nil
+		}
+		finfo, err := de.Info()
+		if err != nil {
+			return nil //nolint:nilerr // removed in the meantime
+		}
+		cb(DedupKey(parts[0], parts[2]), finfo)
+		return nil
+	})
+	if cos.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// remove content that was found unreferenced (see space cleanup),
+// unless it has been referenced (touched) after `since`
+func (mi *Mountpath) DedupRemoveUnref(key string, since time.Time) (size int64, _ error) {
+	var (
+		fqn = mi.DedupFQN(key)
+		mu  = mi.dedupLock(key)
+	)
+	mu.Lock()
+	defer mu.Unlock()
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		if cos.IsNotExist(err) {
+			err = nil
+		}
+		return 0, err
+	}
+	if !finfo.ModTime().Before(since) {
+		return 0, nil
+	}
+	return finfo.Size(), cos.RemoveFile(fqn)
+}
+
+// byte-wise comparison of the stored content with a new (same-size) workfile
+func sameContent(fqn, wfqn string) (bool, error) {
+	fh, err := os.Open(fqn)
+	if err != nil {
+		return false, err
+	}
+	defer fh.Close()
+	wfh, err := os.Open(wfqn)
+	if err != nil {
+		return false, err
+	}
+	defer wfh.Close()
+
+	var b1, b2 [32 * cos.KiB]byte
+	for {
+		n1, err1 := io.ReadFull(fh, b1[:])
+		n2, err2 := io.ReadFull(wfh, b2[:])
+		if n1 != n2 || !bytes.Equal(b1[:n1], b2[:n2]) {
+			return false, nil
+		}
+		switch {
+		case err1 == io.EOF || err1 == io.ErrUnexpectedEOF:
+			return err2 == io.EOF || err2 == io.ErrUnexpectedEOF, nil
+		case err1 != nil:
+			return false, err1
+		case err2 != nil:
+			if err2 == io.EOF || err2 == io.ErrUnexpectedEOF {
+				return false, nil
+			}
+			return false, err2
+		}
+	}
+}
+
+//
+// refcount (xattr)
+//
+
+func getRefs(fqn string) (uint64, error) {
+	var buf [cos.SizeofI64]byte
+	b, err := GetXattrBuf(fqn, xattrRefs, buf[:])
+	if err != nil {
+		return 0, err
+	}
+	if len(b) != cos.SizeofI64 {
+		return 0, fmt.Errorf("dedup: invalid refcount %q (len %d)", fqn, len(b))
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
+
+func setRefs(fqn string, refs uint64) error {
+	var buf [cos.SizeofI64]byte
+	binary.BigEndian.PutUint64(buf[:], refs)
+	return SetXattr(fqn, xattrRefs, buf[:])
+}
+
+// NOTE: also updates mtime - the time of the most recent reference (see DedupRemoveUnref)
+func incRefs(fqn string, n uint64) error {
+	refs, err := getRefs(fqn)
+	if err != nil {
+		if cos.IsNotExist(err) {
+			return err
+		}
+		nlog.Warningln(err, "- resetting")
+		refs = 1 // (best effort)
+	}
+	if err := setRefs(fqn, refs+n); err != nil {
+		return err
+	}
+	now := time.Now()
+	return os.Chtimes(fqn, now, now)
+}
@@ -0,0 +1,117 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestDedupStore(t *testing.T) {
+	var (
+		mpath = t.TempDir()
+		data  = []byte("deduplicated content")
+		key   = fs.DedupKey(cos.ChecksumOneXxh, "0123456789abcdef")
+		key2  = fs.DedupKey(cos.ChecksumOneXxh, "fedcba9876543210")
+	)
+	fs.TestNew(mock.NewIOS())
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	avail, _ := fs.Get()
+	mi := avail[mpath]
+	tassert.Errorf(t, !mi.DedupInUse(), "expected not in use")
+
+	wfile := func() string {
+		wfqn := filepath.Join(mpath, "work", cos.GenTie())
+		fh, err := cos.CreateFile(wfqn)
+		tassert.CheckFatal(t, err)
+		_, err = fh.Write(data)
+		tassert.CheckFatal(t, err)
+		tassert.CheckFatal(t, fh.Close())
+		return wfqn
+	}
+	checkRefs := func(key string, expected uint64) {
+		refs, err := mi.DedupRefs(key)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, refs == expected, "expected %d refs, got %d", expected, refs)
+	}
+
+	// store, then reference
+	for i := range 3 {
+		wfqn := wfile()
+		hit, err := mi.DedupPut(key, wfqn, int64(len(data)))
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, hit == (i > 0), "%d: unexpected hit=%t", i, hit)
+		tassert.Errorf(t, cos.Stat(wfqn) != nil, "%d: work file must be gone", i)
+		checkRefs(key, uint64(i+1))
+	}
+	tassert.Errorf(t, mi.DedupInUse(), "expected in use")
+	b, err := os.ReadFile(mi.DedupFQN(key))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(b) == string(data), "wrong content %q", b)
+
+	// size mismatch
+	_, err = mi.DedupPut(key, wfile(), int64(len(data))+1)
+	tassert.Fatalf(t, errors.Is(err, fs.ErrDedupConflict), "expected conflict, got %v", err)
+	err = mi.DedupIncRef(key, 1)
+	tassert.Fatalf(t, errors.Is(err, fs.ErrDedupConflict), "expected conflict, got %v", err)
+
+	// same size, different bytes (checksum collision)
+	wfqn := wfile()
+	tassert.CheckFatal(t, os.WriteFile(wfqn, []byte("DEDUPLICATED CONTENT"), cos.PermRWR))
+	_, err = mi.DedupPut(key, wfqn, int64(len(data)))
+	tassert.Fatalf(t, errors.Is(err, fs.ErrDedupConflict), "expected conflict, got %v", err)
+	tassert.Errorf(t, cos.Stat(wfqn) == nil, "work file must be kept upon conflict")
+	checkRefs(key, 3)
+
+	tassert.CheckFatal(t, mi.DedupIncRef(key, int64(len(data))))
+	checkRefs(key, 4)
+	for i := range 4 {
+		tassert.CheckFatal(t, mi.DedupDecRef(key))
+		if i < 3 {
+			checkRefs(key, uint64(3-i))
+		}
+	}
+	tassert.Errorf(t, cos.Stat(mi.DedupFQN(key)) != nil, "content must be removed with the last reference")
+	tassert.CheckFatal(t, mi.DedupDecRef(key)) // (no-op)
+
+	// unreadable refcount: keep the content
+	_, err = mi.DedupPut(key, wfile(), int64(len(data)))
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, fs.SetXattr(mi.DedupFQN(key), "user.ais.refs", []byte{1}))
+	tassert.Errorf(t, mi.DedupDecRef(key) != nil, "expected error on invalid refcount")
+	tassert.Errorf(t, cos.Stat(mi.DedupFQN(key)) == nil, "content must not be removed")
+	_, err = mi.DedupRemoveUnref(key, time.Now().Add(time.Second))
+	tassert.CheckFatal(t, err)
+
+	// walk and remove unreferenced
+	_, err = mi.DedupPut(key2, wfile(), int64(len(data)))
+	tassert.CheckFatal(t, err)
+	var keys []string
+	tassert.CheckFatal(t, mi.WalkDedup(func(key string, _ os.FileInfo) { keys = append(keys, key) }))
+	tassert.Fatalf(t, len(keys) == 1 && keys[0] == key2, "unexpected walk result %v", keys)
+
+	size, err := mi.DedupRemoveUnref(key2, time.Now().Add(-time.Hour))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, size == 0, "recently referenced content must not be removed")
+	size, err = mi.DedupRemoveUnref(key2, time.Now().Add(time.Second))
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, size == int64(len(data)), "expected removed size %d, got %d", len(data), size)
+	tassert.Errorf(t, cos.Stat(mi.DedupFQN(key2)) != nil, "content must be removed")
+
+	// invalid keys
+	for _, k := range []string{"", "xxhash", "xxhash/a", "xxhash/../../x", "/abc"} {
+		_, err := mi.DedupPut(k, wfile(), int64(len(data)))
+		tassert.Errorf(t, err != nil, "expected error for invalid key %q", k)
+	}
+}
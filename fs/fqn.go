@@ -201,6 +201,10 @@ func CleanPathErr(err error) {
 			what = "ec slice"
 		case ECMetaType:
 			what = "ec metadata"
+		case ChunkType:
+			what = "object chunk"
+		case PackType:
+			what = "pack file"
 		default:
 			what = fmt.Sprintf("content type '%s'(?)", parsed.ContentType)
 		}
@@ -66,6 +66,10 @@ type (
 		flags      uint64             // bit flags (set/get atomic)
 		PathDigest uint64             // (HRW logic)
 		capacity   Capacity
+		packs      sync.Map   // bucket uname => *Packs (see pack.go)
+		dedup      dedupStore // (see dedup.go)
+		ring       mpathRing  // (see uring.go)
+		mdx        mpathMDX   // (see mdx.go)
 	}
 	MPI map[string]*Mountpath
 
@@ -428,6 +432,9 @@ func (mi *Mountpath) _addEnabled(tid string, avail MPI, config *cmn.Config, bloc
 		}
 	}
 	mi._setDisks(fsdisks)
+	if !config.MDIndex.Enabled {
+		mi.purgeMDX()
+	}
 	_ = mi.String() // assign mi.info if not yet
 	avail[mi.Path] = mi
 	return nil
@@ -797,6 +804,8 @@ func Remove(mpath string, cb ...func()) (*Mountpath, error) {
 		delete(disabledCopy, cleanMpath)
 		delete(mfs.fsIDs, mi.FsID) // optional, benign
 		putDisabMPI(disabledCopy)
+		mi.closeRing()
+		mi.closeMDX(true /*discard*/)
 		return mi, nil
 	}
 	debug.Assert(cleanMpath == mi.Path)
@@ -822,6 +831,8 @@ func Remove(mpath string, cb ...func()) (*Mountpath, error) {
 	}
 	_moveMarkers(availableCopy, mi)
 	putAvailMPI(availableCopy)
+	mi.closeRing()
+	mi.closeMDX(true /*discard*/)
 	if availCnt > 0 && len(cb) > 0 {
 		cb[0]()
 	}
@@ -902,6 +913,8 @@ func Disable(mpath string, cb ...func()) (*Mountpath, error) {
 		delete(mfs.fsIDs, mi.FsID)
 		_moveMarkers(availableCopy, mi)
 		PutMPI(availableCopy, disabledCopy)
+		mi.closeRing()
+		mi.closeMDX(true /*discard*/)
 		if l := len(availableCopy); l == 0 {
 			nlog.Errorf("disabled the last available mountpath %s", mi)
 		} else {
@@ -1041,6 +1054,7 @@ func DestroyBucket(op string, bck *cmn.Bck, bid uint64) error {
 			}
 		}
 
+		mi.dropPacks(bck)
 		dir := mi.makeDelPathBck(bck)
 		if errMv := mi.MoveToDeleted(dir); errMv != nil {
 			nlog.Errorf("%s %q: failed to rm dir %q: %v", op, bck.String(), dir, errMv)
@@ -1062,6 +1076,8 @@ func RenameBucketDirs(bckFrom, bckTo *cmn.Bck) (err error) {
 	for _, mi := range avail {
 		fromPath := mi.makeDelPathBck(bckFrom)
 		toPath := mi.MakePathBck(bckTo)
+		mi.dropPacks(bckFrom)
+		mi.dropPacks(bckTo)
 
 		// remove destination bucket directory before renaming
 		// (the operation will fail otherwise)
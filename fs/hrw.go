@@ -16,16 +16,29 @@ import (
 // aka highest random weight (HRW)
 // See also: core/meta/hrw.go
 
+// NOTE: with tiered mountpaths, selects from the capacity tier (see tier.go)
 func Hrw(uname []byte) (mi *Mountpath, digest uint64, err error) {
+	return HrwTier(uname, TierCapacity)
+}
+
+// HRW within a given tier; TierNone (or no tiering) - across all available mountpaths
+func HrwTier(uname []byte, tier int) (mi *Mountpath, digest uint64, err error) {
 	var (
 		maxH  uint64
 		avail = GetAvail()
+		label = tierLabel(tier)
 	)
 	digest = onexxh.Checksum64S(uname, cos.MLCG32)
+	if label != "" && !hasTier(avail, label, tier) {
+		label = "" // (empty tier)
+	}
 	for _, mpathInfo := range avail {
 		if mpathInfo.IsAnySet(FlagWaitingDD) {
 			continue
 		}
+		if label != "" && (mpathInfo.Label == label) != (tier == TierFast) {
+			continue
+		}
 		cs := xoshiro256.Hash(mpathInfo.PathDigest ^ digest)
 		if cs >= maxH {
 			maxH = cs
@@ -0,0 +1,620 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// Persistent object metadata index (config.MDIndex): per-mountpath append-only log
+//
+//	<mountpath>/.ais.mdx/log
+//
+// Each record:
+//
+//	| magic(4) | flags(2) | name-len(2) | md-len(4) | cksum(4) | mtime(8) | size(8) | uname | md |
+//
+// - all header fields are big-endian; `cksum` (lower 32 bits of xxhash) protects uname and md
+// - `md` is opaque (packed LOM metadata - see core/lmdx.go); `mtime` and `size` are the ones of the
+//   object's file at the time of the update - lookups that don't match return nothing
+// - the last record for a given uname wins; deletion is a record with mdxFlDel set (and no md)
+// - updates are queued and written asynchronously (see Flush), while the in-memory index
+//   (uname => record) gets invalidated synchronously, so that it never returns stale metadata
+// - the log is loaded upon first access if and only if it was closed cleanly (see Close);
+//   otherwise, it is discarded and gets rebuilt lazily, as objects are loaded
+// - the in-memory index is bounded (config.MDIndex.MaxEntries): entries that were not accessed
+//   since the previous eviction go first (second chance); evicted objects' metadata gets loaded
+//   from xattrs (and re-added), while their records remain in the log until compaction
+// - disabling the index (at runtime or across restarts) discards it as well
+// - garbage (overwritten and deleted records) is reclaimed by Compact
+
+const (
+	mdxDir    = ".ais.mdx"
+	mdxLog    = "log"
+	mdxClean  = "clean" // marker: closed cleanly
+	mdxMagic  = 0x6169736d
+	mdxHdrLen = 32
+	mdxFlDel  = uint16(1)
+
+	mdxMaxQueued  = 16 * 1024   // flush sooner
+	mdxCompactMin = 4 * cos.MiB // don't compact smaller logs
+)
+
+type (
+	mdxEntry struct {
+		off   int64 // record offset
+		mtime int64
+		size  int64
+		mdlen uint32
+		nlen  uint16
+		ref   ratomic.Bool // accessed since the previous eviction
+	}
+	mdxUpdate struct {
+		md    []byte // nil: delete
+		size  int64  // object's file size and mtime
+		mtime int64
+	}
+	MDX struct {
+		mi     *Mountpath
+		idx    map[string]*mdxEntry
+		q      map[string]*mdxUpdate // queued
+		wip    map[string]*mdxUpdate // being written (see Add)
+		fh     *os.File
+		dir    string
+		size   int64        // log size
+		live   int64        // bytes in live records
+		mu     sync.RWMutex // protects idx, fh, size, live
+		qmu    sync.Mutex   // protects q, wip
+		wmu    sync.Mutex   // serializes writers: Flush, Compact, Close
+		closed bool
+	}
+	mpathMDX struct {
+		x   ratomic.Pointer[MDX]
+		mu  sync.Mutex
+		off ratomic.Bool // failed to open or closed at shutdown (see CloseMDX)
+	}
+)
+
+func (e *mdxEntry) reclen() int64 { return mdxHdrLen + int64(e.nlen) + int64(e.mdlen) }
+
+////////////////////////////
+// Mountpath => MD index //
+////////////////////////////
+
+// returns nil when disabled, failed to open, or closed
+func (mi *Mountpath) MDX() *MDX {
+	enabled := cmn.GCO.Get().MDIndex.Enabled
+	x := mi.mdx.x.Load()
+	switch {
+	case enabled && x != nil:
+		return x
+	case enabled:
+		if mi.mdx.off.Load() {
+			return nil
+		}
+		return mi.openMDX()
+	case x != nil:
+		// disabled at runtime
+		mi.closeMDX(true /*discard*/)
+	}
+	return nil
+}
+
+func (mi *Mountpath) openMDX() *MDX {
+	mi.mdx.mu.Lock()
+	defer mi.mdx.mu.Unlock()
+	if x := mi.mdx.x.Load(); x != nil {
+		return x
+	}
+	x := &MDX{mi: mi, dir: filepath.Join(mi.Path, mdxDir)}
+	if err := x.open(); err != nil {
+		nlog.Errorln(mi.String()+": failed to open metadata index: [", err, "]")
+		mi.mdx.off.Store(true)
+		return nil
+	}
+	mi.mdx.x.Store(x)
+	return x
+}
+
+// clean close (see Close) or discard
+func (mi *Mountpath) closeMDX(discard bool) {
+	mi.mdx.mu.Lock()
+	defer mi.mdx.mu.Unlock()
+	mi.mdx.off.Store(!discard)
+	x := mi.mdx.x.Swap(nil)
+	if x == nil {
+		if discard {
+			mi.purgeMDX()
+		}
+		return
+	}
+	if discard {
+		x.discard()
+		return
+	}
+	if err := x.Close(); err != nil {
+		nlog.Errorln(mi.String()+": failed to close metadata index: [", err, "]")
+	}
+}
+
+// at shutdown (no reopening)
+func (mi *Mountpath) CloseMDX() { mi.closeMDX(false) }
+
+// (not enabled upon startup: remove the index that may have been left behind by
+// a previous run; it does not reflect updates made in the meantime)
+func (mi *Mountpath) purgeMDX() {
+	if err := os.RemoveAll(filepath.Join(mi.Path, mdxDir)); err != nil {
+		nlog.Errorln(mi.String()+": failed to remove metadata index: [", err, "]")
+	}
+}
+
+/////////
+// MDX //
+/////////
+
+func (x *MDX) String() string { return x.mi.String() + "/mdx" }
+
+func (x *MDX) open() error {
+	var (
+		fqn   = filepath.Join(x.dir, mdxLog)
+		clean = filepath.Join(x.dir, mdxClean)
+	)
+	if err := cos.CreateDir(x.dir); err != nil {
+		return err
+	}
+	x.idx = make(map[string]*mdxEntry, 1024)
+	x.q = make(map[string]*mdxUpdate, 64)
+
+	// trust the log only if it was closed cleanly, and only once
+	err := os.Remove(clean)
+	switch {
+	case err == nil:
+		err = fsyncDir(x.dir)
+	case cos.IsNotExist(err):
+		err = cos.RemoveFile(fqn)
+	}
+	if err != nil {
+		return err
+	}
+
+	fh, err := os.OpenFile(fqn, os.O_CREATE|os.O_RDWR, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	x.fh = fh
+	if err := x.load(); err != nil {
+		cos.Close(fh)
+		return err
+	}
+	if n := len(x.idx); n > 0 {
+		nlog.Infoln(x.String(), "loaded", n, "entries [ log size:", cos.ToSizeIEC(x.size, 2), "]")
+	}
+	return nil
+}
+
+// read the log (sequentially) and build in-memory index;
+// truncate the tail at the first invalid (e.g., partially written) record
+func (x *MDX) load() error {
+	var (
+		br  = bufio.NewReaderSize(x.fh, 256*cos.KiB)
+		hdr [mdxHdrLen]byte
+		off int64
+	)
+	for {
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			if err != io.EOF {
+				nlog.Warningln(x.String(), "truncating at", off, "[", err, "]")
+			}
+			break
+		}
+		e := &mdxEntry{
+			off:   off,
+			nlen:  binary.BigEndian.Uint16(hdr[6:]),
+			mdlen: binary.BigEndian.Uint32(hdr[8:]),
+			mtime: int64(binary.BigEndian.Uint64(hdr[16:])),
+			size:  int64(binary.BigEndian.Uint64(hdr[24:])),
+		}
+		if binary.BigEndian.Uint32(hdr[:]) != mdxMagic || e.nlen == 0 {
+			nlog.Warningln(x.String(), "bad record at", off, "- truncating")
+			break
+		}
+		rec := make([]byte, int(e.nlen)+int(e.mdlen))
+		if _, err := io.ReadFull(br, rec); err != nil {
+			nlog.Warningln(x.String(), "truncating at", off, "[", err, "]")
+			break
+		}
+		if uint32(onexxh.Checksum64S(rec, cos.MLCG32)) != binary.BigEndian.Uint32(hdr[12:]) {
+			nlog.Warningln(x.String(), "record checksum mismatch at", off, "- truncating")
+			break
+		}
+		uname := string(rec[:e.nlen])
+		if prev, ok := x.idx[uname]; ok {
+			x.live -= prev.reclen()
+			delete(x.idx, uname)
+		}
+		if binary.BigEndian.Uint16(hdr[4:])&mdxFlDel == 0 {
+			x.idx[uname] = e
+			x.live += e.reclen()
+		}
+		off += e.reclen()
+	}
+	x.evict(cmn.GCO.Get().MDIndex.MaxEntriesX())
+	x.size = off
+	return x.fh.Truncate(off)
+}
+
+// returns the packed metadata of the object iff its file's size and mtime match
+func (x *MDX) Get(uname string, size, mtime int64) ([]byte, bool) {
+	x.mu.RLock()
+	e, ok := x.idx[uname]
+	if !ok || e.size != size || e.mtime != mtime || x.closed {
+		x.mu.RUnlock()
+		return nil, false
+	}
+	if !e.ref.Load() {
+		e.ref.Store(true)
+	}
+	buf := make([]byte, e.reclen())
+	_, err := x.fh.ReadAt(buf, e.off)
+	x.mu.RUnlock()
+	if err == nil {
+		err = e.check(buf, uname)
+	}
+	if err != nil {
+		nlog.Warningln(x.String(), uname, "[", err, "]")
+		return nil, false
+	}
+	return buf[mdxHdrLen+int(e.nlen):], true
+}
+
+func (e *mdxEntry) check(buf []byte, uname string) error {
+	if binary.BigEndian.Uint32(buf) != mdxMagic {
+		return fmt.Errorf("bad record magic at offset %d", e.off)
+	}
+	rec := buf[mdxHdrLen:]
+	if uint32(onexxh.Checksum64S(rec, cos.MLCG32)) != binary.BigEndian.Uint32(buf[12:]) {
+		return fmt.Errorf("record checksum mismatch at offset %d", e.off)
+	}
+	if n := string(rec[:e.nlen]); n != uname {
+		return fmt.Errorf("record name mismatch at offset %d: %q", e.off, n)
+	}
+	return nil
+}
+
+// update the object's metadata (that's just been written) - queue it while
+// invalidating the current entry, if any; the caller must hold the object's lock
+func (x *MDX) Put(uname, fqn string, md []byte) {
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		x.put(uname, &mdxUpdate{})
+		return
+	}
+	x.put(uname, &mdxUpdate{md: bytes.Clone(md), size: finfo.Size(), mtime: finfo.ModTime().UnixNano()})
+}
+
+// object's file is gone (no-op if not indexed - or evicted, in which case the record
+// that remains in the log won't match the size and mtime of a new file)
+func (x *MDX) Del(uname string) {
+	x.qmu.Lock()
+	_, ok := x.q[uname]
+	if !ok {
+		_, ok = x.wip[uname]
+	}
+	x.qmu.Unlock()
+	if !ok {
+		x.mu.RLock()
+		_, ok = x.idx[uname]
+		x.mu.RUnlock()
+	}
+	if ok {
+		x.put(uname, &mdxUpdate{})
+	}
+}
+
+func (x *MDX) put(uname string, u *mdxUpdate) {
+	x.qmu.Lock()
+	x.q[uname] = u
+	n := len(x.q)
+	x.mu.Lock()
+	if e, ok := x.idx[uname]; ok {
+		x.live -= e.reclen()
+		delete(x.idx, uname)
+	}
+	x.mu.Unlock()
+	x.qmu.Unlock()
+	if n == mdxMaxQueued {
+		go x.Flush()
+	}
+}
+
+// add the object's metadata (that's just been loaded from xattr, given its file's
+// size and mtime) unless already indexed or queued - the latter means there's
+// a more recent update
+func (x *MDX) Add(uname string, md []byte, size, mtime int64) {
+	x.qmu.Lock()
+	defer x.qmu.Unlock()
+	if _, ok := x.q[uname]; ok {
+		return
+	}
+	if _, ok := x.wip[uname]; ok {
+		return
+	}
+	x.mu.RLock()
+	_, ok := x.idx[uname]
+	x.mu.RUnlock()
+	if !ok {
+		x.q[uname] = &mdxUpdate{md: bytes.Clone(md), size: size, mtime: mtime}
+	}
+}
+
+func (x *MDX) Len() int {
+	x.mu.RLock()
+	n := len(x.idx)
+	x.mu.RUnlock()
+	return n
+}
+
+// write queued updates
+func (x *MDX) Flush() error {
+	x.wmu.Lock()
+	defer x.wmu.Unlock()
+	return x.flush()
+}
+
+// (under wmu; closed index is a no-op)
+func (x *MDX) flush() error {
+	x.mu.RLock()
+	closed, off := x.closed, x.size
+	x.mu.RUnlock()
+	if closed {
+		return nil
+	}
+
+	x.qmu.Lock()
+	wip := x.q
+	if len(wip) == 0 {
+		x.qmu.Unlock()
+		return nil
+	}
+	x.q, x.wip = make(map[string]*mdxUpdate, len(wip)), wip
+	x.qmu.Unlock()
+
+	// serialize
+	var (
+		buf     = make([]byte, 0, len(wip)*256)
+		entries = make(map[string]*mdxEntry, len(wip))
+	)
+	for uname, u := range wip {
+		var (
+			e   = &mdxEntry{off: off + int64(len(buf)), nlen: uint16(len(uname))}
+			fl  = mdxFlDel
+			md  []byte
+			hdr [mdxHdrLen]byte
+		)
+		if u.md != nil {
+			e.size, e.mtime, e.mdlen = u.size, u.mtime, uint32(len(u.md))
+			fl, md = 0, u.md
+		}
+		rec := make([]byte, 0, len(uname)+len(md))
+		rec = append(rec, uname...)
+		rec = append(rec, md...)
+
+		binary.BigEndian.PutUint32(hdr[:], mdxMagic)
+		binary.BigEndian.PutUint16(hdr[4:], fl)
+		binary.BigEndian.PutUint16(hdr[6:], e.nlen)
+		binary.BigEndian.PutUint32(hdr[8:], e.mdlen)
+		binary.BigEndian.PutUint32(hdr[12:], uint32(onexxh.Checksum64S(rec, cos.MLCG32)))
+		binary.BigEndian.PutUint64(hdr[16:], uint64(e.mtime))
+		binary.BigEndian.PutUint64(hdr[24:], uint64(e.size))
+		buf = append(buf, hdr[:]...)
+		buf = append(buf, rec...)
+		if fl == 0 {
+			entries[uname] = e
+		}
+	}
+	_, err := x.fh.WriteAt(buf, off)
+
+	// make it visible - unless superseded in the meantime
+	x.qmu.Lock()
+	x.mu.Lock()
+	if err == nil {
+		x.size += int64(len(buf))
+		for uname, e := range entries {
+			if _, ok := x.q[uname]; ok {
+				continue
+			}
+			if prev, ok := x.idx[uname]; ok {
+				x.live -= prev.reclen()
+			}
+			x.idx[uname] = e
+			x.live += e.reclen()
+		}
+		x.evict(cmn.GCO.Get().MDIndex.MaxEntriesX())
+	}
+	x.mu.Unlock()
+	x.wip = nil
+	x.qmu.Unlock()
+	return err
+}
+
+// (under lock) when over the limit, evict down to 15/16 of it: in the first pass,
+// entries accessed since the previous eviction are spared (and their bits cleared)
+func (x *MDX) evict(limit int) {
+	n := len(x.idx) - limit
+	if n <= 0 {
+		return
+	}
+	n += limit >> 4
+	for pass := 0; n > 0 && pass < 2; pass++ {
+		for uname, e := range x.idx {
+			if pass == 0 && e.ref.Load() {
+				e.ref.Store(false)
+				continue
+			}
+			x.live -= e.reclen()
+			delete(x.idx, uname)
+			if n--; n == 0 {
+				break
+			}
+		}
+	}
+}
+
+// whether the log has accumulated enough garbage
+func (x *MDX) NeedsCompaction() bool {
+	x.mu.RLock()
+	size, live := x.size, x.live
+	x.mu.RUnlock()
+	return size > mdxCompactMin && size > live<<1
+}
+
+// rewrite the log to contain only live records that the caller wants to keep
+// (e.g., records of the objects of the buckets that still exist)
+func (x *MDX) Compact(keep func(uname string) bool) (reclaimed int64, err error) {
+	x.wmu.Lock()
+	defer x.wmu.Unlock()
+
+	type ent struct {
+		e     *mdxEntry
+		uname string
+	}
+	x.mu.RLock()
+	if x.closed {
+		x.mu.RUnlock()
+		return 0, nil
+	}
+	var (
+		ents = make([]ent, 0, len(x.idx))
+		prev = x.size
+	)
+	for uname, e := range x.idx {
+		ents = append(ents, ent{e, uname})
+	}
+	x.mu.RUnlock()
+
+	var (
+		tmp  = filepath.Join(x.dir, mdxLog+".tmp")
+		offs = make([]int64, len(ents))
+		off  int64
+	)
+	wfh, err := os.OpenFile(tmp, os.O_CREATE|os.O_RDWR|os.O_TRUNC, cos.PermRWR)
+	if err != nil {
+		return 0, err
+	}
+	bw := bufio.NewWriterSize(wfh, 256*cos.KiB)
+	for i, en := range ents {
+		offs[i] = -1
+		if !keep(en.uname) {
+			continue
+		}
+		buf := make([]byte, en.e.reclen())
+		// (entries removed from idx in the meantime still have their records in the log)
+		if _, err = x.fh.ReadAt(buf, en.e.off); err != nil {
+			goto rerr
+		}
+		if _, err = bw.Write(buf); err != nil {
+			goto rerr
+		}
+		offs[i] = off
+		off += int64(len(buf))
+	}
+	if err = bw.Flush(); err != nil {
+		goto rerr
+	}
+	if err = wfh.Sync(); err != nil {
+		goto rerr
+	}
+
+	x.mu.Lock()
+	if err = os.Rename(tmp, filepath.Join(x.dir, mdxLog)); err != nil {
+		x.mu.Unlock()
+		goto rerr
+	}
+	cos.Close(x.fh)
+	x.fh, x.size, x.live = wfh, off, 0
+	for i, en := range ents {
+		if e, ok := x.idx[en.uname]; !ok || e != en.e {
+			continue // removed or updated in the meantime
+		}
+		if offs[i] < 0 {
+			delete(x.idx, en.uname)
+			continue
+		}
+		en.e.off = offs[i]
+	}
+	for _, e := range x.idx {
+		x.live += e.reclen()
+	}
+	x.mu.Unlock()
+	return prev - off, nil
+
+rerr:
+	cos.Close(wfh)
+	if nerr := cos.RemoveFile(tmp); nerr != nil {
+		nlog.Errorln("nested err:", nerr)
+	}
+	return 0, err
+}
+
+// write queued updates, sync, and mark the log clean (to be loaded upon restart)
+func (x *MDX) Close() error {
+	x.wmu.Lock()
+	defer x.wmu.Unlock()
+	err := x.flush()
+	x.mu.Lock()
+	x.closed = true
+	x.mu.Unlock()
+	if err == nil {
+		err = x.fh.Sync()
+	}
+	if erc := x.fh.Close(); err == nil {
+		err = erc
+	}
+	if err == nil {
+		var fh *os.File
+		if fh, err = os.Create(filepath.Join(x.dir, mdxClean)); err == nil {
+			err = fh.Close()
+		}
+	}
+	if err == nil {
+		err = fsyncDir(x.dir)
+	}
+	return err
+}
+
+func (x *MDX) discard() {
+	x.wmu.Lock()
+	x.mu.Lock()
+	x.closed = true
+	x.idx = map[string]*mdxEntry{}
+	cos.Close(x.fh)
+	x.mu.Unlock()
+	x.wmu.Unlock()
+	x.mi.purgeMDX()
+}
+
+func fsyncDir(dir string) error {
+	fh, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = fh.Sync()
+	cos.Close(fh)
+	return err
+}
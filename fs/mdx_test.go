@@ -0,0 +1,273 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func setMDIndex(enabled bool) {
+	config := cmn.GCO.BeginUpdate()
+	config.MDIndex.Enabled = enabled
+	cmn.GCO.CommitUpdate(config)
+}
+
+type mdxObj struct {
+	uname, fqn string
+	md         []byte
+	size       int64
+	mtime      int64
+}
+
+func newMdxObj(t *testing.T, dir string, i int) *mdxObj {
+	o := &mdxObj{
+		uname: fmt.Sprintf("@ais#mdx/obj%d", i),
+		fqn:   filepath.Join(dir, fmt.Sprintf("obj%d", i)),
+		md:    bytes.Repeat([]byte{byte('a' + i%26)}, 50+i),
+	}
+	tassert.CheckFatal(t, os.WriteFile(o.fqn, make([]byte, i), cos.PermRWR))
+	o.stat(t)
+	return o
+}
+
+func (o *mdxObj) stat(t *testing.T) {
+	finfo, err := os.Stat(o.fqn)
+	tassert.CheckFatal(t, err)
+	o.size, o.mtime = finfo.Size(), finfo.ModTime().UnixNano()
+}
+
+func (o *mdxObj) check(t *testing.T, x *fs.MDX, expected bool) {
+	md, ok := x.Get(o.uname, o.size, o.mtime)
+	tassert.Fatalf(t, ok == expected, "%s: expected found=%t, got %t", o.uname, expected, ok)
+	if ok {
+		tassert.Fatalf(t, bytes.Equal(md, o.md), "%s: wrong md %q", o.uname, md)
+	}
+}
+
+func initMdx(t *testing.T) (*fs.Mountpath, string) {
+	mpath := t.TempDir()
+	fs.TestNew(mock.NewIOS())
+	setMDIndex(true)
+	t.Cleanup(func() { setMDIndex(false) })
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	avail, _ := fs.Get()
+	return avail[mpath], t.TempDir()
+}
+
+// (restart)
+func readdMdx(t *testing.T, mi *fs.Mountpath) *fs.Mountpath {
+	fs.TestNew(mock.NewIOS())
+	_, err := fs.Add(mi.Path, "daeID")
+	tassert.CheckFatal(t, err)
+	avail, _ := fs.Get()
+	return avail[mi.Path]
+}
+
+func TestMDX(t *testing.T) {
+	const num = 100
+	mi, dir := initMdx(t)
+	x := mi.MDX()
+	tassert.Fatalf(t, x != nil, "expected metadata index")
+
+	objs := make([]*mdxObj, num)
+	for i := range num {
+		objs[i] = newMdxObj(t, dir, i)
+		x.Put(objs[i].uname, objs[i].fqn, objs[i].md)
+	}
+	// queued - not yet visible
+	objs[0].check(t, x, false)
+	tassert.CheckFatal(t, x.Flush())
+	tassert.Errorf(t, x.Len() == num, "expected %d entries, got %d", num, x.Len())
+	for _, o := range objs {
+		o.check(t, x, true)
+	}
+
+	// stale: size or mtime mismatch
+	o := objs[1]
+	_, ok := x.Get(o.uname, o.size+1, o.mtime)
+	tassert.Errorf(t, !ok, "expected size mismatch")
+	_, ok = x.Get(o.uname, o.size, o.mtime+1)
+	tassert.Errorf(t, !ok, "expected mtime mismatch")
+
+	// update invalidates immediately
+	o.md = []byte("updated")
+	tassert.CheckFatal(t, os.WriteFile(o.fqn, []byte("new content"), cos.PermRWR))
+	o.stat(t)
+	x.Put(o.uname, o.fqn, o.md)
+	o.check(t, x, false)
+	tassert.CheckFatal(t, x.Flush())
+	o.check(t, x, true)
+
+	// delete; add does not override
+	x.Del(objs[2].uname)
+	objs[2].check(t, x, false)
+	x.Add(objs[3].uname, []byte("older"), objs[3].size, objs[3].mtime)
+	tassert.CheckFatal(t, x.Flush())
+	objs[2].check(t, x, false)
+	objs[3].check(t, x, true)
+
+	// clean close => reopen with all entries
+	mi.CloseMDX()
+	tassert.Errorf(t, mi.MDX() == nil, "expected no reopening after close")
+	mi = readdMdx(t, mi)
+	x = mi.MDX()
+	tassert.Fatalf(t, x != nil, "expected metadata index")
+	tassert.Errorf(t, x.Len() == num-1, "expected %d entries, got %d", num-1, x.Len())
+	for i, o := range objs {
+		o.check(t, x, i != 2)
+	}
+
+	// unclean (no marker) => start over
+	mi.CloseMDX()
+	tassert.CheckFatal(t, os.Remove(filepath.Join(mi.Path, ".ais.mdx", "clean")))
+	mi = readdMdx(t, mi)
+	x = mi.MDX()
+	tassert.Fatalf(t, x != nil, "expected metadata index")
+	tassert.Errorf(t, x.Len() == 0, "expected empty index, got %d", x.Len())
+}
+
+func TestMDXTruncate(t *testing.T) {
+	const num = 10
+	mi, dir := initMdx(t)
+	x := mi.MDX()
+	objs := make([]*mdxObj, num)
+	for i := range num {
+		objs[i] = newMdxObj(t, dir, i)
+		x.Put(objs[i].uname, objs[i].fqn, objs[i].md)
+	}
+	mi.CloseMDX()
+
+	// corrupt the tail (e.g., partially written record)
+	fqn := filepath.Join(mi.Path, ".ais.mdx", "log")
+	b, err := os.ReadFile(fqn)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, os.WriteFile(fqn, b[:len(b)-3], cos.PermRWR))
+
+	mi = readdMdx(t, mi)
+	x = mi.MDX()
+	tassert.Errorf(t, x.Len() == num-1, "expected %d entries, got %d", num-1, x.Len())
+	var found int
+	for _, o := range objs {
+		if _, ok := x.Get(o.uname, o.size, o.mtime); ok {
+			found++
+		}
+	}
+	tassert.Errorf(t, found == num-1, "expected %d valid entries, got %d", num-1, found)
+}
+
+func TestMDXCompact(t *testing.T) {
+	const num = 10
+	mi, dir := initMdx(t)
+	x := mi.MDX()
+	objs := make([]*mdxObj, num)
+	for i := range num {
+		objs[i] = newMdxObj(t, dir, i)
+	}
+	// overwrite the same entries (over and over again) to accumulate garbage
+	md := bytes.Repeat([]byte{'x'}, 4*cos.KiB)
+	for !x.NeedsCompaction() {
+		for _, o := range objs {
+			x.Put(o.uname, o.fqn, md)
+		}
+		tassert.CheckFatal(t, x.Flush())
+	}
+	for _, o := range objs {
+		o.md = md
+	}
+	dropped := objs[0].uname
+	reclaimed, err := x.Compact(func(uname string) bool { return uname != dropped })
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, reclaimed > 2*cos.MiB, "expected to reclaim more than 2MiB, got %d", reclaimed)
+	tassert.Errorf(t, !x.NeedsCompaction(), "expected compacted")
+
+	check := func() {
+		for i, o := range objs {
+			o.check(t, x, i != 0)
+		}
+	}
+	check()
+	mi.CloseMDX()
+	mi = readdMdx(t, mi)
+	x = mi.MDX()
+	check()
+
+	// disabled at runtime => discarded
+	setMDIndex(false)
+	tassert.Errorf(t, mi.MDX() == nil, "expected nil when disabled")
+	_, err = os.Stat(filepath.Join(mi.Path, ".ais.mdx"))
+	tassert.Errorf(t, os.IsNotExist(err), "expected discarded index, err: %v", err)
+}
+
+func TestMDXEvict(t *testing.T) {
+	const (
+		limit = cmn.MinMDIndexMaxEntries
+		hot   = 100
+	)
+	mi, dir := initMdx(t)
+	config := cmn.GCO.BeginUpdate()
+	config.MDIndex.MaxEntries = limit
+	cmn.GCO.CommitUpdate(config)
+	t.Cleanup(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.MDIndex.MaxEntries = 0
+		cmn.GCO.CommitUpdate(config)
+	})
+
+	x := mi.MDX()
+	objs := make([]*mdxObj, 2*limit)
+	for i := range objs {
+		objs[i] = newMdxObj(t, dir, i)
+	}
+	for _, o := range objs[:limit] {
+		x.Put(o.uname, o.fqn, o.md)
+	}
+	tassert.CheckFatal(t, x.Flush())
+	tassert.Fatalf(t, x.Len() == limit, "expected %d entries, got %d", limit, x.Len())
+
+	// accessed entries survive eviction
+	for _, o := range objs[:hot] {
+		o.check(t, x, true)
+	}
+	for _, o := range objs[limit:] {
+		x.Put(o.uname, o.fqn, o.md)
+	}
+	tassert.CheckFatal(t, x.Flush())
+	n := limit - limit>>4
+	tassert.Fatalf(t, x.Len() == n, "expected %d entries upon eviction, got %d", n, x.Len())
+	for _, o := range objs[:hot] {
+		o.check(t, x, true)
+	}
+
+	// evicted: not found, can be re-added
+	var evicted *mdxObj
+	for _, o := range objs[hot:] {
+		if _, ok := x.Get(o.uname, o.size, o.mtime); !ok {
+			evicted = o
+			break
+		}
+	}
+	tassert.Fatalf(t, evicted != nil, "expected evicted entries")
+	x.Add(evicted.uname, evicted.md, evicted.size, evicted.mtime)
+	tassert.CheckFatal(t, x.Flush())
+	evicted.check(t, x, true)
+
+	// restart: the log (that still contains evicted records) is loaded within the limit
+	mi.CloseMDX()
+	mi = readdMdx(t, mi)
+	x = mi.MDX()
+	tassert.Fatalf(t, x != nil, "expected metadata index")
+	tassert.Errorf(t, x.Len() > 0 && x.Len() <= limit, "expected (0, %d] entries, got %d", limit, x.Len())
+}
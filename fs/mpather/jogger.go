@@ -63,6 +63,8 @@ type (
 
 	// jogger is being run on each mountpath and executes fs.Walk which call
 	// provided callback.
+	// (callbacks that read and write objects via LOM - e.g., lom.Open, lom.Copy -
+	// do so via the mountpath's io_uring, if configured - see fs/uring.go)
 	jogger struct {
 		ctx       context.Context
 		opts      *JgroupOpts
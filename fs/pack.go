@@ -0,0 +1,601 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Small-object packing: objects below configured size threshold get appended
+// into per-(mountpath, bucket) pack files: <mountpath>/@<provider>/[#ns/]<bucket>/%pk/<number>
+//
+// Each pack file is a sequence of records:
+//
+//	| magic(4) | flags(2) | name-len(2) | md-len(4) | reserved(4) | atime(8) | size(8) | name | md | data |
+//
+// - all header fields are big-endian
+// - `md` is opaque (packed LOM metadata - see core/lpack.go)
+// - deleting (or overwriting) marks the record dead in place; the space is reclaimed by `Compact`
+// - in-memory index (name => record) gets rebuilt from the pack files upon first access
+// - the last ("current") pack is the only one being appended to; all other packs are sealed
+
+const (
+	packMagic  = 0x61697370 // "aisp"
+	packHdrLen = 32
+	packFlDead = uint16(1)
+
+	packNumFmt = "%010d"
+)
+
+type (
+	PackEntry struct {
+		Atime int64 // (persisted in the record header, updated in place)
+		Size  int64 // object size
+		off   int64 // record offset
+		mdlen uint32
+		nlen  uint16
+		num   uint32 // pack number
+	}
+	packf struct {
+		size int64 // (total bytes)
+		dead int64 // (bytes in dead records and corrupted tail)
+	}
+	Packs struct {
+		mi         *Mountpath
+		idx        map[string]*PackEntry
+		packs      map[uint32]*packf
+		wfh        *os.File // current pack
+		bck        cmn.Bck
+		dir        string
+		mu         sync.RWMutex // protects idx and packs
+		wmu        sync.Mutex   // serializes writers: append, delete, update-in-place, compaction
+		once       sync.Once
+		wnum       uint32
+		compacting atomic.Bool
+	}
+)
+
+///////////////
+// PackEntry //
+///////////////
+
+func (e *PackEntry) reclen() int64  { return packHdrLen + int64(e.nlen) + int64(e.mdlen) + e.Size }
+func (e *PackEntry) DataOff() int64 { return e.off + packHdrLen + int64(e.nlen) + int64(e.mdlen) }
+
+// read and validate record header, return packed metadata
+func (e *PackEntry) ReadMd(fh *os.File, name string) ([]byte, error) {
+	buf := make([]byte, packHdrLen+int(e.nlen)+int(e.mdlen))
+	if _, err := fh.ReadAt(buf, e.off); err != nil {
+		return nil, err
+	}
+	if binary.BigEndian.Uint32(buf) != packMagic {
+		return nil, fmt.Errorf("%s: bad pack record magic at offset %d", fh.Name(), e.off)
+	}
+	if binary.BigEndian.Uint16(buf[4:])&packFlDead != 0 {
+		return nil, &os.PathError{Op: "read-pack", Path: name, Err: os.ErrNotExist}
+	}
+	if n := string(buf[packHdrLen : packHdrLen+int(e.nlen)]); n != name {
+		return nil, fmt.Errorf("%s: pack record name mismatch at offset %d: %q vs %q", fh.Name(), e.off, n, name)
+	}
+	return buf[packHdrLen+int(e.nlen):], nil
+}
+
+//////////////////////////////
+// Mountpath => bucket packs //
+//////////////////////////////
+
+// returns (and, upon first access, loads) packs of a given bucket on this mountpath
+func (mi *Mountpath) Packs(bck *cmn.Bck) *Packs {
+	uname := cos.UnsafeS(bck.MakeUname(""))
+	v, ok := mi.packs.Load(uname)
+	if !ok {
+		p := &Packs{mi: mi, bck: *bck, dir: mi.MakePathCT(bck, PackType)}
+		v, _ = mi.packs.LoadOrStore(uname, p)
+	}
+	p := v.(*Packs)
+	p.once.Do(p.load)
+	return p
+}
+
+func (mi *Mountpath) RangePacks(f func(p *Packs)) {
+	mi.packs.Range(func(_, v any) bool {
+		f(v.(*Packs))
+		return true
+	})
+}
+
+func (mi *Mountpath) dropPacks(bck *cmn.Bck) {
+	v, ok := mi.packs.LoadAndDelete(cos.UnsafeS(bck.MakeUname("")))
+	if !ok {
+		return
+	}
+	p := v.(*Packs)
+	p.wmu.Lock()
+	if p.wfh != nil {
+		cos.Close(p.wfh)
+		p.wfh = nil
+	}
+	p.wmu.Unlock()
+}
+
+///////////
+// Packs //
+///////////
+
+func (p *Packs) Bck() *cmn.Bck         { return &p.bck }
+func (p *Packs) Mountpath() *Mountpath { return p.mi }
+func (p *Packs) String() string        { return "packs[" + p.mi.String() + ", " + p.bck.String() + "]" }
+
+func (p *Packs) fqn(num uint32) string { return filepath.Join(p.dir, fmt.Sprintf(packNumFmt, num)) }
+
+func (p *Packs) load() {
+	p.idx = make(map[string]*PackEntry, 64)
+	p.packs = make(map[uint32]*packf, 4)
+	p.wnum = 1
+
+	dents, err := os.ReadDir(p.dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln(p.String(), "failed to read:", err)
+		}
+		return
+	}
+	nums := make([]uint32, 0, len(dents))
+	for _, de := range dents {
+		if de.IsDir() {
+			continue
+		}
+		num, err := strconv.ParseUint(de.Name(), 10, 32)
+		if err != nil || num == 0 {
+			continue
+		}
+		nums = append(nums, uint32(num))
+	}
+	if len(nums) == 0 {
+		return
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+	for _, num := range nums {
+		p.wnum = num
+		if sealed := p.loadPack(num); sealed {
+			p.wnum = num + 1
+		}
+	}
+}
+
+// returns true if the pack must be sealed (corrupted tail)
+func (p *Packs) loadPack(num uint32) (sealed bool) {
+	fqn := p.fqn(num)
+	fh, err := os.Open(fqn)
+	if err != nil {
+		nlog.Errorln(p.String(), "failed to open:", err)
+		return true
+	}
+	defer fh.Close()
+	var (
+		pk  = &packf{}
+		br  = bufio.NewReaderSize(fh, 64*cos.KiB)
+		hdr = make([]byte, packHdrLen)
+	)
+	p.packs[num] = pk
+	for {
+		if _, err = io.ReadFull(br, hdr); err != nil {
+			break
+		}
+		if binary.BigEndian.Uint32(hdr) != packMagic {
+			err = errors.New("bad magic")
+			break
+		}
+		e := &PackEntry{
+			off:   pk.size,
+			num:   num,
+			nlen:  binary.BigEndian.Uint16(hdr[6:]),
+			mdlen: binary.BigEndian.Uint32(hdr[8:]),
+			Atime: int64(binary.BigEndian.Uint64(hdr[16:])),
+			Size:  int64(binary.BigEndian.Uint64(hdr[24:])),
+		}
+		name := make([]byte, e.nlen)
+		if _, err = io.ReadFull(br, name); err != nil {
+			break
+		}
+		if _, err = br.Discard(int(e.mdlen) + int(e.Size)); err != nil {
+			break
+		}
+		pk.size += e.reclen()
+		if binary.BigEndian.Uint16(hdr[4:])&packFlDead != 0 {
+			pk.dead += e.reclen()
+			continue
+		}
+		// (the most recent wins)
+		if prev, ok := p.idx[string(name)]; ok {
+			p.packs[prev.num].dead += prev.reclen()
+		}
+		p.idx[string(name)] = e
+	}
+	if err == io.EOF {
+		return false
+	}
+	// corrupted (e.g., partially written) tail
+	nlog.Warningln(p.String(), "sealing", fqn, "with corrupted tail at offset", pk.size, "[", err, "]")
+	if finfo, errS := fh.Stat(); errS == nil {
+		pk.dead += finfo.Size() - pk.size
+		pk.size = finfo.Size()
+	}
+	return true
+}
+
+func (p *Packs) Has(name string) bool {
+	p.mu.RLock()
+	_, ok := p.idx[name]
+	p.mu.RUnlock()
+	return ok
+}
+
+func (p *Packs) Get(name string) (PackEntry, bool) {
+	p.mu.RLock()
+	e, ok := p.idx[name]
+	p.mu.RUnlock()
+	if !ok {
+		return PackEntry{}, false
+	}
+	return *e, true
+}
+
+// opens the containing pack file and returns it along with a copy of the index entry
+// (the returned file handle remains valid even if the pack gets compacted away)
+func (p *Packs) Open(name string) (*os.File, PackEntry, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	e, ok := p.idx[name]
+	if !ok {
+		return nil, PackEntry{}, &os.PathError{Op: "open-pack", Path: name, Err: os.ErrNotExist}
+	}
+	fh, err := os.Open(p.fqn(e.num))
+	return fh, *e, err
+}
+
+// returns names of packed objects that have a given prefix (unordered)
+func (p *Packs) Names(prefix string) []string {
+	p.mu.RLock()
+	names := make([]string, 0, len(p.idx))
+	for name := range p.idx {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	p.mu.RUnlock()
+	return names
+}
+
+func (p *Packs) Len() int {
+	p.mu.RLock()
+	l := len(p.idx)
+	p.mu.RUnlock()
+	return l
+}
+
+// (total, dead) bytes in sealed packs
+func (p *Packs) Usage() (size, dead int64) {
+	p.mu.RLock()
+	for num, pk := range p.packs {
+		if num != p.wnum {
+			size += pk.size
+			dead += pk.dead
+		}
+	}
+	p.mu.RUnlock()
+	return size, dead
+}
+
+// append new record; kill the previous one (if exists)
+func (p *Packs) Put(name string, md, data []byte, atime, packSize int64, fsync bool) error {
+	p.wmu.Lock()
+	err := p._put(name, md, data, atime, packSize, fsync)
+	p.wmu.Unlock()
+	return err
+}
+
+func (p *Packs) _put(name string, md, data []byte, atime, packSize int64, fsync bool) error {
+	debug.Assert(len(name) > 0 && len(name) <= 0xffff)
+	if err := p._wopen(packSize); err != nil {
+		return err
+	}
+	var (
+		pk  = p.packs[p.wnum]
+		buf = make([]byte, packHdrLen+len(name)+len(md)+len(data))
+		e   = &PackEntry{
+			Atime: atime,
+			Size:  int64(len(data)),
+			off:   pk.size,
+			mdlen: uint32(len(md)),
+			nlen:  uint16(len(name)),
+			num:   p.wnum,
+		}
+	)
+	binary.BigEndian.PutUint32(buf, packMagic)
+	binary.BigEndian.PutUint16(buf[6:], e.nlen)
+	binary.BigEndian.PutUint32(buf[8:], e.mdlen)
+	binary.BigEndian.PutUint64(buf[16:], uint64(atime))
+	binary.BigEndian.PutUint64(buf[24:], uint64(e.Size))
+	n := copy(buf[packHdrLen:], name)
+	n += copy(buf[packHdrLen+n:], md)
+	copy(buf[packHdrLen+n:], data)
+
+	if _, err := p.wfh.WriteAt(buf, e.off); err != nil {
+		p._seal()
+		return err
+	}
+	if fsync {
+		if err := p.wfh.Sync(); err != nil {
+			p._seal()
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	prev := p.idx[name]
+	p.idx[name] = e
+	pk.size += e.reclen()
+	p.mu.Unlock()
+	if prev != nil {
+		p._kill(prev)
+	}
+	return nil
+}
+
+// make sure the current pack is open and has room
+func (p *Packs) _wopen(packSize int64) error {
+	if p.wfh != nil {
+		if p.packs[p.wnum].size < packSize {
+			return nil
+		}
+		p._seal()
+	}
+	fqn := p.fqn(p.wnum)
+	fh, err := os.OpenFile(fqn, os.O_WRONLY|os.O_CREATE, cos.PermRWR)
+	if err != nil && os.IsNotExist(err) {
+		if err = cos.CreateDir(p.dir); err == nil {
+			fh, err = os.OpenFile(fqn, os.O_WRONLY|os.O_CREATE, cos.PermRWR)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	p.wfh = fh
+	p.mu.Lock()
+	if _, ok := p.packs[p.wnum]; !ok {
+		p.packs[p.wnum] = &packf{}
+	}
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *Packs) _seal() {
+	if p.wfh != nil {
+		cos.Close(p.wfh)
+		p.wfh = nil
+	}
+	p.mu.Lock()
+	p.wnum++
+	p.mu.Unlock()
+}
+
+// mark dead in place (note: not syncing - a resurrected record gets killed again by a subsequent load)
+func (p *Packs) _kill(e *PackEntry) {
+	var flags [2]byte
+	binary.BigEndian.PutUint16(flags[:], packFlDead)
+	if err := p._pwrite(e.num, flags[:], e.off+4); err != nil {
+		nlog.Errorln(p.String(), "failed to mark dead:", err)
+	}
+	p.mu.Lock()
+	if pk, ok := p.packs[e.num]; ok {
+		pk.dead += e.reclen()
+	}
+	p.mu.Unlock()
+}
+
+func (p *Packs) _pwrite(num uint32, b []byte, off int64) error {
+	if num == p.wnum && p.wfh != nil {
+		_, err := p.wfh.WriteAt(b, off)
+		return err
+	}
+	fh, err := os.OpenFile(p.fqn(num), os.O_WRONLY, cos.PermRWR)
+	if err != nil {
+		return err
+	}
+	_, err = fh.WriteAt(b, off)
+	cos.Close(fh)
+	return err
+}
+
+func (p *Packs) Del(name string) error {
+	p.wmu.Lock()
+	defer p.wmu.Unlock()
+	p.mu.Lock()
+	e, ok := p.idx[name]
+	delete(p.idx, name)
+	p.mu.Unlock()
+	if !ok {
+		return &os.PathError{Op: "del-pack", Path: name, Err: os.ErrNotExist}
+	}
+	p._kill(e)
+	return nil
+}
+
+// update access time in place
+func (p *Packs) SetAtime(name string, atime int64) error {
+	p.wmu.Lock()
+	defer p.wmu.Unlock()
+	p.mu.Lock()
+	e, ok := p.idx[name]
+	if ok {
+		e.Atime = atime
+	}
+	p.mu.Unlock()
+	if !ok {
+		return &os.PathError{Op: "set-pack-atime", Path: name, Err: os.ErrNotExist}
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(atime))
+	return p._pwrite(e.num, b[:], e.off+16)
+}
+
+// update metadata: in place if the size doesn't change, otherwise re-append the entire record
+func (p *Packs) SetMd(name string, md []byte, packSize int64, fsync bool) error {
+	p.wmu.Lock()
+	defer p.wmu.Unlock()
+	p.mu.RLock()
+	e, ok := p.idx[name]
+	var ecopy PackEntry
+	if ok {
+		ecopy = *e
+	}
+	p.mu.RUnlock()
+	if !ok {
+		return &os.PathError{Op: "set-pack-md", Path: name, Err: os.ErrNotExist}
+	}
+	if int(ecopy.mdlen) == len(md) {
+		return p._pwrite(ecopy.num, md, ecopy.off+packHdrLen+int64(ecopy.nlen))
+	}
+	data, err := p._readData(&ecopy)
+	if err != nil {
+		return err
+	}
+	return p._put(name, md, data, ecopy.Atime, packSize, fsync)
+}
+
+func (p *Packs) _readData(e *PackEntry) ([]byte, error) {
+	fh, err := os.Open(p.fqn(e.num))
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, e.Size)
+	_, err = fh.ReadAt(data, e.DataOff())
+	cos.Close(fh)
+	return data, err
+}
+
+// Compact rewrites live records of the sealed packs that have at least `pct` percent
+// dead space (or any dead space when pct <= 0) and removes those packs.
+// Concurrent readers holding open pack file handles are not affected.
+func (p *Packs) Compact(pct int, packSize int64) (moved int, reclaimed int64, err error) {
+	if !p.compacting.CAS(false, true) {
+		return 0, 0, nil
+	}
+	defer p.compacting.Store(false)
+
+	var nums []uint32
+	p.mu.RLock()
+	for num, pk := range p.packs {
+		if num == p.wnum || pk.dead == 0 {
+			continue
+		}
+		if pct <= 0 || pk.dead*100 >= pk.size*int64(pct) {
+			nums = append(nums, num)
+		}
+	}
+	p.mu.RUnlock()
+
+	for _, num := range nums {
+		var (
+			n    int
+			size int64
+		)
+		if n, size, err = p.compactPack(num, packSize); err != nil {
+			return moved, reclaimed, err
+		}
+		moved += n
+		reclaimed += size
+	}
+	return moved, reclaimed, nil
+}
+
+func (p *Packs) compactPack(num uint32, packSize int64) (moved int, reclaimed int64, _ error) {
+	var (
+		names []string
+		live  int64
+	)
+	p.mu.RLock()
+	for name, e := range p.idx {
+		if e.num == num {
+			names = append(names, name)
+		}
+	}
+	p.mu.RUnlock()
+
+	src, err := os.Open(p.fqn(num))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer src.Close()
+
+	// one record at a time (so as not to block writers for too long)
+	for _, name := range names {
+		p.wmu.Lock()
+		p.mu.RLock()
+		e, ok := p.idx[name]
+		var ecopy PackEntry
+		if ok {
+			ecopy = *e
+		}
+		p.mu.RUnlock()
+		if !ok || ecopy.num != num {
+			p.wmu.Unlock()
+			continue // deleted or overwritten in the meantime
+		}
+		buf := make([]byte, ecopy.reclen())
+		if _, err := src.ReadAt(buf, ecopy.off); err != nil {
+			p.wmu.Unlock()
+			return moved, reclaimed, err
+		}
+		md := buf[packHdrLen+int(ecopy.nlen) : packHdrLen+int(ecopy.nlen)+int(ecopy.mdlen)]
+		data := buf[packHdrLen+int(ecopy.nlen)+int(ecopy.mdlen):]
+		err := p._put(name, md, data, ecopy.Atime, packSize, false /*fsync*/)
+		p.wmu.Unlock()
+		if err != nil {
+			return moved, reclaimed, err
+		}
+		moved++
+		live += ecopy.reclen()
+	}
+
+	p.wmu.Lock()
+	defer p.wmu.Unlock()
+	if p.wfh != nil {
+		if err := p.wfh.Sync(); err != nil {
+			return moved, reclaimed, err
+		}
+	}
+	p.mu.Lock()
+	for _, e := range p.idx {
+		if e.num == num {
+			p.mu.Unlock()
+			return moved, reclaimed, fmt.Errorf("%s: pack %d still has live records", p, num)
+		}
+	}
+	pk := p.packs[num]
+	delete(p.packs, num)
+	p.mu.Unlock()
+	if pk != nil {
+		reclaimed = pk.size - live
+	}
+	return moved, reclaimed, os.Remove(p.fqn(num))
+}
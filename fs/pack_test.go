@@ -0,0 +1,180 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func packData(i int) []byte { return bytes.Repeat([]byte{byte('a' + i%26)}, 100+i) }
+
+func readPacked(t *testing.T, p *fs.Packs, name string) []byte {
+	fh, e, err := p.Open(name)
+	tassert.CheckFatal(t, err)
+	defer fh.Close()
+	md, err := e.ReadMd(fh, name)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, string(md) == "md-"+name, "%s: wrong md %q", name, md)
+	b, err := io.ReadAll(io.NewSectionReader(fh, e.DataOff(), e.Size))
+	tassert.CheckFatal(t, err)
+	return b
+}
+
+func TestPacks(t *testing.T) {
+	const (
+		num      = 200
+		packSize = 4 * cos.KiB
+	)
+	var (
+		bck   = cmn.Bck{Name: "packs", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		mpath = t.TempDir()
+	)
+	fs.TestNew(mock.NewIOS())
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	avail, _ := fs.Get()
+	p := avail[mpath].Packs(&bck)
+
+	for i := range num {
+		name := fmt.Sprintf("dir%d/obj%d", i%3, i)
+		err := p.Put(name, []byte("md-"+name), packData(i), int64(i+1), packSize, false)
+		tassert.CheckFatal(t, err)
+	}
+	// overwrite and delete
+	for i := range num {
+		name := fmt.Sprintf("dir%d/obj%d", i%3, i)
+		switch {
+		case i%4 == 0:
+			tassert.CheckFatal(t, p.Del(name))
+		case i%4 == 1:
+			tassert.CheckFatal(t, p.Put(name, []byte("md-"+name), packData(i+1), int64(i+1), packSize, false))
+		}
+	}
+	tassert.CheckFatal(t, p.SetAtime("dir2/obj2", 12345))
+	tassert.CheckFatal(t, p.SetMd("dir1/obj10", []byte("md-dir1/obj10"), packSize, false))
+
+	check := func(p *fs.Packs) {
+		tassert.Fatalf(t, p.Len() == num-num/4, "expected %d packed, got %d", num-num/4, p.Len())
+		for i := range num {
+			name := fmt.Sprintf("dir%d/obj%d", i%3, i)
+			if i%4 == 0 {
+				tassert.Errorf(t, !p.Has(name), "%s: expected deleted", name)
+				_, _, err := p.Open(name)
+				tassert.Errorf(t, os.IsNotExist(err), "%s: expected not-exist, got %v", name, err)
+				continue
+			}
+			exp := packData(i)
+			if i%4 == 1 {
+				exp = packData(i + 1)
+			}
+			tassert.Errorf(t, bytes.Equal(readPacked(t, p, name), exp), "%s: data mismatch", name)
+		}
+		e, _ := p.Get("dir2/obj2")
+		tassert.Errorf(t, e.Atime == 12345, "expected updated atime, got %d", e.Atime)
+		tassert.Errorf(t, len(p.Names("dir1/")) == len(p.Names("dir2/")), "names by prefix")
+	}
+	check(p)
+
+	// reload from disk
+	_, err = fs.Remove(mpath)
+	tassert.CheckFatal(t, err)
+	_, err = fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	avail, _ = fs.Get()
+	p = avail[mpath].Packs(&bck)
+	check(p)
+
+	// compact
+	size, dead := p.Usage()
+	tassert.Fatalf(t, dead > 0 && size > dead, "expected dead space (%d, %d)", size, dead)
+	_, reclaimed, err := p.Compact(0, packSize)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, reclaimed > 0, "expected reclaimed space")
+	_, dead = p.Usage()
+	tassert.Errorf(t, dead == 0, "expected no dead space in sealed packs, got %d", dead)
+	check(p)
+}
+
+func TestWalkPacked(t *testing.T) {
+	var (
+		bck    = cmn.Bck{Name: "walk-packed", Provider: apc.AIS, Ns: cmn.NsGlobal}
+		mpath  = t.TempDir()
+		files  = []string{"a/x", "a/y/z", "b", "d/e"}
+		packed = []string{"a/w", "a/y/a", "a-b", "c/d/e", "c/f", "e"}
+	)
+	fs.TestNew(mock.NewIOS())
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}, true)
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	avail, _ := fs.Get()
+	mi := avail[mpath]
+	for _, name := range files {
+		fqn := mi.MakePathFQN(&bck, fs.ObjectType, name)
+		tassert.CheckFatal(t, cos.CreateDir(filepath.Dir(fqn)))
+		tassert.CheckFatal(t, os.WriteFile(fqn, []byte(name), cos.PermRWR))
+	}
+	p := mi.Packs(&bck)
+	for _, name := range packed {
+		tassert.CheckFatal(t, p.Put(name, nil, []byte(name), 1, cos.MiB, false))
+	}
+
+	walk := func(sorted bool, skip string) (objs, dirs []string) {
+		err := fs.WalkBck(&fs.WalkBckOpts{
+			WalkOpts: fs.WalkOpts{Bck: bck, CTs: []string{fs.ObjectType}, Sorted: sorted,
+				Callback: func(fqn string, _ fs.DirEntry) error {
+					var parsed fs.ParsedFQN
+					tassert.CheckError(t, parsed.Init(fqn))
+					objs = append(objs, parsed.ObjName)
+					return nil
+				},
+			},
+			ValidateCb: func(fqn string, de fs.DirEntry) error {
+				if !de.IsDir() {
+					return nil
+				}
+				var parsed fs.ParsedFQN
+				if parsed.Init(fqn) != nil {
+					return nil // bucket dir
+				}
+				dirs = append(dirs, parsed.ObjName)
+				if parsed.ObjName == skip {
+					return filepath.SkipDir
+				}
+				return nil
+			},
+		})
+		tassert.CheckFatal(t, err)
+		return objs, dirs
+	}
+
+	// (depth-first: "a/..." precedes "a-b")
+	all := []string{"a/w", "a/x", "a/y/a", "a/y/z", "a-b", "b", "c/d/e", "c/f", "d/e", "e"}
+	objs, dirs := walk(true, "")
+	tassert.Fatalf(t, strings.Join(objs, ",") == strings.Join(all, ","), "expected %v, got %v", all, objs)
+	sort.Strings(dirs)
+	exp := "a,a/y,c,c/d,d"
+	tassert.Errorf(t, strings.Join(dirs, ",") == exp, "expected dirs %s, got %v", exp, dirs)
+
+	// skipping virtual directory skips its packed content
+	objs, _ = walk(true, "c")
+	for _, o := range objs {
+		tassert.Errorf(t, !strings.HasPrefix(o, "c/"), "expected %q skipped", o)
+	}
+	tassert.Errorf(t, len(objs) == len(all)-2, "expected %d, got %v", len(all)-2, objs)
+}
@@ -0,0 +1,97 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Tiered mountpaths (config.Tier):
+// - mountpaths labeled `tier.fast_label` comprise the fast tier, all others - the capacity tier;
+// - tiering is in effect only when both tiers are non-empty;
+// - new objects are placed on the fast tier (HRW within the tier) unless the respective
+//   fast-tier mountpath is above `tier.highwm` or out of space, in which case - on the capacity
+//   tier (see HrwObj); either way, objects get migrated between the tiers by access time
+//   and fast-tier capacity (see space/tier.go);
+// - an object is always stored at its HRW location within the tier, so that finding it
+//   takes (at most) two lookups and no walking (see core/ltier.go);
+// - all other content (chunks, EC slices and metadata, etc.) is placed on the capacity tier (see Hrw)
+
+const (
+	TierNone = iota // not tiered
+	TierFast
+	TierCapacity
+)
+
+func tierLabel(tier int) cos.MountpathLabel {
+	if tier == TierNone {
+		return ""
+	}
+	return cos.MountpathLabel(cmn.GCO.Get().Tier.FastLabel)
+}
+
+func hasTier(avail MPI, label cos.MountpathLabel, tier int) bool {
+	for _, mi := range avail {
+		if (mi.Label == label) == (tier == TierFast) && !mi.IsAnySet(FlagWaitingDD) {
+			return true
+		}
+	}
+	return false
+}
+
+// whether tiering is in effect
+func Tiered() bool {
+	label := tierLabel(TierFast)
+	if label == "" {
+		return false
+	}
+	avail := GetAvail()
+	return hasTier(avail, label, TierFast) && hasTier(avail, label, TierCapacity)
+}
+
+// HRW location of a new object: fast tier, unless the respective mountpath is full
+// (see tierFull), in which case - the object's HRW location on the capacity tier;
+// the digest is the same either way
+func HrwObj(uname []byte) (mi *Mountpath, digest uint64, err error) {
+	mi, digest, err = HrwTier(uname, TierFast)
+	if err != nil || mi.Tier() != TierFast || !mi.tierFull(cmn.GCO.Get()) {
+		return mi, digest, err
+	}
+	if mc, _, errV := HrwTier(uname, TierCapacity); errV == nil {
+		mi = mc
+	}
+	return mi, digest, nil
+}
+
+// fast-tier mountpath above `tier.highwm` or OOS (as per the most recently refreshed capacity)
+func (mi *Mountpath) tierFull(config *cmn.Config) bool {
+	pct := ratomic.LoadInt32(&mi.capacity.PctUsed)
+	return pct >= int32(config.Tier.HighWMX()) || pct > int32(config.Space.OOS)
+}
+
+// (TierNone when not tiered)
+func (mi *Mountpath) Tier() int {
+	if !Tiered() {
+		return TierNone
+	}
+	if mi.Label == tierLabel(TierFast) {
+		return TierFast
+	}
+	return TierCapacity
+}
+
+func OtherTier(tier int) int {
+	switch tier {
+	case TierFast:
+		return TierCapacity
+	case TierCapacity:
+		return TierFast
+	default:
+		return TierNone
+	}
+}
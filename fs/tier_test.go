@@ -0,0 +1,119 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/mock"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+const fastLabel = cos.MountpathLabel("nvme")
+
+func setFastLabel(label cos.MountpathLabel) {
+	config := cmn.GCO.BeginUpdate()
+	config.Tier.FastLabel = string(label)
+	cmn.GCO.CommitUpdate(config)
+}
+
+func TestTierHrw(t *testing.T) {
+	fs.TestNew(mock.NewIOS())
+	defer setFastLabel("")
+
+	mpaths := make(map[string]cos.MountpathLabel, 5)
+	for i := range 5 {
+		mpath, label := t.TempDir(), cos.TestMpathLabel
+		if i < 2 {
+			label = fastLabel
+		}
+		_, err := fs.AddMpath("daeID", mpath, label, func() {})
+		tassert.CheckFatal(t, err)
+		mpaths[mpath] = label
+	}
+	tassert.Errorf(t, !fs.Tiered(), "must not be tiered without config")
+
+	// unknown label: no fast tier
+	setFastLabel("ssd")
+	tassert.Errorf(t, !fs.Tiered(), "must not be tiered with an empty fast tier")
+
+	setFastLabel(fastLabel)
+	tassert.Fatalf(t, fs.Tiered(), "expected tiered")
+
+	fastUsed := make(map[string]struct{}, 2)
+	for i := range 100 {
+		uname := []byte(fmt.Sprintf("bucket/object-%d", i))
+		fast, _, err := fs.HrwTier(uname, fs.TierFast)
+		tassert.CheckFatal(t, err)
+		capa, _, err := fs.Hrw(uname)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, mpaths[fast.Path] == fastLabel && fast.Tier() == fs.TierFast, "%s: not fast", fast)
+		tassert.Fatalf(t, mpaths[capa.Path] != fastLabel && capa.Tier() == fs.TierCapacity, "%s: not capacity", capa)
+		fastUsed[fast.Path] = struct{}{}
+		// same input, same output
+		again, _, _ := fs.HrwTier(uname, fs.TierFast)
+		tassert.Errorf(t, again == fast, "HRW must be deterministic")
+	}
+	tassert.Errorf(t, len(fastUsed) == 2, "expected both fast-tier mountpaths to be selected")
+	tassert.Errorf(t, fs.OtherTier(fs.TierFast) == fs.TierCapacity && fs.OtherTier(fs.TierNone) == fs.TierNone,
+		"unexpected other tier")
+
+	// tiering off: HRW across all mountpaths
+	setFastLabel("")
+	all := make(map[string]struct{})
+	for i := range 100 {
+		mi, _, err := fs.HrwTier([]byte(fmt.Sprintf("bucket/object-%d", i)), fs.TierFast)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, mi.Tier() == fs.TierNone, "%s: expected no tier", mi)
+		all[mi.Path] = struct{}{}
+	}
+	tassert.Errorf(t, len(all) > 2, "expected selection across all mountpaths, got %d", len(all))
+}
+
+func TestTierHrwObj(t *testing.T) {
+	fs.TestNew(mock.NewIOS())
+	defer setFastLabel("")
+
+	for i := range 4 {
+		label := cos.TestMpathLabel
+		if i < 2 {
+			label = fastLabel
+		}
+		_, err := fs.AddMpath("daeID", t.TempDir(), label, func() {})
+		tassert.CheckFatal(t, err)
+	}
+	setFastLabel(fastLabel)
+
+	for i := range 100 {
+		uname := []byte(fmt.Sprintf("bucket/object-%d", i))
+		mi, digest, err := fs.HrwObj(uname)
+		tassert.CheckFatal(t, err)
+		fast, d, _ := fs.HrwTier(uname, fs.TierFast)
+		tassert.Errorf(t, mi == fast && digest == d, "%s: expected fast-tier %s", mi, fast)
+	}
+
+	// simulate full fast tier (used capacity above OOS)
+	config := cmn.GCO.BeginUpdate()
+	oos := config.Space.OOS
+	config.Space.OOS = -1
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Space.OOS = oos
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	for i := range 100 {
+		uname := []byte(fmt.Sprintf("bucket/object-%d", i))
+		mi, _, err := fs.HrwObj(uname)
+		tassert.CheckFatal(t, err)
+		capa, _, _ := fs.Hrw(uname)
+		tassert.Errorf(t, mi == capa && mi.Tier() == fs.TierCapacity, "%s: expected capacity-tier %s", mi, capa)
+	}
+}
@@ -0,0 +1,95 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"os"
+	"sync"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/ios/uring"
+)
+
+// io_uring disk I/O engine (config.Disk.IOEngine):
+// - one ring per mountpath, created upon first use and closed when the mountpath
+//   gets disabled or detached, or when the engine gets switched back to sync;
+// - files opened (or created) via the ring keep working after the ring is closed
+//   (see uring.File);
+// - failing to create a ring (e.g., io_uring not supported by the kernel, or
+//   out of locked memory) means regular (blocking) syscalls until the next
+//   attempt - with exponential backoff.
+
+const (
+	ringBackoffMin = 10 * time.Second
+	ringBackoffMax = 10 * time.Minute
+)
+
+type mpathRing struct {
+	r       ratomic.Pointer[uring.Ring]
+	retry   ratomic.Int64 // mono time: next attempt to create (after failure)
+	backoff time.Duration // under mu
+	mu      sync.Mutex
+}
+
+// returns nil when not configured or (currently) failing to create;
+// closes the ring when not configured
+func (mi *Mountpath) Ring() *uring.Ring {
+	if !cmn.GCO.Get().Disk.Uring() {
+		if mi.ring.r.Load() != nil {
+			mi.closeRing()
+		}
+		return nil
+	}
+	if r := mi.ring.r.Load(); r != nil {
+		return r
+	}
+	if mono.NanoTime() < mi.ring.retry.Load() {
+		return nil
+	}
+	return mi.newRing()
+}
+
+func (mi *Mountpath) newRing() *uring.Ring {
+	mi.ring.mu.Lock()
+	defer mi.ring.mu.Unlock()
+	if r := mi.ring.r.Load(); r != nil {
+		return r
+	}
+	if mono.NanoTime() < mi.ring.retry.Load() {
+		return nil
+	}
+	r, err := uring.New(uring.DfltEntries)
+	if err != nil {
+		mi.ring.backoff = min(max(mi.ring.backoff<<1, ringBackoffMin), ringBackoffMax)
+		mi.ring.retry.Store(mono.NanoTime() + int64(mi.ring.backoff))
+		nlog.Errorln(mi.String()+":", err, "- falling back to", cmn.IOEngineSync, "for", mi.ring.backoff)
+		return nil
+	}
+	mi.ring.backoff = 0
+	mi.ring.r.Store(r)
+	return r
+}
+
+// (waits for requests in flight - hence, async; the ring's reaper exits upon close)
+func (mi *Mountpath) closeRing() {
+	mi.ring.mu.Lock()
+	r := mi.ring.r.Swap(nil)
+	mi.ring.mu.Unlock()
+	if r != nil {
+		go r.Close()
+	}
+}
+
+// returns nil when there's no ring (see above)
+func (mi *Mountpath) UringFile(fh *os.File) *uring.File {
+	if r := mi.Ring(); r != nil {
+		return uring.NewFile(fh, r)
+	}
+	return nil
+}
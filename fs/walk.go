@@ -74,6 +74,9 @@ func Walk(opts *WalkOpts) error {
 	if err != nil {
 		return err
 	}
+	if wp := newWalkPacked(opts, fqns); wp != nil {
+		return wp.walk(fqns, opts)
+	}
 	return useWalker.walk(fqns, opts)
 }
 
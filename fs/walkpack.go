@@ -0,0 +1,167 @@
+// Package fs provides mountpath and FQN abstractions and methods to resolve/map stored content
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package fs
+
+import (
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Walking packed objects (see pack.go): for the walking caller, packed objects
+// look exactly like regular ones, with FQNs that do not exist in the filesystem.
+// - unsorted walk: packed objects are visited after all the regular ones
+// - sorted walk: packed objects are merged in walking order; their parent virtual
+//   directories are visited as well (which is what listing with no recursion requires)
+
+type (
+	packedDE bool
+
+	walkPacked struct {
+		ucb     walkFunc
+		dirs    map[string]bool // visited dirs => skipped
+		bdir    string          // objects' bucket directory
+		rootObj string          // walk root relative to `bdir`
+		names   []string
+		i       int
+	}
+)
+
+// interface guard
+var _ DirEntry = packedDE(false)
+
+func (d packedDE) IsDir() bool { return bool(d) }
+
+func newWalkPacked(opts *WalkOpts, fqns []string) *walkPacked {
+	if opts.Dir != "" || opts.Bck.Name == "" || opts.Mi == nil || !slices.Contains(opts.CTs, ObjectType) {
+		return nil
+	}
+	names := opts.Mi.Packs(&opts.Bck).Names(opts.Prefix)
+	if len(names) == 0 {
+		return nil
+	}
+	wp := &walkPacked{ucb: opts.Callback, bdir: opts.Mi.MakePathCT(&opts.Bck, ObjectType), names: names}
+	for _, fqn := range fqns {
+		if len(fqn) > len(wp.bdir) && strings.HasPrefix(fqn, wp.bdir) {
+			wp.rootObj = strings.TrimSuffix(fqn[len(wp.bdir)+1:], "/")
+		}
+	}
+	return wp
+}
+
+func (wp *walkPacked) walk(fqns []string, opts *WalkOpts) error {
+	if !opts.Sorted {
+		if err := useWalker.walk(fqns, opts); err != nil {
+			return err
+		}
+		for _, name := range wp.names {
+			if err := wp.ucb(wp.fqn(name), packedDE(false)); err != nil && !skipPacked(err) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	sort.Slice(wp.names, func(i, j int) bool { return walkLess(wp.names[i], wp.names[j]) })
+	wp.dirs = make(map[string]bool, 16)
+	o := *opts
+	o.Callback = wp.cb
+	if err := useWalker.walk(fqns, &o); err != nil {
+		return err
+	}
+	return wp.flush("")
+}
+
+func (wp *walkPacked) fqn(name string) string { return wp.bdir + "/" + name }
+
+func (wp *walkPacked) cb(fqn string, de DirEntry) error {
+	if len(fqn) <= len(wp.bdir) || !strings.HasPrefix(fqn, wp.bdir) || fqn[len(wp.bdir)] != filepath.Separator {
+		return wp.ucb(fqn, de)
+	}
+	objName := fqn[len(wp.bdir)+1:]
+	if err := wp.flush(objName); err != nil {
+		return err
+	}
+	err := wp.ucb(fqn, de)
+	if de.IsDir() {
+		wp.dirs[objName] = err == filepath.SkipDir
+	}
+	return err
+}
+
+// visit packed objects that precede `upto` in walking order (all remaining when empty)
+func (wp *walkPacked) flush(upto string) error {
+	for ; wp.i < len(wp.names); wp.i++ {
+		name := wp.names[wp.i]
+		if upto != "" && !walkLess(name, upto) {
+			break
+		}
+		skip, err := wp.visitDirs(name)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+		if err := wp.ucb(wp.fqn(name), packedDE(false)); err != nil && !skipPacked(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// visit (virtual) parent directories below the walk root, unless already visited
+func (wp *walkPacked) visitDirs(name string) (skip bool, _ error) {
+	for j := 0; ; {
+		k := strings.IndexByte(name[j:], '/')
+		if k < 0 {
+			break
+		}
+		dir := name[:j+k]
+		j += k + 1
+		if len(dir) <= len(wp.rootObj) {
+			continue
+		}
+		skipped, ok := wp.dirs[dir]
+		if !ok {
+			err := wp.ucb(wp.fqn(dir), packedDE(true))
+			skipped = err == filepath.SkipDir
+			if err != nil && !skipped {
+				return false, err
+			}
+			wp.dirs[dir] = skipped
+		}
+		if skipped {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// (same as the default error callback: skip objects with object-level errors)
+func skipPacked(err error) bool { return err == filepath.SkipDir || cmn.IsErrObjLevel(err) }
+
+// depth-first walking order with sorted directory entries:
+// same as lexicographic except that path separator sorts first
+func walkLess(a, b string) bool {
+	n := min(len(a), len(b))
+	for i := range n {
+		ca, cb := a[i], b[i]
+		if ca == cb {
+			continue
+		}
+		if ca == '/' {
+			return true
+		}
+		if cb == '/' {
+			return false
+		}
+		return ca < cb
+	}
+	return len(a) < len(b)
+}
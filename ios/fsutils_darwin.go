@@ -54,3 +54,6 @@ func GetATime(osfi os.FileInfo) time.Time {
 	// NOTE: see https://en.wikipedia.org/wiki/Stat_(system_call)#Criticism_of_atime
 	return atime
 }
+
+// (no posix_fadvise on darwin; the hint is advisory anyway)
+func ReadAhead(*os.File) error { return nil }
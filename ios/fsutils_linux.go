@@ -153,3 +153,8 @@ func GetATime(osfi os.FileInfo) time.Time {
 	// NOTE: see https://en.wikipedia.org/wiki/Stat_(system_call)#Criticism_of_atime
 	return atime
 }
+
+// ask the kernel to (asynchronously) read the entire file into page cache
+func ReadAhead(fh *os.File) error {
+	return unix.Fadvise(int(fh.Fd()), 0, 0, unix.FADV_WILLNEED)
+}
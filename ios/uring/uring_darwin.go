@@ -0,0 +1,21 @@
+// Package uring provides io_uring-based file I/O (Linux only)
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package uring
+
+const (
+	opFsync = iota
+	opRead
+	opWrite
+)
+
+type Ring struct{}
+
+func Supported() bool { return false }
+
+func New(uint32) (*Ring, error) { return nil, ErrUnsupported }
+
+func (*Ring) Close() {}
+
+func (*Ring) do(uint8, int, []byte, int64) (int, error) { return 0, ErrClosed }
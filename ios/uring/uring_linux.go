@@ -0,0 +1,349 @@
+// Package uring provides io_uring-based file I/O (Linux only)
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package uring
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	"golang.org/x/sys/unix"
+)
+
+// (see include/uapi/linux/io_uring.h)
+const (
+	opNop   = 0
+	opFsync = 3
+	opRead  = 22 // (5.6)
+	opWrite = 23 // ditto
+
+	featSingleMmap = 1 << 0
+	featRWCurPos   = 1 << 3 // (5.6; used here to detect opRead and opWrite)
+
+	enterGetEvents = 1 << 0
+
+	offSQRing = 0
+	offSQEs   = 0x10000000
+
+	sizeofSQE = 64
+	sizeofCQE = 16
+
+	closeUD = ^uint64(0) // (user data) stop reaping
+)
+
+type (
+	sqringOffsets struct {
+		head, tail, ringMask, ringEntries, flags, dropped, array, resv1 uint32
+		userAddr                                                        uint64
+	}
+	cqringOffsets struct {
+		head, tail, ringMask, ringEntries, overflow, cqes, flags, resv1 uint32
+		userAddr                                                        uint64
+	}
+	params struct {
+		sqEntries, cqEntries, flags, sqThreadCPU, sqThreadIdle, features, wqFd uint32
+		resv                                                                   [3]uint32
+		sqOff                                                                  sqringOffsets
+		cqOff                                                                  cqringOffsets
+	}
+	sqe struct {
+		opcode      uint8
+		flags       uint8
+		ioprio      uint16
+		fd          int32
+		off         uint64
+		addr        uint64
+		len         uint32
+		opFlags     uint32
+		userData    uint64
+		bufIndex    uint16
+		personality uint16
+		spliceFdIn  int32
+		addr3       uint64
+		_           uint64
+	}
+	cqe struct {
+		userData uint64
+		res      int32
+		flags    uint32
+	}
+)
+
+type (
+	Ring struct {
+		sq struct {
+			head, tail *uint32
+			mask       uint32
+			array      []uint32
+			sqes       []sqe
+		}
+		cq struct {
+			head, tail *uint32
+			mask       uint32
+			cqes       []cqe
+		}
+		ringMem, sqeMem []byte
+		reqs            []atomic.Pointer[request] // in flight, indexed by slot (user data)
+		free            chan uint32               // free slots (limits the number in flight)
+		wg              sync.WaitGroup
+		mu              sync.RWMutex // (closed)
+		smu             sync.Mutex   // (sq tail, queued)
+		rmu             sync.Mutex   // (cq head)
+		submitting      atomic.Bool
+		queued          uint32 // not yet submitted
+		fd              int
+		closed          bool
+	}
+	request struct {
+		done chan int32 // result
+		buf  []byte     // (keep alive while in flight)
+	}
+)
+
+// compile-time
+var (
+	_ [sizeofSQE]byte = [unsafe.Sizeof(sqe{})]byte{}
+	_ [sizeofCQE]byte = [unsafe.Sizeof(cqe{})]byte{}
+	_ [120]byte       = [unsafe.Sizeof(params{})]byte{}
+)
+
+var reqPool = sync.Pool{New: func() any { return &request{done: make(chan int32, 1)} }}
+
+func Supported() bool {
+	r, err := New(1)
+	if err != nil {
+		return false
+	}
+	r.Close()
+	return true
+}
+
+func New(entries uint32) (*Ring, error) {
+	var p params
+	fd, _, errno := unix.Syscall(unix.SYS_IO_URING_SETUP, uintptr(entries), uintptr(unsafe.Pointer(&p)), 0)
+	if errno != 0 {
+		return nil, fmt.Errorf("%w: setup: %v", ErrUnsupported, errno)
+	}
+	r := &Ring{fd: int(fd)}
+	if p.features&featSingleMmap == 0 || p.features&featRWCurPos == 0 {
+		unix.Close(r.fd)
+		return nil, fmt.Errorf("%w: kernel 5.6 or later required (features 0x%x)", ErrUnsupported, p.features)
+	}
+	if err := r.mmap(&p); err != nil {
+		unix.Close(r.fd)
+		return nil, err
+	}
+
+	// NOTE: in flight <= SQ size, so that queued (not yet submitted) entries never overrun the SQ
+	// (and with CQ = 2 x SQ, completions never overflow)
+	r.reqs = make([]atomic.Pointer[request], p.sqEntries)
+	r.free = make(chan uint32, p.sqEntries)
+	for i := range p.sqEntries {
+		r.free <- i
+	}
+
+	r.wg.Add(1)
+	go r.reaper()
+	return r, nil
+}
+
+func (r *Ring) mmap(p *params) (err error) {
+	var (
+		sqSize = p.sqOff.array + p.sqEntries*4
+		cqSize = p.cqOff.cqes + p.cqEntries*sizeofCQE
+		prot   = unix.PROT_READ | unix.PROT_WRITE
+		flags  = unix.MAP_SHARED | unix.MAP_POPULATE
+	)
+	if r.ringMem, err = unix.Mmap(r.fd, offSQRing, int(max(sqSize, cqSize)), prot, flags); err != nil {
+		return fmt.Errorf("io_uring mmap ring: %w", err)
+	}
+	if r.sqeMem, err = unix.Mmap(r.fd, offSQEs, int(p.sqEntries*sizeofSQE), prot, flags); err != nil {
+		unix.Munmap(r.ringMem)
+		return fmt.Errorf("io_uring mmap sqes: %w", err)
+	}
+	base := unsafe.Pointer(&r.ringMem[0])
+	r.sq.head = (*uint32)(unsafe.Add(base, p.sqOff.head))
+	r.sq.tail = (*uint32)(unsafe.Add(base, p.sqOff.tail))
+	r.sq.mask = *(*uint32)(unsafe.Add(base, p.sqOff.ringMask))
+	r.sq.array = unsafe.Slice((*uint32)(unsafe.Add(base, p.sqOff.array)), p.sqEntries)
+	r.sq.sqes = unsafe.Slice((*sqe)(unsafe.Pointer(&r.sqeMem[0])), p.sqEntries)
+
+	r.cq.head = (*uint32)(unsafe.Add(base, p.cqOff.head))
+	r.cq.tail = (*uint32)(unsafe.Add(base, p.cqOff.tail))
+	r.cq.mask = *(*uint32)(unsafe.Add(base, p.cqOff.ringMask))
+	r.cq.cqes = unsafe.Slice((*cqe)(unsafe.Add(base, p.cqOff.cqes)), p.cqEntries)
+	return nil
+}
+
+// waits for all requests in flight to complete; subsequent requests fail with ErrClosed
+func (r *Ring) Close() {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return
+	}
+	r.closed = true
+	r.mu.Unlock()
+
+	for range cap(r.free) {
+		<-r.free
+	}
+	r.queue(opNop, -1, nil, 0, closeUD)
+	r.flush()
+	r.wg.Wait()
+
+	unix.Munmap(r.sqeMem)
+	unix.Munmap(r.ringMem)
+	unix.Close(r.fd)
+}
+
+// submit and wait for completion
+func (r *Ring) do(op uint8, fd int, b []byte, off int64) (int, error) {
+	for {
+		r.mu.RLock()
+		if r.closed {
+			r.mu.RUnlock()
+			return 0, ErrClosed
+		}
+		req := reqPool.Get().(*request)
+		req.buf = b
+		slot := <-r.free
+		r.reqs[slot].Store(req)
+		r.queue(op, fd, b, off, uint64(slot))
+		r.mu.RUnlock()
+
+		r.flush()
+		// completed inline (e.g., page cache hit)? reap it without waiting for the reaper
+		if r.rmu.TryLock() {
+			r.reap()
+			r.rmu.Unlock()
+		}
+		res := <-req.done
+		r.free <- slot
+		req.buf = nil
+		reqPool.Put(req)
+
+		switch {
+		case res >= 0:
+			return int(res), nil
+		case unix.Errno(-res) == unix.EINTR || unix.Errno(-res) == unix.EAGAIN:
+			continue
+		default:
+			return 0, unix.Errno(-res)
+		}
+	}
+}
+
+func (r *Ring) queue(op uint8, fd int, b []byte, off int64, ud uint64) {
+	r.smu.Lock()
+	tail := atomic.LoadUint32(r.sq.tail)
+	idx := tail & r.sq.mask
+	e := &r.sq.sqes[idx]
+	*e = sqe{opcode: op, fd: int32(fd), off: uint64(off), userData: ud}
+	if len(b) > 0 {
+		e.addr = uint64(uintptr(unsafe.Pointer(unsafe.SliceData(b))))
+		e.len = uint32(len(b))
+	}
+	r.sq.array[idx] = idx
+	atomic.StoreUint32(r.sq.tail, tail+1)
+	r.queued++
+	r.smu.Unlock()
+}
+
+// submit all queued - by whoever gets there first (the rest don't wait)
+func (r *Ring) flush() {
+	for r.submitting.CompareAndSwap(false, true) {
+		for {
+			r.smu.Lock()
+			n := r.queued
+			r.queued = 0
+			r.smu.Unlock()
+			if n == 0 {
+				break
+			}
+			r.submit(n)
+		}
+		r.submitting.Store(false)
+
+		// (queued by others in the meantime)
+		r.smu.Lock()
+		n := r.queued
+		r.smu.Unlock()
+		if n == 0 {
+			return
+		}
+	}
+}
+
+func (r *Ring) submit(n uint32) {
+	for n > 0 {
+		m, err := r.enter(n, 0, 0)
+		switch err {
+		case nil:
+			n -= uint32(m)
+		case unix.EINTR, unix.EAGAIN, unix.EBUSY:
+			time.Sleep(time.Millisecond)
+		default:
+			// (unlikely) keep them queued for the next flush
+			nlog.Errorln("io_uring submit:", err)
+			debug.AssertNoErr(err)
+			r.smu.Lock()
+			r.queued += n
+			r.smu.Unlock()
+			return
+		}
+	}
+}
+
+func (r *Ring) reaper() {
+	defer r.wg.Done()
+	for {
+		if atomic.LoadUint32(r.cq.head) == atomic.LoadUint32(r.cq.tail) {
+			if _, err := r.enter(0, 1, enterGetEvents); err != nil && err != unix.EINTR {
+				nlog.Errorln("io_uring wait:", err)
+				time.Sleep(time.Millisecond)
+			}
+			continue
+		}
+		r.rmu.Lock()
+		stop := r.reap()
+		r.rmu.Unlock()
+		if stop {
+			return
+		}
+	}
+}
+
+// under rmu
+// NOTE: the closing nop is only submitted when nothing's in flight and, therefore, only reaper can see it
+func (r *Ring) reap() (stop bool) {
+	head, tail := atomic.LoadUint32(r.cq.head), atomic.LoadUint32(r.cq.tail)
+	for ; head != tail; head++ {
+		e := &r.cq.cqes[head&r.cq.mask]
+		if e.userData == closeUD {
+			stop = true
+			continue
+		}
+		req := r.reqs[e.userData].Swap(nil)
+		debug.Assert(req != nil, e.userData)
+		req.done <- e.res
+	}
+	atomic.StoreUint32(r.cq.head, head)
+	return stop
+}
+
+func (r *Ring) enter(toSubmit, minComplete, flags uint32) (int, error) {
+	n, _, errno := unix.Syscall6(unix.SYS_IO_URING_ENTER, uintptr(r.fd), uintptr(toSubmit), uintptr(minComplete),
+		uintptr(flags), 0, 0)
+	if errno != 0 {
+		return 0, errno
+	}
+	return int(n), nil
+}
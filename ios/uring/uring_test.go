@@ -0,0 +1,136 @@
+// Package uring provides io_uring-based file I/O (Linux only)
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package uring_test
+
+import (
+	"bytes"
+	cryptorand "crypto/rand"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ios/uring"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func newRing(tb testing.TB) *uring.Ring {
+	r, err := uring.New(uring.DfltEntries)
+	if err != nil {
+		tb.Skip(err)
+	}
+	return r
+}
+
+func TestFile(t *testing.T) {
+	var (
+		r     = newRing(t)
+		fqn   = filepath.Join(t.TempDir(), "obj")
+		data  = make([]byte, 3*cos.MiB+17)
+		chunk = 64*cos.KiB + 1
+	)
+	_, _ = cryptorand.Read(data)
+
+	// write
+	fh, err := os.OpenFile(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, cos.PermRWR)
+	tassert.CheckFatal(t, err)
+	wf := uring.NewFile(fh, r)
+	for off := 0; off < len(data); off += chunk {
+		n, err := wf.Write(data[off:min(off+chunk, len(data))])
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, n == min(chunk, len(data)-off), "short write %d", n)
+	}
+	tassert.CheckFatal(t, wf.Sync())
+	tassert.CheckFatal(t, wf.Close())
+
+	fh, err = os.Open(fqn)
+	tassert.CheckFatal(t, err)
+	rf := uring.NewFile(fh, r)
+
+	// read (sequential)
+	b, err := io.ReadAll(rf)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, bytes.Equal(b, data), "content mismatch (%d vs %d)", len(b), len(data))
+
+	// read at (concurrent)
+	wg := &sync.WaitGroup{}
+	for range 32 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf := make([]byte, 4*cos.KiB)
+			for range 100 {
+				off := rand.IntN(len(data) - len(buf))
+				n, err := rf.ReadAt(buf, int64(off))
+				if err != nil || n != len(buf) || !bytes.Equal(buf, data[off:off+len(buf)]) {
+					t.Errorf("read at %d: n=%d, err=%v", off, n, err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// past the end
+	buf := make([]byte, 100)
+	n, err := rf.ReadAt(buf, int64(len(data)-10))
+	tassert.Errorf(t, n == 10 && err == io.EOF, "expected (10, EOF), got (%d, %v)", n, err)
+
+	// closed ring: fallback
+	r.Close()
+	n, err = rf.ReadAt(buf, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, n == len(buf) && bytes.Equal(buf, data[:len(buf)]), "fallback: content mismatch")
+	tassert.CheckFatal(t, rf.Close())
+
+	// errors
+	r = newRing(t)
+	defer r.Close()
+	fh, err = os.Open(fqn)
+	tassert.CheckFatal(t, err)
+	_, err = uring.NewFile(fh, r).Write(data[:10])
+	tassert.Errorf(t, err != nil, "expected error writing read-only file")
+	fh.Close()
+}
+
+func BenchmarkReadAt(b *testing.B) {
+	const size = 64 * cos.MiB
+	var (
+		fqn  = filepath.Join(b.TempDir(), "obj")
+		data = make([]byte, size)
+	)
+	_, _ = cryptorand.Read(data)
+	if err := os.WriteFile(fqn, data, cos.PermRWR); err != nil {
+		b.Fatal(err)
+	}
+	for _, engine := range []string{"sync", "io_uring"} {
+		b.Run(engine, func(b *testing.B) {
+			fh, err := os.Open(fqn)
+			if err != nil {
+				b.Fatal(err)
+			}
+			defer fh.Close()
+			var ra io.ReaderAt = fh
+			if engine == "io_uring" {
+				r := newRing(b)
+				defer r.Close()
+				ra = uring.NewFile(fh, r)
+			}
+			b.SetBytes(4 * cos.KiB)
+			b.RunParallel(func(pb *testing.PB) {
+				buf := make([]byte, 4*cos.KiB)
+				for pb.Next() {
+					if _, err := ra.ReadAt(buf, rand.Int64N(size-int64(len(buf)))); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
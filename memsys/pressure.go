@@ -87,6 +87,8 @@ var memPressureText = map[int]string{
 	OOM:              "OOM",
 }
 
+func PressureText(p int) string { return memPressureText[p] }
+
 // NOTE: used instead of mem.Free as a more realistic estimate where
 // mem.BuffCache - kernel buffers and page caches that can be reclaimed -
 // is always included _unless_ the resulting number exceeds mem.ActualFree
@@ -5,6 +5,7 @@
 package mirror
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
@@ -96,10 +97,12 @@ func newMNC(p *mncFactory, slab *memsys.Slab) (r *mncXact) {
 func (r *mncXact) Run(wg *sync.WaitGroup) {
 	wg.Done()
 	tname := core.T.String()
-	if err := fs.ValidateNCopies(tname, r.p.args.Copies); err != nil {
-		r.AddErr(err)
-		r.Finish()
-		return
+	if !r.p.Bck.Props.Mirror.AcrossTargets() {
+		if err := fs.ValidateNCopies(tname, r.p.args.Copies); err != nil {
+			r.AddErr(err)
+			r.Finish()
+			return
+		}
 	}
 	r.BckJog.Run()
 	nlog.Infoln(r.Name())
@@ -117,6 +120,8 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 		copies = r.p.args.Copies
 	)
 	switch {
+	case lom.MirrorConf().AcrossTargets():
+		size, err = r.across(lom)
 	case n == copies:
 		return nil
 	case n > copies:
@@ -133,6 +138,10 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 		if cos.IsNotExist(err) {
 			return nil
 		}
+		if errors.Is(err, errNoLocalCopies) {
+			r.AddErr(err, 5, cos.SmoduleMirror) // (keep going)
+			return nil
+		}
 		if cos.IsErrOOS(err) {
 			r.Abort(err)
 		} else {
@@ -160,6 +169,34 @@ func (r *mncXact) visitObj(lom *core.LOM, buf []byte) (err error) {
 	return err
 }
 
+// mirror.placement = "targets" (see replicas.go)
+func (*mncXact) across(lom *core.LOM) (size int64, err error) {
+	if lom.NumCopies() > 1 {
+		// no local copies when mirroring across targets
+		lom.Lock(true)
+		size, err = delCopies(lom, 1)
+		lom.Unlock(true)
+		if err != nil {
+			return size, err
+		}
+	}
+	smap := core.T.Sowner().Get()
+	tsi, local, err := lom.HrwTarget(smap)
+	switch {
+	case err != nil:
+	case local:
+		var n int64
+		n, err = SendReplicas(lom, smap, true /*missing*/)
+		size += n
+	case !IsReplica(lom, smap) && core.T.HeadObjT2T(lom, tsi):
+		// extra replica (e.g., reduced number of copies or disabled mirroring)
+		lom.Lock(true)
+		err = lom.RemoveObj()
+		lom.Unlock(true)
+	}
+	return size, err
+}
+
 func (r *mncXact) String() string { return r._str }
 func (r *mncXact) Name() string   { return r._nam }
 
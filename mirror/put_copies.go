@@ -80,9 +80,11 @@ func (p *putFactory) Start() error {
 	if !mirror.Enabled {
 		return fmt.Errorf("%s: mirroring disabled, nothing to do", bck.String())
 	}
-	if err = fs.ValidateNCopies(core.T.String(), int(mirror.Copies)); err != nil {
-		nlog.Errorln(err)
-		return err
+	if !mirror.AcrossTargets() {
+		if err = fs.ValidateNCopies(core.T.String(), int(mirror.Copies)); err != nil {
+			nlog.Errorln(err)
+			return err
+		}
 	}
 	r := &XactPut{mirror: *mirror, workCh: make(chan core.LIF, mirror.Burst)}
 
@@ -127,11 +129,18 @@ func (p *putFactory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
 
 // (one worker per mountpath)
 func (r *XactPut) do(lom *core.LOM, buf []byte) {
-	copies := int(lom.Bprops().Mirror.Copies)
-
-	lom.Lock(true)
-	size, err := addCopies(lom, copies, buf)
-	lom.Unlock(true)
+	var (
+		size   int64
+		err    error
+		mirror = &lom.Bprops().Mirror
+	)
+	if mirror.AcrossTargets() {
+		size, err = SendReplicas(lom, core.T.Sowner().Get(), false /*missing*/)
+	} else {
+		lom.Lock(true)
+		size, err = addCopies(lom, int(mirror.Copies), buf)
+		lom.Unlock(true)
+	}
 
 	if err != nil {
 		r.AddErr(err, 5, cos.SmoduleMirror)
@@ -0,0 +1,180 @@
+// Package mirror provides local mirroring and replica management
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package mirror
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+)
+
+// N-way mirroring across targets (mirror.placement = "targets"):
+// - the object's HRW owner stores the object; the next (copies - 1) targets in the
+//   HRW order store its replicas (and may serve reads);
+// - the owner replicates upon PUT (see XactPut) and propagates deletions;
+// - replica holders serve their replicas as is: when overwriting, the owner removes
+//   existing replicas before replicating the new version (see DelReplicas);
+// - a replica holder that does not have the object fetches it from the owner upon GET;
+// - rebalance keeps replicas in place and re-replicates when cluster membership changes.
+
+// HRW-ordered targets that must store the object, the first being the owner
+func ReplicaTargets(lom *core.LOM, smap *meta.Smap) (meta.Nodes, error) {
+	copies := min(int(lom.MirrorConf().Copies), smap.CountActiveTs())
+	return smap.HrwTargetList(lom.UnamePtr(), max(copies, 1))
+}
+
+// IsReplica: whether this target is a designated replica holder (other than the owner)
+func IsReplica(lom *core.LOM, smap *meta.Smap) bool {
+	if mconfig := lom.MirrorConf(); !mconfig.Enabled || !mconfig.AcrossTargets() {
+		return false
+	}
+	sis, err := ReplicaTargets(lom, smap)
+	if err != nil {
+		return false
+	}
+	for _, tsi := range sis[1:] {
+		if tsi.ID() == core.T.SID() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOwner: whether this target is the owner that must replicate (or delete replicas of) the object
+func IsOwner(lom *core.LOM, smap *meta.Smap) bool {
+	if mconfig := lom.MirrorConf(); !mconfig.Enabled || !mconfig.AcrossTargets() {
+		return false
+	}
+	_, local, err := lom.HrwTarget(smap)
+	return err == nil && local
+}
+
+// SendReplicas sends the object to its replica holders; when `missing` is true,
+// only to those that do not have it (e.g., upon rebalance).
+// Returns the number of bytes sent.
+func SendReplicas(lom *core.LOM, smap *meta.Smap, missing bool) (size int64, err error) {
+	if !IsOwner(lom, smap) {
+		return 0, nil
+	}
+	sis, err := ReplicaTargets(lom, smap)
+	if err != nil {
+		return 0, err
+	}
+	config := cmn.GCO.Get()
+	for _, tsi := range sis[1:] {
+		if missing && core.T.HeadObjT2T(lom, tsi) {
+			continue
+		}
+		n, errV := putReplica(lom, tsi, config)
+		if errV != nil {
+			err = errV
+			continue
+		}
+		size += n
+	}
+	return size, err
+}
+
+// DelReplicas removes the object's replicas (is called by the owner after deleting, evicting, renaming, or overwriting)
+func DelReplicas(lom *core.LOM) {
+	smap := core.T.Sowner().Get()
+	if !IsOwner(lom, smap) {
+		return
+	}
+	sis, err := ReplicaTargets(lom, smap)
+	if err != nil {
+		return
+	}
+	config := cmn.GCO.Get()
+	for _, tsi := range sis[1:] {
+		if err := delReplica(lom, tsi, config); err != nil {
+			nlog.Warningln("failed to delete replica", lom.Cname(), "at", tsi.StringEx(), "err:", err)
+		}
+	}
+}
+
+// PUT(lom) => replica holder
+func putReplica(lom *core.LOM, tsi *meta.Snode, config *cmn.Config) (int64, error) {
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		return 0, err
+	}
+	roc, err := lom.NewDeferROC(true /*loaded*/) // (unlocks upon close)
+	if err != nil {
+		return 0, err
+	}
+	var (
+		size  = lom.Lsize()
+		hdr   = make(http.Header, 8)
+		query = lom.Bck().NewQuery()
+	)
+	cmn.ToHeader(lom.ObjAttrs(), hdr, lom.Lsize(true))
+	setHdr(hdr)
+	query.Set(apc.QparamOWT, cmn.OwtRebalance.ToS()) // no versioning, no remote writes, no further replication
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodPut,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName),
+		Query:  query,
+		Header: hdr,
+		BodyR:  roc,
+	}
+	return size, _do(&reqArgs, lom, tsi, config)
+}
+
+// DELETE(lom) => replica holder
+func delReplica(lom *core.LOM, tsi *meta.Snode, config *cmn.Config) error {
+	hdr := make(http.Header, 4)
+	setHdr(hdr)
+	reqArgs := cmn.HreqArgs{
+		Method: http.MethodDelete,
+		Base:   tsi.URL(cmn.NetIntraData),
+		Path:   apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName),
+		Query:  lom.Bck().NewQuery(),
+		Header: hdr,
+	}
+	return _do(&reqArgs, lom, tsi, config)
+}
+
+// is intra-call (see checkIntraCall)
+func setHdr(hdr http.Header) {
+	tsi := core.T.Snode()
+	hdr.Set(apc.HdrT2TPutterID, tsi.ID())
+	hdr.Set(apc.HdrCallerID, tsi.ID())
+	hdr.Set(apc.HdrCallerName, tsi.String())
+}
+
+func _do(reqArgs *cmn.HreqArgs, lom *core.LOM, tsi *meta.Snode, config *cmn.Config) error {
+	req, _, cancel, err := reqArgs.ReqWith(config.Timeout.SendFile.D())
+	if err != nil {
+		if reqArgs.BodyR != nil {
+			cos.Close(reqArgs.BodyR)
+		}
+		return err
+	}
+	defer cancel()
+	resp, err := core.T.DataClient().Do(req) // always closes request body
+	cmn.HreqFree(req)
+	if err != nil {
+		return cmn.NewErrFailedTo(core.T, reqArgs.Method+" replica", lom.Cname(), err)
+	}
+	cos.DrainReader(resp.Body)
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode < http.StatusBadRequest:
+		return nil
+	case resp.StatusCode == http.StatusNotFound && reqArgs.Method == http.MethodDelete:
+		return nil
+	default:
+		return fmt.Errorf("%s replica %s => %s: status %d", reqArgs.Method, lom.Cname(), tsi.StringEx(), resp.StatusCode)
+	}
+}
@@ -5,6 +5,7 @@
 package mirror
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/NVIDIA/aistore/cmn/nlog"
@@ -12,6 +13,10 @@ import (
 	"github.com/NVIDIA/aistore/fs"
 )
 
+// local copies of a chunked object would share its chunks (see core/lchunk.go);
+// packed and deduplicated objects are not mirrored either (see core/lpack.go, core/ldedup.go)
+var errNoLocalCopies = errors.New("local copies not supported")
+
 // is under lock
 func delCopies(lom *core.LOM, copies int) (size int64, err error) {
 	// force reloading metadata
@@ -58,6 +63,14 @@ func addCopies(lom *core.LOM, copies int, buf []byte) (size int64, err error) {
 	if lom.NumCopies() >= copies {
 		return 0, nil
 	}
+	switch {
+	case lom.IsChunked():
+		return 0, fmt.Errorf("%s (chunked): %w", lom.Cname(), errNoLocalCopies)
+	case lom.IsPacked():
+		return 0, fmt.Errorf("%s (packed): %w", lom.Cname(), errNoLocalCopies)
+	case lom.IsDeduped():
+		return 0, fmt.Errorf("%s (deduplicated): %w", lom.Cname(), errNoLocalCopies)
+	}
 
 	//  While copying we may find out that some copies do not exist -
 	//  these copies will be removed and `NumCopies()` will decrease.
@@ -0,0 +1,349 @@
+// Package quota enforces bucket and namespace quotas: total size and number of objects.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package quota
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+)
+
+// Quotas are cluster-wide while usage is tracked by each target - for the objects it stores.
+// A write is checked against the cluster-wide usage:
+//   (this target's usage) + (other targets' usage, as last queried) + (size of the write) <= limit
+//
+// Usage counters are updated inline (PUT, APPEND, promote, copy, delete) and lazily
+// reconciled - recounted by walking the bucket - upon first use and every `quota.reconcile`
+// thereafter (which also accounts for objects migrated by rebalance, evicted by LRU, etc.)
+// Other targets' usage is queried (see Init) upon use but at most every `peersEvery`; in between,
+// concurrent writes via other targets may exceed the limit by up to what they've written since.
+// Until the first recount and the first query complete, writes are not limited.
+
+const (
+	warnEvery  = 10 * time.Minute // soft limit: at most one warning per bucket (or namespace) per
+	peersEvery = 10 * time.Second // query other targets' usage at most this often
+)
+
+type (
+	// (this or other targets') usage of a given bucket
+	Counters struct {
+		Size int64 `json:"size"`
+		Objs int64 `json:"objs"`
+	}
+	// returns the sum of all other targets' usage of a given bucket (see Local)
+	PeersFn func(bck *meta.Bck) (Counters, error)
+
+	usage struct {
+		bck        *meta.Bck
+		size       atomic.Int64
+		objs       atomic.Int64
+		psize      atomic.Int64 // other targets: total size
+		pobjs      atomic.Int64 // other targets: number of objects
+		reconciled atomic.Int64 // mono time; zero - not yet
+		synced     atomic.Int64 // mono time other targets' usage was (successfully) queried; zero - not yet
+		queried    atomic.Int64 // mono time of the last query
+		warned     atomic.Int64 // mono time
+		running    atomic.Bool  // recounting
+		querying   atomic.Bool  // querying other targets
+	}
+	tracker struct {
+		m     map[uint64]*usage // by bucket ID (Bprops.BID)
+		peers PeersFn
+		mu    sync.RWMutex
+	}
+)
+
+var g = tracker{m: make(map[uint64]*usage, 4)}
+
+// Init is called once upon target startup
+func Init(peers PeersFn) { g.peers = peers }
+
+// Check is called prior to writing `size` bytes (negative or zero size when not known in advance)
+// given the size of the object that is about to be overwritten, or -1 if the object is new (see Prev)
+func Check(bck *meta.Bck, size, prev int64) error {
+	var (
+		config = cmn.GCO.Get()
+		bl     = &bck.Props.Quota
+		nl     = config.NsLimits(bck.Ns)
+	)
+	if !bl.IsSet() && nl == nil {
+		return nil
+	}
+	var (
+		add     = max(size, 0)
+		addObjs = int64(1)
+	)
+	if prev >= 0 {
+		add, addObjs = max(add-prev, 0), 0
+	}
+	u := g.get(bck, config)
+	u.query()
+	if bl.IsSet() {
+		if bsize, bobjs, ok := u.total(); ok {
+			if err := u.check(bl, bck.Cname(""), bsize, bobjs, add, addObjs); err != nil {
+				return err
+			}
+		}
+	}
+	if nl == nil {
+		return nil
+	}
+	nsize, nobjs, ok := g.nsUsage(bck.Ns, config)
+	if !ok {
+		return nil
+	}
+	return u.check(nl, "namespace "+bck.Ns.String(), nsize, nobjs, add, addObjs)
+}
+
+// Prev returns the (logical) size of the object that is about to be overwritten, or -1 if
+// the object does not exist (or the bucket's usage is not tracked).
+// When called under the object's write lock (`locked`), the result is exact.
+func Prev(lom *core.LOM, locked bool) int64 {
+	if g.lookup(lom.Bprops().BID) == nil {
+		return -1
+	}
+	// (loading separately: the caller's `lom` may already carry the new object's metadata)
+	prev := core.AllocLOM(lom.ObjName)
+	defer core.FreeLOM(prev)
+	if err := prev.InitBck(lom.Bucket()); err != nil {
+		return -1
+	}
+	if err := prev.Load(false /*cache it*/, locked); err != nil {
+		return -1
+	}
+	return prev.Lsize()
+}
+
+// Put updates bucket usage upon (successful) write, given the size of the previous version (see Prev)
+func Put(lom *core.LOM, prev int64) {
+	u := g.lookup(lom.Bprops().BID)
+	if u == nil {
+		return
+	}
+	if prev < 0 {
+		u.objs.Inc()
+		u.size.Add(lom.Lsize())
+	} else {
+		u.size.Add(lom.Lsize() - prev)
+	}
+}
+
+// Del updates bucket usage upon object deletion
+func Del(lom *core.LOM) {
+	if u := g.lookup(lom.Bprops().BID); u != nil {
+		u.objs.Dec()
+		u.size.Add(-lom.Lsize())
+	}
+}
+
+// Local returns this target's usage of a given bucket (to be summed up by the querying target),
+// or false if not counted yet; starts tracking upon first request
+func Local(bck *meta.Bck) (Counters, bool) {
+	u := g.get(bck, cmn.GCO.Get())
+	if u.reconciled.Load() == 0 {
+		return Counters{}, false
+	}
+	return Counters{Size: u.size.Load(), Objs: u.objs.Load()}, true
+}
+
+// Usage returns this target's bucket usage, if tracked
+func Usage(bid uint64) (size, objs int64, ok bool) {
+	u := g.lookup(bid)
+	if u == nil || u.reconciled.Load() == 0 {
+		return 0, 0, false
+	}
+	return u.size.Load(), u.objs.Load(), true
+}
+
+/////////////
+// tracker //
+/////////////
+
+func (t *tracker) lookup(bid uint64) (u *usage) {
+	t.mu.RLock()
+	u = t.m[bid]
+	t.mu.RUnlock()
+	return u
+}
+
+// start tracking upon first use; recount when stale
+func (t *tracker) get(bck *meta.Bck, config *cmn.Config) *usage {
+	u := t.lookup(bck.Props.BID)
+	if u == nil {
+		t.mu.Lock()
+		if u = t.m[bck.Props.BID]; u == nil {
+			t.prune()
+			u = &usage{bck: meta.CloneBck(bck.Bucket())}
+			t.m[bck.Props.BID] = u
+		}
+		t.mu.Unlock()
+	}
+	if last := u.reconciled.Load(); last == 0 || mono.Since(last) > config.Quota.ReconcileTime() {
+		u.reconcile(config)
+	}
+	return u
+}
+
+// forget destroyed buckets (under lock)
+func (t *tracker) prune() {
+	bmd := core.T.Bowner().Get()
+	for bid, u := range t.m {
+		if props, present := bmd.Get(u.bck); !present || props.BID != bid {
+			delete(t.m, bid)
+		}
+	}
+}
+
+// cluster-wide usage of all buckets in a given namespace
+func (t *tracker) nsUsage(ns cmn.Ns, config *cmn.Config) (size, objs int64, ok bool) {
+	ok = true
+	core.T.Bowner().Get().Range(nil, &ns, func(bck *meta.Bck) bool {
+		u := t.get(bck, config)
+		u.query()
+		bsize, bobjs, bok := u.total()
+		if !bok {
+			ok = false
+			return true // stop
+		}
+		size += bsize
+		objs += bobjs
+		return false
+	})
+	return size, objs, ok
+}
+
+///////////
+// usage //
+///////////
+
+func (u *usage) set(size, objs int64) {
+	u.size.Store(size)
+	u.objs.Store(objs)
+	u.reconciled.Store(mono.NanoTime())
+}
+
+// cluster-wide
+func (u *usage) total() (size, objs int64, ok bool) {
+	if u.reconciled.Load() == 0 || u.synced.Load() == 0 {
+		return 0, 0, false
+	}
+	return u.size.Load() + u.psize.Load(), u.objs.Load() + u.pobjs.Load(), true
+}
+
+func (u *usage) check(l *cmn.QuotaLimits, entity string, size, objs, add, addObjs int64) error {
+	if err := exceeds(l, entity, size, objs, add, addObjs); err != nil {
+		return err
+	}
+	if soft(l, size, objs, add, addObjs) {
+		if now := mono.NanoTime(); time.Duration(now-u.warned.Load()) > warnEvery {
+			u.warned.Store(now)
+			nlog.Warningf("%s: usage exceeds %d%% of the %s (%s, %d objects)",
+				entity, l.Soft(), l, cos.ToSizeIEC(size, 2), objs)
+		}
+	}
+	return nil
+}
+
+// recount (in the background)
+func (u *usage) reconcile(config *cmn.Config) {
+	if !u.running.CAS(false, true) {
+		return
+	}
+	go u.run(config)
+}
+
+func (u *usage) run(config *cmn.Config) {
+	var (
+		size, objs atomic.Int64
+		started    = mono.NanoTime()
+	)
+	opts := &mpather.JgroupOpts{
+		CTs: []string{fs.ObjectType},
+		Bck: u.bck.Clone(),
+		VisitObj: func(lom *core.LOM, _ []byte) error {
+			size.Add(lom.Lsize())
+			objs.Inc()
+			return nil
+		},
+		DoLoad:   mpather.LoadUnsafe,
+		Throttle: true,
+	}
+	jg := mpather.NewJoggerGroup(opts, config, nil)
+	jg.Run()
+	<-jg.ListenFinished()
+	if err := jg.Stop(); err != nil {
+		nlog.Errorln("quota: failed to recount", u.bck.Cname(""), "usage:", err)
+		u.reconciled.Store(mono.NanoTime()) // (not to retry on every write)
+	} else {
+		u.set(size.Load(), objs.Load())
+		if cmn.Rom.FastV(4, cos.SmoduleAIS) {
+			nlog.Infoln("quota:", u.bck.Cname(""), "usage", cos.ToSizeIEC(size.Load(), 2), objs.Load(), "objects",
+				"[", mono.Since(started), "]")
+		}
+	}
+	u.running.Store(false)
+}
+
+// query other targets (in the background)
+func (u *usage) query() {
+	if last := u.queried.Load(); last != 0 && mono.Since(last) < peersEvery {
+		return
+	}
+	if !u.querying.CAS(false, true) {
+		return
+	}
+	u.queried.Store(mono.NanoTime())
+	go u.runQuery()
+}
+
+func (u *usage) runQuery() {
+	var (
+		c   Counters
+		err error
+	)
+	if g.peers != nil {
+		c, err = g.peers(u.bck)
+	}
+	if err != nil {
+		nlog.Warningln("quota: failed to query", u.bck.Cname(""), "usage:", err)
+	} else {
+		u.psize.Store(c.Size)
+		u.pobjs.Store(c.Objs)
+		u.synced.Store(mono.NanoTime())
+	}
+	u.querying.Store(false)
+}
+
+//
+// limits
+//
+
+// (addObjs: zero when overwriting)
+func exceeds(l *cmn.QuotaLimits, entity string, size, objs, add, addObjs int64) error {
+	if l.Size > 0 && size+add > int64(l.Size) {
+		return cmn.NewErrQuotaExceeded(entity, "size", size, int64(l.Size))
+	}
+	if l.Objects > 0 && addObjs > 0 && objs+addObjs > l.Objects {
+		return cmn.NewErrQuotaExceeded(entity, "objects", objs, l.Objects)
+	}
+	return nil
+}
+
+func soft(l *cmn.QuotaLimits, size, objs, add, addObjs int64) bool {
+	pct := l.Soft()
+	if l.Size > 0 && (size+add)*100 > int64(l.Size)*pct {
+		return true
+	}
+	return l.Objects > 0 && (objs+addObjs)*100 > l.Objects*pct
+}
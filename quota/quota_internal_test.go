@@ -0,0 +1,87 @@
+// Package quota enforces bucket and namespace quotas: total size and number of objects.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package quota
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestExceeds(t *testing.T) {
+	l := &cmn.QuotaLimits{Size: 4 * cos.MiB, Objects: 100}
+	tassert.CheckFatal(t, l.Validate())
+
+	tassert.CheckError(t, exceeds(l, "ais://b", 0, 0, cos.MiB, 1))
+	tassert.CheckError(t, exceeds(l, "ais://b", 3*cos.MiB, 99, cos.MiB, 1))
+	err := exceeds(l, "ais://b", 3*cos.MiB, 10, cos.MiB+1, 1)
+	tassert.Fatalf(t, cmn.IsErrQuotaExceeded(err), "expected quota-exceeded (size), got %v", err)
+	err = exceeds(l, "ais://b", 0, 100, 1, 1)
+	tassert.Fatalf(t, cmn.IsErrQuotaExceeded(err), "expected quota-exceeded (objects), got %v", err)
+
+	// overwrite: no additional objects
+	tassert.CheckError(t, exceeds(l, "ais://b", 0, 100, 1, 0))
+
+	// size only
+	l = &cmn.QuotaLimits{Size: cos.GiB}
+	tassert.CheckError(t, exceeds(l, "ais://b", 0, 1_000_000, cos.MiB, 1))
+}
+
+// cluster-wide usage: this target's and other targets' (as queried)
+func TestTotal(t *testing.T) {
+	l := &cmn.QuotaLimits{Size: 10 * cos.MiB, Objects: 10}
+	u := &usage{}
+	_, _, ok := u.total()
+	tassert.Errorf(t, !ok, "expected no usage until recounted and queried")
+
+	u.set(cos.MiB, 1)
+	_, _, ok = u.total()
+	tassert.Errorf(t, !ok, "expected no usage until queried")
+
+	// skewed placement: most of the usage is elsewhere
+	u.psize.Store(8 * cos.MiB)
+	u.pobjs.Store(8)
+	u.synced.Store(mono.NanoTime())
+	size, objs, ok := u.total()
+	tassert.Fatalf(t, ok && size == 9*cos.MiB && objs == 9, "expected 9MiB and 9 objects, got %d, %d (%t)", size, objs, ok)
+
+	tassert.CheckError(t, u.check(l, "ais://b", size, objs, cos.MiB, 1))
+	err := u.check(l, "ais://b", size, objs, cos.MiB+1, 1)
+	tassert.Fatalf(t, cmn.IsErrQuotaExceeded(err), "expected quota-exceeded (size), got %v", err)
+	// reports the actual (cluster-wide) usage
+	tassert.Errorf(t, strings.Contains(err.Error(), "size 9.00MiB"), "unexpected error %q", err)
+}
+
+func TestSoft(t *testing.T) {
+	l := &cmn.QuotaLimits{Size: 100 * cos.MiB}
+	tassert.Errorf(t, !soft(l, 80*cos.MiB, 0, 10*cos.MiB, 1), "90%% is not above the default soft limit")
+	tassert.Errorf(t, soft(l, 80*cos.MiB, 0, 10*cos.MiB+1, 1), "expected soft limit")
+
+	l.SoftPct = 50
+	tassert.Errorf(t, !soft(l, 50*cos.MiB, 0, 0, 1), "expected no soft limit")
+	tassert.Errorf(t, soft(l, 50*cos.MiB+1, 0, 0, 1), "expected soft limit")
+
+	l = &cmn.QuotaLimits{Objects: 10, SoftPct: 80}
+	tassert.Errorf(t, !soft(l, 0, 7, 0, 1), "expected no soft limit")
+	tassert.Errorf(t, soft(l, 0, 8, 0, 1), "expected soft limit")
+}
+
+func TestValidate(t *testing.T) {
+	for _, l := range []cmn.QuotaLimits{{Size: -1}, {Objects: -1}, {SoftPct: 101}} {
+		tassert.Errorf(t, l.Validate() != nil, "expected %+v to fail validation", l)
+	}
+	conf := cmn.QuotaConf{Namespaces: map[string]*cmn.QuotaLimits{"#team-a": {Objects: 10}}}
+	tassert.CheckFatal(t, conf.Validate())
+	tassert.Errorf(t, conf.NsLimits(cmn.Ns{Name: "team-a"}) != nil, "expected namespace quota")
+	tassert.Errorf(t, conf.NsLimits(cmn.Ns{Name: "team-b"}) == nil, "expected no namespace quota")
+	tassert.Errorf(t, conf.NsLimits(cmn.NsGlobal) == nil, "expected no namespace quota")
+
+	conf.Namespaces[""] = &cmn.QuotaLimits{Size: cos.GiB}
+	tassert.Errorf(t, conf.Validate() != nil, "global namespace quota is not supported")
+}
@@ -156,7 +156,7 @@ func (reb *Reb) sendFromDisk(ct *core.CT, md *ec.Metadata, target *meta.Snode, w
 
 	xctn := reb.xctn()
 	xctn.OutObjsAdd(1, o.Hdr.ObjAttrs.Size)
-	return nil
+	return reb.thr.Acquire(o.Hdr.ObjAttrs.Size)
 }
 
 // Saves received CT to a local drive if needed:
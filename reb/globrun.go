@@ -27,6 +27,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/ec"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact"
@@ -72,6 +73,7 @@ type (
 			mtx     sync.Mutex
 		}
 		lazydel lazydel
+		thr     *xs.Throttle // bandwidth, latency, and time-of-day window (current run)
 		// (smap, xreb) + atomic state
 		rebID atomic.Int64
 		// quiescence
@@ -86,6 +88,7 @@ type (
 		Prefix string    // ditto
 		Oxid   string    // oldRMD g[version]
 		NID    int64     // newRMD version
+		Auto   bool      // automatic (i.e., not user-requested) - subject to rebalance.window
 	}
 )
 
@@ -267,7 +270,7 @@ func (reb *Reb) RunRebalance(smap *meta.Smap, extArgs *ExtArgs) {
 	if bmd.IsEmpty() {
 		haveStreams = false
 	}
-	if !reb.initRenew(rargs, extArgs.Notif, haveStreams) {
+	if !reb.initRenew(rargs, extArgs, haveStreams) {
 		reb.dm.UnregRecv()
 		return
 	}
@@ -364,7 +367,7 @@ func _pingall(rargs *rebArgs) bool {
 	return true
 }
 
-func (reb *Reb) initRenew(rargs *rebArgs, notif *xact.NotifXact, haveStreams bool) bool {
+func (reb *Reb) initRenew(rargs *rebArgs, extArgs *ExtArgs, haveStreams bool) bool {
 	var ctlmsg string
 	if rargs.bck != nil && !rargs.bck.IsEmpty() {
 		ctlmsg = rargs.bck.Cname(rargs.prefix)
@@ -379,13 +382,14 @@ func (reb *Reb) initRenew(rargs *rebArgs, notif *xact.NotifXact, haveStreams boo
 	xctn := rns.Entry.Get()
 	rargs.xreb = xctn.(*xs.Rebalance)
 
-	notif.Xact = rargs.xreb
-	rargs.xreb.AddNotif(notif)
+	extArgs.Notif.Xact = rargs.xreb
+	rargs.xreb.AddNotif(extArgs.Notif)
 
 	reb.mu.Lock()
 
 	reb.stages.stage.Store(rebStageInit)
 	reb.setXact(rargs.xreb)
+	reb.thr = xs.NewThrottle(&rargs.xreb.Base, extArgs.Tstats, extArgs.Auto)
 	reb.rebID.Store(rargs.id)
 
 	// prior to opening streams:
@@ -852,6 +856,16 @@ func (rj *rebJogger) _lwalk(lom *core.LOM, fqn string) error {
 		return err
 	}
 	if tsi.ID() == core.T.SID() {
+		// mirroring across targets: re-replicate, if need be
+		if mirror.IsOwner(lom, rj.rargs.smap) {
+			if _, err := mirror.SendReplicas(lom, rj.rargs.smap, true /*missing*/); err != nil {
+				nlog.Warningln(rj.rargs.logHdr, "failed to re-replicate", lom.Cname(), "err:", err)
+			}
+		}
+		return cmn.ErrSkip
+	}
+	// replica holder: keep the replica; send it only if the owner doesn't have it
+	if mirror.IsReplica(lom, rj.rargs.smap) && core.T.HeadObjT2T(lom, tsi) {
 		return cmn.ErrSkip
 	}
 
@@ -870,13 +884,15 @@ func (rj *rebJogger) _lwalk(lom *core.LOM, fqn string) error {
 	}
 
 	// transmit (unlock via transport completion => roc.Close)
+	size := lom.Lsize()
 	rj.m.addLomAck(lom)
 	if err := rj.doSend(lom, tsi, roc); err != nil {
 		rj.m.cleanupLomAck(lom)
 		return err
 	}
 
-	return nil
+	// throttle (see cmn.RebalanceConf)
+	return rj.m.thr.Acquire(size)
 }
 
 // takes rlock and keeps it _iff_ successful
@@ -0,0 +1,144 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// rebalance dry-run: given the current cluster map and a (hypothetical) membership change,
+// walk local mountpaths and count objects (and bytes) that would be sent to their new HRW owners
+
+type planJogger struct {
+	nsmap *meta.Smap
+	dests map[string]*apc.RebPlanCnt
+	opts  fs.WalkOpts
+}
+
+// NewPlanSmap returns the post-change cluster map (targets only, which is all that's needed
+// to compute HRW ownership)
+func NewPlanSmap(smap *meta.Smap, msg *apc.RebPlanMsg) (*meta.Smap, error) {
+	nsmap := &meta.Smap{
+		Tmap:    make(meta.NodeMap, len(smap.Tmap)+len(msg.Add)),
+		Version: smap.Version,
+		UUID:    smap.UUID,
+	}
+	for tid, tsi := range smap.Tmap {
+		nsmap.Tmap[tid] = tsi
+	}
+	for _, tid := range msg.Remove {
+		if smap.GetTarget(tid) == nil {
+			return nil, fmt.Errorf("cannot remove target %s: not present in the %s", tid, smap)
+		}
+		delete(nsmap.Tmap, tid)
+	}
+	for _, tid := range msg.Add {
+		if osi := smap.GetNode(tid); osi != nil {
+			// (stop-maintenance)
+			if !osi.IsTarget() || !osi.InMaintOrDecomm() {
+				return nil, fmt.Errorf("cannot add target %s: already present in the %s", tid, smap)
+			}
+			tsi := osi.Clone()
+			tsi.Flags = tsi.Flags.Clear(meta.SnodeMaintDecomm)
+			nsmap.Tmap[tid] = tsi
+			continue
+		}
+		tsi := &meta.Snode{}
+		tsi.Init(tid, apc.Target)
+		nsmap.Tmap[tid] = tsi
+	}
+	if nsmap.CountActiveTs() == 0 {
+		return nil, cmn.NewErrNoNodes(apc.Target, len(smap.Tmap))
+	}
+	return nsmap, nil
+}
+
+// Plan walks all local buckets in parallel (one goroutine per mountpath)
+func Plan(nsmap *meta.Smap) (*apc.RebPlan, error) {
+	var (
+		wg      sync.WaitGroup
+		bmd     = core.T.Bowner().Get()
+		avail   = fs.GetAvail()
+		joggers = make([]*planJogger, 0, len(avail))
+		errs    = make(chan error, len(avail))
+	)
+	for _, mi := range avail {
+		pj := &planJogger{nsmap: nsmap, dests: make(map[string]*apc.RebPlanCnt, 4)}
+		pj.opts.Mi = mi
+		pj.opts.CTs = []string{fs.ObjectType}
+		pj.opts.Callback = pj.visitObj
+		joggers = append(joggers, pj)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+				pj.opts.Bck.Copy(bck.Bucket())
+				if err := fs.Walk(&pj.opts); err != nil {
+					errs <- err
+					return true
+				}
+				return false
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	var (
+		plan = &apc.RebPlan{}
+		tid  = core.T.SID()
+	)
+	plan.Init()
+	for _, pj := range joggers {
+		for dst, cnt := range pj.dests {
+			plan.Move(tid, dst, cnt.Objs, cnt.Bytes)
+		}
+	}
+	return plan, nil
+}
+
+func (pj *planJogger) visitObj(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	lom := core.AllocLOM(fqn)
+	defer core.FreeLOM(lom)
+	if err := lom.InitFQN(fqn, nil); err != nil {
+		if cmn.IsErrBucketLevel(err) {
+			return err
+		}
+		return nil
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return nil // (removed in the meantime or not an object)
+	}
+	if lom.IsCopy() {
+		return nil
+	}
+	tsi, err := pj.nsmap.HrwHash2T(lom.Digest())
+	if err != nil {
+		return err
+	}
+	if tsi.ID() == core.T.SID() {
+		return nil
+	}
+	cnt, ok := pj.dests[tsi.ID()]
+	if !ok {
+		cnt = &apc.RebPlanCnt{}
+		pj.dests[tsi.ID()] = cnt
+	}
+	cnt.Add(1, lom.Lsize())
+	return nil
+}
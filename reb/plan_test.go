@@ -0,0 +1,76 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb_test
+
+import (
+	"strconv"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/reb"
+
+	onexxh "github.com/OneOfOne/xxhash"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rebalance plan", func() {
+	newSmap := func(numTargets int) *meta.Smap {
+		smap := &meta.Smap{Tmap: make(meta.NodeMap, numTargets), Pmap: make(meta.NodeMap), Version: 10}
+		for i := range numTargets {
+			tsi := &meta.Snode{}
+			tsi.Init("t"+strconv.Itoa(i), apc.Target)
+			smap.Tmap[tsi.ID()] = tsi
+		}
+		return smap
+	}
+
+	It("should add and remove targets", func() {
+		smap := newSmap(4)
+		nsmap, err := reb.NewPlanSmap(smap, &apc.RebPlanMsg{Add: []string{"t8", "t9"}, Remove: []string{"t0"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nsmap.CountActiveTs()).To(Equal(5))
+		Expect(nsmap.GetTarget("t0")).To(BeNil())
+		Expect(nsmap.GetTarget("t9")).NotTo(BeNil())
+		Expect(smap.CountActiveTs()).To(Equal(4)) // unchanged
+	})
+
+	It("should fail to add existing or remove missing targets", func() {
+		smap := newSmap(3)
+		_, err := reb.NewPlanSmap(smap, &apc.RebPlanMsg{Add: []string{"t1"}})
+		Expect(err).To(HaveOccurred())
+		_, err = reb.NewPlanSmap(smap, &apc.RebPlanMsg{Remove: []string{"t7"}})
+		Expect(err).To(HaveOccurred())
+		_, err = reb.NewPlanSmap(smap, &apc.RebPlanMsg{Remove: []string{"t0", "t1", "t2"}})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should re-activate target in maintenance", func() {
+		smap := newSmap(3)
+		smap.Tmap["t2"].Flags = smap.Tmap["t2"].Flags.Set(meta.SnodeMaint)
+		Expect(smap.CountActiveTs()).To(Equal(2))
+		nsmap, err := reb.NewPlanSmap(smap, &apc.RebPlanMsg{Add: []string{"t2"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nsmap.CountActiveTs()).To(Equal(3))
+		Expect(smap.Tmap["t2"].InMaint()).To(BeTrue()) // unchanged
+	})
+
+	It("should move only objects owned by the removed target", func() {
+		smap := newSmap(5)
+		nsmap, err := reb.NewPlanSmap(smap, &apc.RebPlanMsg{Remove: []string{"t3"}})
+		Expect(err).NotTo(HaveOccurred())
+		for i := range 1000 {
+			digest := onexxh.Checksum64S([]byte("obj-"+strconv.Itoa(i)), cos.MLCG32)
+			before, _ := smap.HrwHash2T(digest)
+			after, _ := nsmap.HrwHash2T(digest)
+			if before.ID() != "t3" {
+				Expect(after.ID()).To(Equal(before.ID()))
+			} else {
+				Expect(after.ID()).NotTo(Equal("t3"))
+			}
+		}
+	})
+})
@@ -17,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/mirror"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/xact/xs"
 )
@@ -190,8 +191,9 @@ func (reb *Reb) ackLomAck(lom *core.LOM, rebID int64) {
 	xreb.ObjsAdd(1, size)
 
 	// NOTE: rm migrated object (and local copies, if any) right away
+	// (unless it is a replica that must stay - see mirror.placement)
 	// TODO [feature]: mark "deleted" instead
-	if !cmn.Rom.Features().IsSet(feat.DontDeleteWhenRebalancing) {
+	if !cmn.Rom.Features().IsSet(feat.DontDeleteWhenRebalancing) && !mirror.IsReplica(lom, core.T.Sowner().Get()) {
 		lom.UncacheDel()
 		reb.lazydel.enqueue(lom.LIF(), xreb.Name(), rebID)
 	}
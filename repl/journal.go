@@ -0,0 +1,168 @@
+// Package repl provides asynchronous (journaled) replication of bucket content
+// to a remote AIS cluster or cloud.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package repl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Journal: append-only file of (newline-separated, JSON-encoded) events and
+// a separate cursor file containing the offset of the first not-yet-shipped event.
+// Delivery semantics: at-least-once (the cursor is persisted periodically).
+
+const (
+	jnlExt = ".jnl"
+	curExt = ".off"
+)
+
+type (
+	event struct {
+		Op   string `json:"op"`
+		Name string `json:"name"`
+		Ts   int64  `json:"ts"` // when journaled (unix nano)
+	}
+	journal struct {
+		fh    *os.File // append
+		rfh   *os.File // read
+		rd    *bufio.Reader
+		wake  chan struct{}
+		fpath string
+		cpath string
+		size  int64 // total appended
+		off   int64 // shipped so far
+		saved int64 // last persisted cursor
+		mu    sync.Mutex
+	}
+)
+
+func openJournal(dir, name string) (j *journal, err error) {
+	j = &journal{
+		fpath: filepath.Join(dir, name+jnlExt),
+		cpath: filepath.Join(dir, name+curExt),
+		wake:  make(chan struct{}, 1),
+	}
+	if j.fh, err = os.OpenFile(j.fpath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, cos.PermRWR); err != nil {
+		return nil, err
+	}
+	if j.rfh, err = os.Open(j.fpath); err != nil {
+		j.fh.Close()
+		return nil, err
+	}
+	finfo, err := j.fh.Stat()
+	if err != nil {
+		j.close()
+		return nil, err
+	}
+	j.size = finfo.Size()
+	if b, errV := os.ReadFile(j.cpath); errV == nil {
+		if off, errV := strconv.ParseInt(string(b), 10, 64); errV == nil && off <= j.size {
+			j.off, j.saved = off, off
+		}
+	}
+	if _, err := j.rfh.Seek(j.off, io.SeekStart); err != nil {
+		j.close()
+		return nil, err
+	}
+	j.rd = bufio.NewReader(j.rfh)
+	return j, nil
+}
+
+func (j *journal) close() {
+	cos.Close(j.fh)
+	cos.Close(j.rfh)
+}
+
+func (j *journal) remove() {
+	j.close()
+	cos.RemoveFile(j.fpath)
+	cos.RemoveFile(j.cpath)
+}
+
+func (j *journal) append(ev *event) error {
+	b, err := jsoniter.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	j.mu.Lock()
+	n, err := j.fh.Write(b)
+	j.size += int64(n)
+	j.mu.Unlock()
+
+	// wake up shipper
+	select {
+	case j.wake <- struct{}{}:
+	default:
+	}
+	return err
+}
+
+// returns the next event (or nil when caught up) and its end offset
+func (j *journal) next() (*event, int64, error) {
+	j.mu.Lock()
+	caughtUp := j.off >= j.size
+	j.mu.Unlock()
+	if caughtUp {
+		return nil, 0, nil
+	}
+	line, err := j.rd.ReadBytes('\n')
+	if err != nil {
+		return nil, 0, fmt.Errorf("journal %s: failed to read at offset %d: %v", j.fpath, j.off, err)
+	}
+	ev := &event{}
+	if err := jsoniter.Unmarshal(line, ev); err != nil {
+		return nil, 0, fmt.Errorf("journal %s: corrupted event at offset %d: %v", j.fpath, j.off, err)
+	}
+	return ev, j.off + int64(len(line)), nil
+}
+
+func (j *journal) advance(off int64) {
+	j.mu.Lock()
+	j.off = off
+	j.mu.Unlock()
+}
+
+func (j *journal) pending() (n int64) {
+	j.mu.Lock()
+	n = j.size - j.off
+	j.mu.Unlock()
+	return n
+}
+
+// persist the cursor; when fully caught up, truncate the journal
+func (j *journal) sync(compact bool) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if compact && j.off == j.size && j.size > 0 {
+		if err := j.fh.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := j.rfh.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		j.rd.Reset(j.rfh)
+		j.size, j.off = 0, 0
+	}
+	if j.off == j.saved {
+		return nil
+	}
+	if err := os.WriteFile(j.cpath, []byte(strconv.FormatInt(j.off, 10)), cos.PermRWR); err != nil {
+		return err
+	}
+	j.saved = j.off
+	return nil
+}
@@ -0,0 +1,66 @@
+// Package repl provides asynchronous (journaled) replication of bucket content
+// to a remote AIS cluster or cloud.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package repl
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestJournal(t *testing.T) {
+	const num = 10
+	dir := t.TempDir()
+
+	j, err := openJournal(dir, "test")
+	tassert.CheckFatal(t, err)
+	for i := range num {
+		err := j.append(&event{Op: OpPut, Name: "obj-" + strconv.Itoa(i), Ts: int64(i)})
+		tassert.CheckFatal(t, err)
+	}
+
+	// ship (half)
+	for i := range num / 2 {
+		ev, off, err := j.next()
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, ev != nil && ev.Name == "obj-"+strconv.Itoa(i), "expected obj-%d, got %+v", i, ev)
+		j.advance(off)
+	}
+	tassert.CheckFatal(t, j.sync(true /*compact*/))
+	j.close()
+
+	// reopen and resume
+	j, err = openJournal(dir, "test")
+	tassert.CheckFatal(t, err)
+	for i := num / 2; i < num; i++ {
+		ev, off, err := j.next()
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, ev != nil && ev.Name == "obj-"+strconv.Itoa(i), "expected obj-%d, got %+v", i, ev)
+		j.advance(off)
+	}
+	ev, _, err := j.next()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, ev == nil, "expected caught-up journal, got %+v", ev)
+
+	// compact when caught up
+	tassert.CheckFatal(t, j.sync(true))
+	finfo, err := os.Stat(j.fpath)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, finfo.Size() == 0, "expected empty (compacted) journal, got size %d", finfo.Size())
+
+	// append after compaction
+	tassert.CheckFatal(t, j.append(&event{Op: OpDel, Name: "obj-0"}))
+	ev, _, err = j.next()
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, ev != nil && ev.Op == OpDel && ev.Name == "obj-0", "unexpected %+v", ev)
+	tassert.Errorf(t, j.pending() > 0, "expected pending event")
+
+	j.remove()
+	_, err = os.Stat(j.fpath)
+	tassert.Errorf(t, os.IsNotExist(err), "expected journal removed, got %v", err)
+}
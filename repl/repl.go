@@ -0,0 +1,305 @@
+// Package repl provides asynchronous (journaled) replication of bucket content
+// to a remote AIS cluster or cloud.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package repl
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact/xreg"
+
+	onexxh "github.com/OneOfOne/xxhash"
+)
+
+// Each target journals (and ships) events that pertain to the objects it owns:
+// - PUT, APPEND, and promote => OpPut
+// - DELETE => OpDel
+// - rename => OpPut(new name) followed by OpDel(old name)
+//
+// Ordering is preserved per bucket per target; shipping a PUT reads the object's
+// _current_ content, which makes repeated (or out-of-date) events idempotent.
+// Retries never give up: failures are counted (err.repl.n) and backed off.
+
+const (
+	OpPut = "put"
+	OpDel = "del"
+)
+
+const (
+	Dirname = "ais.repl" // in the config dir
+
+	syncEvery  = 64               // events between cursor updates
+	idleTime   = 30 * time.Second // periodically: persist cursor, compact, and check bucket props
+	backoffMin = time.Second
+	backoffMax = time.Minute
+)
+
+type (
+	shipper struct {
+		j     *journal
+		bck   cmn.Bck
+		vlabs map[string]string
+		cnt   int
+	}
+	manager struct {
+		tstats cos.StatsUpdater
+		smap   map[string]*shipper // by bucket uname
+		dir    string
+		mu     sync.Mutex
+	}
+)
+
+var (
+	g manager
+
+	errGone = errors.New("replication disabled or bucket does not exist")
+)
+
+func Init(config *cmn.Config, tstats cos.StatsUpdater) {
+	g.dir = filepath.Join(config.ConfigDir, Dirname)
+	g.tstats = tstats
+	g.smap = make(map[string]*shipper, 4)
+	xreg.RegBckXact(&resyncFactory{})
+	if err := cos.CreateDir(g.dir); err != nil {
+		nlog.Errorln("replication: failed to create journal dir:", err)
+		return
+	}
+
+	// resume
+	var (
+		bmd  = core.T.Bowner().Get()
+		keep = make(cos.StrSet, 4)
+	)
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if !bck.Props.Repl.Enabled {
+			return false
+		}
+		name := jname(bck.Bucket())
+		if _, err := os.Stat(filepath.Join(g.dir, name+jnlExt)); err != nil {
+			return false
+		}
+		keep.Add(name + jnlExt)
+		keep.Add(name + curExt)
+		if _, err := g.get(bck.Bucket()); err != nil {
+			nlog.Errorln("replication:", bck.String(), err)
+		}
+		return false
+	})
+	entries, err := os.ReadDir(g.dir)
+	if err != nil {
+		return
+	}
+	for _, de := range entries {
+		if !keep.Contains(de.Name()) {
+			cos.RemoveFile(filepath.Join(g.dir, de.Name()))
+		}
+	}
+}
+
+// Enqueue journals the event and returns immediately (noop when replication is disabled)
+func Enqueue(bck *meta.Bck, objName, op string) {
+	if bck.Props == nil || !bck.Props.Repl.Enabled || g.dir == "" {
+		return
+	}
+	s, err := g.get(bck.Bucket())
+	if err == nil {
+		err = s.j.append(&event{Op: op, Name: objName, Ts: time.Now().UnixNano()})
+	}
+	if err != nil {
+		nlog.Errorln("replication: failed to journal", op, bck.Cname(objName), "err:", err)
+		return
+	}
+	g.tstats.IncWith(stats.ReplEnqueueCount, s.vlabs)
+}
+
+func jname(bck *cmn.Bck) string {
+	return strconv.FormatUint(onexxh.Checksum64S(bck.MakeUname(""), cos.MLCG32), 36)
+}
+
+/////////////
+// manager //
+/////////////
+
+func (m *manager) get(bck *cmn.Bck) (*shipper, error) {
+	uname := string(bck.MakeUname(""))
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.smap[uname]; ok {
+		return s, nil
+	}
+	j, err := openJournal(m.dir, jname(bck))
+	if err != nil {
+		return nil, err
+	}
+	s := &shipper{
+		j:     j,
+		bck:   *bck,
+		vlabs: map[string]string{stats.VlabBucket: bck.Cname("")},
+	}
+	m.smap[uname] = s
+	go s.run()
+	return s, nil
+}
+
+func (m *manager) del(s *shipper) {
+	m.mu.Lock()
+	delete(m.smap, string(s.bck.MakeUname("")))
+	m.mu.Unlock()
+	s.j.remove()
+}
+
+/////////////
+// shipper //
+/////////////
+
+func (s *shipper) run() {
+	var (
+		ev      *event
+		off     int64
+		backoff time.Duration
+		err     error
+		ticker  = time.NewTicker(idleTime)
+	)
+	defer ticker.Stop()
+	nlog.Infoln("replication: start shipping", s.bck.String())
+	for {
+		if ev == nil {
+			if ev, off, err = s.j.next(); err != nil {
+				// (unlikely) corrupted or unreadable journal: drop it and rely on resync
+				nlog.Errorln("replication:", err, "- discarding journal, resync required")
+				g.del(s)
+				return
+			}
+		}
+		if ev == nil {
+			if err := s.j.sync(true); err != nil {
+				nlog.Errorln("replication:", s.bck.String(), err)
+			}
+			select {
+			case <-s.j.wake:
+			case <-ticker.C:
+				if _, err := s.props(); err == errGone {
+					nlog.Infoln("replication: stop shipping", s.bck.String())
+					g.del(s)
+					return
+				}
+			}
+			continue
+		}
+
+		size, ecode, err := s.ship(ev)
+		switch {
+		case err == nil:
+			s.j.advance(off)
+			g.tstats.AddWith(
+				cos.NamedVal64{Name: stats.ReplCount, Value: 1, VarLabs: s.vlabs},
+				cos.NamedVal64{Name: stats.ReplSize, Value: size, VarLabs: s.vlabs},
+				cos.NamedVal64{Name: stats.ReplLagTotal, Value: time.Now().UnixNano() - ev.Ts, VarLabs: s.vlabs},
+			)
+			ev, backoff = nil, 0
+			if s.cnt++; s.cnt%syncEvery == 0 {
+				if err := s.j.sync(false); err != nil {
+					nlog.Errorln("replication:", s.bck.String(), err)
+				}
+			}
+		case err == errGone:
+			nlog.Infoln("replication: stop shipping", s.bck.String(), "[", err, "]")
+			g.del(s)
+			return
+		default:
+			g.tstats.IncWith(stats.ErrReplCount, s.vlabs)
+			if backoff == 0 {
+				backoff = backoffMin
+				nlog.Warningln("replication: failed to ship", ev.Op, s.bck.Cname(ev.Name), "err:", err, ecode, "- retrying...")
+			} else {
+				backoff = min(backoff*2, backoffMax)
+			}
+			time.Sleep(backoff)
+		}
+	}
+}
+
+// source bucket props (must exist and have replication enabled)
+func (s *shipper) props() (*cmn.Bprops, error) {
+	bck := meta.CloneBck(&s.bck)
+	if err := bck.Init(core.T.Bowner()); err != nil {
+		if cmn.IsErrBckNotFound(err) || cmn.IsErrRemoteBckNotFound(err) {
+			return nil, errGone
+		}
+		return nil, err
+	}
+	if !bck.Props.Repl.Enabled {
+		return nil, errGone
+	}
+	return bck.Props, nil
+}
+
+func (s *shipper) ship(ev *event) (size int64, ecode int, err error) {
+	props, err := s.props()
+	if err != nil {
+		return 0, 0, err
+	}
+	dst, err := props.Repl.DstBck()
+	if err != nil {
+		return 0, 0, err
+	}
+	bckTo := meta.CloneBck(dst)
+	if err := bckTo.Init(core.T.Bowner()); err != nil {
+		return 0, 0, err
+	}
+	lomTo := core.AllocLOM(ev.Name)
+	defer core.FreeLOM(lomTo)
+	if err := lomTo.InitBck(bckTo.Bucket()); err != nil {
+		return 0, 0, err
+	}
+	bp := core.T.Backend(bckTo)
+
+	switch ev.Op {
+	case OpDel:
+		ecode, err = bp.DeleteObj(context.Background(), lomTo)
+		if err != nil && cos.IsNotExist(err, ecode) {
+			err = nil
+		}
+		return 0, ecode, err
+	case OpPut:
+		lom := core.AllocLOM(ev.Name)
+		defer core.FreeLOM(lom)
+		if err := lom.InitBck(&s.bck); err != nil {
+			return 0, 0, err
+		}
+		lom.Lock(false)
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			lom.Unlock(false)
+			if cos.IsNotExist(err, 0) {
+				return 0, 0, nil // deleted or renamed in the meantime (and will be journaled as such)
+			}
+			return 0, 0, err
+		}
+		fh, err := lom.NewDeferROC(true /*loaded*/) // (unlocks upon close)
+		if err != nil {
+			return 0, 0, err
+		}
+		lomTo.CopyAttrs(lom.ObjAttrs(), false /*skip cksum*/)
+		if ecode, err = bp.PutObj(context.Background(), fh, lomTo, nil); err != nil {
+			return 0, ecode, err
+		}
+		return lom.Lsize(), 0, nil
+	default:
+		nlog.Errorln("replication: skipping invalid event", ev.Op, s.bck.Cname(ev.Name))
+		return 0, 0, nil
+	}
+}
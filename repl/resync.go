@@ -0,0 +1,163 @@
+// Package repl provides asynchronous (journaled) replication of bucket content
+// to a remote AIS cluster or cloud.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package repl
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-repl-resync (to fix drift between source and destination):
+// 1. journal all locally stored objects (OpPut)
+// 2. list destination bucket and journal OpDel for each object that is owned by this target
+//    but does not exist in the source bucket
+// In both cases, the actual shipping is done by the (regular) shipper.
+
+type (
+	resyncFactory struct {
+		xreg.RenewBase
+		xctn *XactResync
+	}
+	XactResync struct {
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*XactResync)(nil)
+	_ xreg.Renewable = (*resyncFactory)(nil)
+)
+
+///////////////////
+// resyncFactory //
+///////////////////
+
+func (*resyncFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &resyncFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *resyncFactory) Start() error {
+	if !p.Bck.Props.Repl.Enabled {
+		return fmt.Errorf("cannot resync %s: replication is not enabled", p.Bck.String())
+	}
+	xctn := newResync(p.UUID(), p.Bck)
+	p.xctn = xctn
+	go xctn.Run(nil)
+	return nil
+}
+
+func (*resyncFactory) Kind() string     { return apc.ActReplResync }
+func (p *resyncFactory) Get() core.Xact { return p.xctn }
+
+func (*resyncFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+////////////////
+// XactResync //
+////////////////
+
+func newResync(uuid string, bck *meta.Bck) (r *XactResync) {
+	r = &XactResync{}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		DoLoad:   mpather.Load,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActReplResync, "" /*ctlmsg*/, bck, mpopts, cmn.GCO.Get())
+	return r
+}
+
+func (r *XactResync) Run(*sync.WaitGroup) {
+	nlog.Infoln(r.Name())
+	r.BckJog.Run()
+	err := r.BckJog.Wait()
+	if err == nil {
+		err = r.rmExtra()
+	}
+	if err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *XactResync) visitObj(lom *core.LOM, _ []byte) error {
+	if lom.IsCopy() {
+		return nil
+	}
+	Enqueue(r.Bck(), lom.ObjName, OpPut)
+	r.ObjsAdd(1, lom.Lsize())
+	return nil
+}
+
+func (r *XactResync) rmExtra() error {
+	bck := r.Bck()
+	dst, err := bck.Props.Repl.DstBck()
+	if err != nil {
+		return err
+	}
+	bckTo := meta.CloneBck(dst)
+	if err := bckTo.Init(core.T.Bowner()); err != nil {
+		return err
+	}
+	var (
+		smap  = core.T.Sowner().Get()
+		bp    = core.T.Backend(bckTo)
+		lsmsg = &apc.LsoMsg{Flags: apc.LsNoDirs}
+	)
+	for {
+		lst := &cmn.LsoRes{}
+		if _, err := bp.ListObjects(bckTo, lsmsg, lst); err != nil {
+			return err
+		}
+		for _, en := range lst.Entries {
+			if r.IsAborted() {
+				return nil
+			}
+			if en.IsAnyFlagSet(apc.EntryIsDir) {
+				continue
+			}
+			r.rmOne(bck, en.Name, smap)
+		}
+		if lst.ContinuationToken == "" {
+			return nil
+		}
+		lsmsg.ContinuationToken = lst.ContinuationToken
+	}
+}
+
+func (*XactResync) rmOne(bck *meta.Bck, objName string, smap *meta.Smap) {
+	lom := core.AllocLOM(objName)
+	defer core.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		return
+	}
+	if _, local, err := lom.HrwTarget(smap); err != nil || !local {
+		return
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil && cos.IsNotExist(err, 0) {
+		Enqueue(bck, objName, OpDel)
+	}
+}
+
+func (r *XactResync) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
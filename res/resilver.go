@@ -46,6 +46,7 @@ type (
 	}
 	joggerCtx struct {
 		xres *xs.Resilver
+		thr  *xs.Throttle
 	}
 )
 
@@ -102,10 +103,10 @@ func (res *Res) RunResilver(args *Args, tstats cos.StatsUpdater) {
 	var (
 		jg        *mpather.Jgroup
 		slab, err = core.T.PageMM().GetSlab(memsys.MaxPageSlabSize)
-		jctx      = &joggerCtx{xres: xres}
+		jctx      = &joggerCtx{xres: xres, thr: xs.NewThrottle(&xres.Base, tstats, false /*auto*/)}
 
 		opts = &mpather.JgroupOpts{
-			CTs:      []string{fs.ObjectType, fs.ECSliceType},
+			CTs:      []string{fs.ObjectType, fs.ECSliceType, fs.ChunkType},
 			VisitObj: jctx.visitObj,
 			VisitCT:  jctx.visitCT,
 			Slab:     slab,
@@ -263,6 +264,8 @@ func (jg *joggerCtx) visitObj(lom *core.LOM, buf []byte) (errHrw error) {
 		lom.Unlock(true)
 		if copied && errHrw == nil {
 			jg.xres.ObjsAdd(1, size)
+			// throttle (see cmn.RebalanceConf) - not holding the lock
+			errHrw = jg.thr.Acquire(size)
 		}
 	}()
 
@@ -289,6 +292,10 @@ func (jg *joggerCtx) visitObj(lom *core.LOM, buf []byte) (errHrw error) {
 		return nil
 	}
 	size = lom.Lsize()
+	if lom.IsPacked() || lom.IsDeduped() {
+		copied, errHrw = jg.visitMoved(lom)
+		return errHrw
+	}
 	// 2. fix hrw location; fail and subsequently abort if unsuccessful
 	var (
 		retries   int
@@ -372,6 +379,32 @@ ret:
 	return nil
 }
 
+// packed object: move its record to the hrw mountpath (see core/lpack.go)
+// deduplicated object: move its stub and reference (or copy) the content (see core/ldedup.go)
+func (jg *joggerCtx) visitMoved(lom *core.LOM) (bool, error) {
+	mi, isHrw := lom.ToMpath()
+	if !isHrw {
+		return false, nil
+	}
+	move, what := lom.MovePacked, "packed"
+	if lom.IsDeduped() {
+		move, what = lom.MoveDeduped, "deduplicated"
+	}
+	err := move(mi)
+	switch {
+	case err == nil:
+		return true, nil
+	case cos.IsErrOOS(err):
+		errV := fmt.Errorf("%s: %s OOS, err: %w", core.T, mi, err)
+		err = cmn.NewErrAborted(jg.xres.Name(), "", errV)
+		jg.xres.Abort(err)
+		return false, err
+	case !cos.IsNotExist(err):
+		jg.xres.AddErr(fmt.Errorf("%s: failed to move %s %s to %s: %w", jg.xres.Name(), what, lom, mi, err), 0)
+	}
+	return false, nil
+}
+
 func (*joggerCtx) fixHrw(lom *core.LOM, mi *fs.Mountpath, buf []byte) (hlom *core.LOM, err error) {
 	if err = lom.Copy(mi, buf); err != nil {
 		return
@@ -389,12 +422,29 @@ func (*joggerCtx) fixHrw(lom *core.LOM, mi *fs.Mountpath, buf []byte) (hlom *cor
 }
 
 func (jg *joggerCtx) visitCT(ct *core.CT, buf []byte) (err error) {
-	debug.Assert(ct.ContentType() == fs.ECSliceType)
-	if !ct.Bck().Props.EC.Enabled {
-		// Since `%ec` directory is inside a bucket, it is safe to skip
-		// the entire `%ec` directory when EC is disabled for the bucket.
-		return filepath.SkipDir
+	switch ct.ContentType() {
+	case fs.ECSliceType:
+		if !ct.Bck().Props.EC.Enabled {
+			// Since `%ec` directory is inside a bucket, it is safe to skip
+			// the entire `%ec` directory when EC is disabled for the bucket.
+			return filepath.SkipDir
+		}
+		jg._mvSlice(ct, buf)
+	case fs.ChunkType:
+		jg._mvChunk(ct, buf)
+	default:
+		debug.Assert(false, "unexpected content type: ", ct.ContentType())
 	}
-	jg._mvSlice(ct, buf)
 	return nil
 }
+
+// chunks of chunked objects are placed independently (see core/lchunk.go)
+func (jg *joggerCtx) _mvChunk(ct *core.CT, buf []byte) {
+	moved, err := core.MoveChunk(ct, buf)
+	switch {
+	case err != nil:
+		jg.xres.AddErr(err)
+	case moved && cmn.Rom.FastV(4, cos.SmoduleReb):
+		nlog.Infof("%s: moved chunk %q", core.T, ct.FQN())
+	}
+}
@@ -65,11 +65,13 @@ type (
 		// runtime
 		oldWork   []string
 		misplaced struct {
-			loms []*core.LOM
-			ec   []*core.CT // EC slices and replicas without corresponding metafiles (CT FQN -> Meta FQN)
+			loms   []*core.LOM
+			ec     []*core.CT // EC slices and replicas without corresponding metafiles (CT FQN -> Meta FQN)
+			chunks []*core.CT // chunks that may not be referenced by their respective objects (see core/lchunk.go)
 		}
-		bck cmn.Bck
-		now int64
+		drefs map[string]struct{} // deduplicated content referenced by objects on this mountpath
+		bck   cmn.Bck
+		now   int64
 		// init-time
 		p       *clnP
 		ini     *IniCln
@@ -154,6 +156,7 @@ func RunCleanup(ini *IniCln) fs.CapStatus {
 		}
 		joggers[mpath].misplaced.loms = make([]*core.LOM, 0, 64)
 		joggers[mpath].misplaced.ec = make([]*core.CT, 0, 64)
+		joggers[mpath].misplaced.chunks = make([]*core.CT, 0, 64)
 	}
 	parent.jcnt.Store(int32(len(joggers)))
 	providers := apc.Providers.ToSlice()
@@ -267,7 +270,14 @@ func (j *clnJ) run(providers []string) {
 	if len(j.ini.Args.Buckets) != 0 {
 		size, err = j.jogBcks(j.ini.Args.Buckets)
 	} else {
+		if j.mi.DedupInUse() {
+			j.drefs = make(map[string]struct{}, 64)
+		}
 		size, err = j.jog(providers)
+		// (only upon full traversal)
+		if err == nil && j.drefs != nil {
+			size += j.rmUnrefDedup()
+		}
 	}
 	if err == nil {
 		err = erm
@@ -333,6 +343,7 @@ func (j *clnJ) jogBcks(bcks []cmn.Bck) (size int64, rerr error) {
 				// TODO: config option to scrub `fs.AllMpathBcks` buckets
 				j.ini.Xaction.AddErr(err)
 				nlog.Errorf("%s: %v - skipping %s", j, err, bck.String())
+				j.drefs = nil // cannot tell which deduplicated content is unreferenced
 			}
 			continue
 		}
@@ -369,14 +380,31 @@ func (j *clnJ) jogBck() (int64, error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
 		Bck:      j.bck,
-		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType},
+		CTs:      []string{fs.WorkfileType, fs.ObjectType, fs.ECSliceType, fs.ECMetaType, fs.ChunkType},
 		Callback: j.walk,
 		Sorted:   false,
 	}
 	if err := fs.Walk(opts); err != nil {
 		return 0, err
 	}
-	return j.rmLeftovers()
+	size, err := j.rmLeftovers()
+	if err == nil {
+		size += j.compactPacks()
+	}
+	return size, err
+}
+
+// reclaim all dead space in the bucket's packs, if any (see fs/pack.go)
+func (j *clnJ) compactPacks() int64 {
+	p := j.mi.Packs(&j.bck)
+	if _, dead := p.Usage(); dead == 0 {
+		return 0
+	}
+	bck := meta.CloneBck(&j.bck)
+	if err := bck.Init(core.T.Bowner()); err != nil {
+		return 0
+	}
+	return core.CompactPacks(p, 0 /*any dead space*/, bck.Props.Pack.PackSizeX())
 }
 
 func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
@@ -430,6 +458,18 @@ func (j *clnJ) visitCT(parsedFQN *fs.ParsedFQN, fqn string) {
 			return
 		}
 		j.oldWork = append(j.oldWork, fqn)
+	case fs.ChunkType:
+		// chunks: check for orphans later (see rmLeftovers), ignoring recently written
+		ct, err := core.NewCTFromFQN(fqn, core.T.Bowner())
+		if err != nil {
+			j.oldWork = append(j.oldWork, fqn)
+			return
+		}
+		finfo, err := os.Stat(fqn)
+		if err != nil || finfo.ModTime().UnixNano()+int64(j.config.LRU.DontEvictTime) > j.now {
+			return
+		}
+		j.misplaced.chunks = append(j.misplaced.chunks, ct)
 	default:
 		debug.Assert(false, "Unsupported content type: ", parsedFQN.ContentType)
 	}
@@ -478,6 +518,10 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 		return
 	}
 
+	if lom.IsDeduped() && j.drefs != nil {
+		j.drefs[lom.DedupKey()] = struct{}{}
+	}
+
 	// TODO: switch
 	// too early; NOTE: default dont-evict = 2h
 	if lom.AtimeUnix()+int64(j.config.LRU.DontEvictTime) > j.now {
@@ -524,6 +568,50 @@ func (j *clnJ) visitObj(fqn string, lom *core.LOM) {
 	}
 }
 
+// remove deduplicated content that is no longer referenced by any object on this mountpath
+// (orphaned by bucket deletion, crashes, and such - see fs/dedup.go),
+// skipping content that was (re)referenced recently
+func (j *clnJ) rmUnrefDedup() (size int64) {
+	var (
+		cnt   int64
+		unref []string
+		since = time.Unix(0, j.now-int64(j.config.LRU.DontEvictTime))
+	)
+	err := j.mi.WalkDedup(func(key string, finfo os.FileInfo) {
+		if _, ok := j.drefs[key]; !ok && finfo.ModTime().Before(since) {
+			unref = append(unref, key)
+		}
+	})
+	j.drefs = nil
+	if err != nil {
+		j.ini.Xaction.AddErr(err)
+		return 0
+	}
+	for _, key := range unref {
+		sz, err := j.mi.DedupRemoveUnref(key, since)
+		if err != nil {
+			j.ini.Xaction.AddErr(err)
+			continue
+		}
+		if sz > 0 {
+			cnt++
+			size += sz
+			if cmn.Rom.FastV(4, cos.SmoduleSpace) {
+				nlog.Infof("%s: rm unreferenced dedup content %q, size=%d", j, key, sz)
+			}
+		}
+		if j.yieldTerm() != nil {
+			break
+		}
+	}
+	if cnt > 0 {
+		j.ini.StatsT.Add(stats.CleanupStoreSize, size)
+		j.ini.StatsT.Add(stats.CleanupStoreCount, cnt)
+		j.ini.Xaction.ObjsAdd(int(cnt), size)
+	}
+	return size
+}
+
 func (j *clnJ) rmExtraCopies(lom *core.LOM) {
 	if !lom.TryLock(true) {
 		return // must be busy
@@ -640,7 +728,8 @@ func (j *clnJ) rmLeftovers() (size int64, _ error) {
 	j.oldWork = j.oldWork[:0]
 
 	// 2. rm misplaced
-	if len(j.misplaced.loms) > 0 && j.p.rmMisplaced() {
+	rmMisplaced := (len(j.misplaced.loms) > 0 || len(j.misplaced.chunks) > 0) && j.p.rmMisplaced()
+	if len(j.misplaced.loms) > 0 && rmMisplaced {
 		for _, mlom := range j.misplaced.loms {
 			var (
 				fqn     = mlom.FQN
@@ -671,6 +760,30 @@ func (j *clnJ) rmLeftovers() (size int64, _ error) {
 	}
 	j.misplaced.loms = j.misplaced.loms[:0]
 
+	// 2.1. rm orphan chunks (ditto)
+	if rmMisplaced {
+		for _, ct := range j.misplaced.chunks {
+			if !core.IsOrphanChunk(ct.Bucket(), ct.ObjectName()) {
+				continue
+			}
+			finfo, err := os.Stat(ct.FQN())
+			if err != nil {
+				continue
+			}
+			if os.Remove(ct.FQN()) == nil {
+				fevicted++
+				bevicted += finfo.Size()
+				if cmn.Rom.FastV(4, cos.SmoduleSpace) {
+					nlog.Infof("%s: rm orphan chunk %q, size=%d", j, ct.FQN(), finfo.Size())
+				}
+				if err := j.yieldTerm(); err != nil {
+					return size, err
+				}
+			}
+		}
+	}
+	j.misplaced.chunks = j.misplaced.chunks[:0]
+
 	// 3. rm EC slices and replicas that are still without corresponding metafile
 	for _, ct := range j.misplaced.ec {
 		metaFQN := fs.CSM.Gen(ct, fs.ECMetaType, "")
@@ -0,0 +1,440 @@
+// Package space provides storage cleanup and eviction functionality (the latter based on the
+// least recently used cache replacement). It also serves as a built-in garbage-collection
+// mechanism for orphaned workfiles.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package space
+
+import (
+	"container/heap"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Tier migration moves objects between the fast and capacity tiers of the target's
+// mountpaths (config.Tier - see fs/tier.go and core/ltier.go), whereby:
+//   - fast-tier objects that were not accessed for config.Tier.DemoteAge get demoted;
+//   - when the fast tier's used capacity exceeds config.Tier.HighWM, the least recently
+//     accessed objects get demoted as well, until used capacity gets below config.Tier.LowWM
+//     (compare with LRU eviction);
+//   - capacity-tier objects accessed within config.Tier.PromoteAge get promoted, while
+//     the respective fast-tier mountpath remains below config.Tier.LowWM;
+//   - in between migration runs, new objects are written to the capacity tier when the
+//     respective fast-tier mountpath is above config.Tier.HighWM or out of space (see fs.HrwObj).
+//
+// Same as LRU, tier migration runs one jogger per mountpath and self-throttles in accordance
+// with disk utilization. It runs periodically (config.Tier.Interval) and can be started
+// via API (`ais start tier-migration`).
+
+type (
+	IniTier struct {
+		Xaction    *XactTier
+		Config     *cmn.Config
+		StatsT     stats.Tracker
+		GetFSStats func(path string) (blocks, bavail uint64, bsize int64, err error)
+		WG         *sync.WaitGroup
+	}
+	XactTier struct {
+		xact.Base
+	}
+)
+
+// private
+type (
+	// parent (contains mpath joggers)
+	tierP struct {
+		wg   sync.WaitGroup
+		room map[string]*atomic.Int64 // fast-tier mountpath => bytes that can be promoted
+		ini  IniTier
+	}
+	// single mountpath jogger: demotes (fast tier) or promotes (capacity tier)
+	tierJ struct {
+		p      *tierP
+		mi     *fs.Mountpath
+		config *cmn.Config
+		stopCh chan struct{}
+		bck    cmn.Bck
+		buf    []byte
+		// demotion by capacity
+		heap      *minHeap
+		totalSize int64 // bytes to demote (above low watermark)
+		curSize   int64
+		newest    int64
+		// runtime
+		now   int64
+		tier  int
+		count int64
+		size  int64
+	}
+	tierFactory struct {
+		xreg.RenewBase
+		xctn *XactTier
+	}
+)
+
+// interface guard
+var (
+	_ xreg.Renewable = (*tierFactory)(nil)
+	_ core.Xact      = (*XactTier)(nil)
+)
+
+/////////////////
+// tierFactory //
+/////////////////
+
+func (*tierFactory) New(args xreg.Args, _ *meta.Bck) xreg.Renewable {
+	return &tierFactory{RenewBase: xreg.RenewBase{Args: args}}
+}
+
+func (p *tierFactory) Start() error {
+	p.xctn = &XactTier{}
+	p.xctn.InitBase(p.UUID(), apc.ActTierMigrate, "", nil)
+	return nil
+}
+
+func (*tierFactory) Kind() string     { return apc.ActTierMigrate }
+func (p *tierFactory) Get() core.Xact { return p.xctn }
+
+func (*tierFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (wpr xreg.WPR, err error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+func RunTier(ini *IniTier) {
+	var (
+		xtier  = ini.Xaction
+		config = ini.Config
+		avail  = fs.GetAvail()
+		parent = &tierP{room: make(map[string]*atomic.Int64, 2), ini: *ini}
+	)
+	defer func() {
+		if ini.WG != nil {
+			ini.WG.Done()
+		}
+	}()
+	if !config.Tier.Enabled() || !fs.Tiered() {
+		nlog.Infoln(xtier.Name(), "- mountpaths are not tiered, nothing to do")
+		xtier.Finish()
+		return
+	}
+
+	joggers := make([]*tierJ, 0, len(avail))
+	for _, mi := range avail {
+		tier := mi.Tier()
+		if tier == fs.TierFast {
+			parent.room[mi.Path] = &atomic.Int64{}
+		}
+		h := make(minHeap, 0, 64)
+		joggers = append(joggers, &tierJ{
+			p:      parent,
+			mi:     mi,
+			config: config,
+			stopCh: make(chan struct{}, 1),
+			heap:   &h,
+			tier:   tier,
+		})
+	}
+	for _, j := range joggers {
+		if j.tier == fs.TierFast {
+			if err := j.capacity(); err != nil {
+				nlog.Errorln(j.String()+":", err)
+			}
+		}
+	}
+	providers := apc.Providers.ToSlice()
+	nlog.Infoln(xtier.Name(), "started:", config.Tier.String())
+	for _, j := range joggers {
+		parent.wg.Add(1)
+		go j.run(providers)
+	}
+	if ini.WG != nil {
+		ini.WG.Done()
+		ini.WG = nil
+	}
+	parent.wg.Wait()
+
+	for _, j := range joggers {
+		j.stop()
+	}
+	xtier.Finish()
+	nlog.Infoln(xtier.Name(), "finished")
+}
+
+func (*XactTier) Run(*sync.WaitGroup) { debug.Assert(false) }
+
+func (r *XactTier) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+//////////////////////
+// mountpath jogger //
+//////////////////////
+
+func (j *tierJ) String() string {
+	return fmt.Sprintf("%s: jog-%s", j.p.ini.Xaction, j.mi)
+}
+
+func (j *tierJ) stop() { j.stopCh <- struct{}{} }
+
+// fast tier: compute the size to demote (above high watermark) and the room to promote (below low watermark)
+func (j *tierJ) capacity() error {
+	blocks, bavail, bsize, err := j.p.ini.GetFSStats(j.mi.Path)
+	if err != nil {
+		return err
+	}
+	var (
+		tconf   = &j.config.Tier
+		used    = blocks - bavail
+		lwm     = blocks * uint64(tconf.LowWMX()) / 100
+		usedPct = used * 100 / max(blocks, 1)
+	)
+	j.totalSize = 0
+	if usedPct >= uint64(tconf.HighWMX()) {
+		j.totalSize = int64(used-lwm) * bsize
+	}
+	var room int64
+	if used < lwm {
+		room = int64(lwm-used) * bsize
+	}
+	j.p.room[j.mi.Path].Store(room)
+	return nil
+}
+
+func (j *tierJ) run(providers []string) {
+	var (
+		slab *memsys.Slab
+		err  error
+	)
+	defer j.p.wg.Done()
+	j.buf, slab = core.T.PageMM().Alloc()
+	defer slab.Free(j.buf)
+
+	for _, provider := range providers { // for each provider (NOTE: ordering is random)
+		var (
+			bcks []cmn.Bck
+			opts = fs.WalkOpts{
+				Mi:  j.mi,
+				Bck: cmn.Bck{Provider: provider, Ns: cmn.NsGlobal},
+			}
+		)
+		if bcks, err = fs.AllMpathBcks(&opts); err != nil {
+			break
+		}
+		for _, bck := range bcks {
+			j.bck = bck
+			if err = j.jogBck(); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	j.stats()
+	if err == nil || cmn.IsErrBucketNought(err) || cmn.IsErrObjNought(err) {
+		return
+	}
+	nlog.Errorln(j.String()+":", "exited with err:", err)
+}
+
+func (j *tierJ) jogBck() error {
+	// 1. init per-bucket min-heap (and reuse the slice)
+	h := (*j.heap)[:0]
+	j.heap = &h
+	heap.Init(j.heap)
+	j.curSize, j.newest = 0, 0
+
+	// 2. walk: demote (promote) by age, collect (demotion) by capacity
+	opts := &fs.WalkOpts{
+		Mi:       j.mi,
+		Bck:      j.bck,
+		CTs:      []string{fs.ObjectType},
+		Callback: j.walk,
+		Sorted:   false,
+	}
+	j.now = time.Now().UnixNano()
+	if err := fs.Walk(opts); err != nil {
+		return err
+	}
+
+	// 3. demote least recently accessed
+	for j.heap.Len() > 0 {
+		lom := heap.Pop(j.heap).(*core.LOM)
+		if j.totalSize > 0 {
+			if err := j.move(lom); err != nil {
+				core.FreeLOM(lom)
+				return err
+			}
+		}
+		core.FreeLOM(lom)
+	}
+	return nil
+}
+
+func (j *tierJ) walk(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	if err := j.yieldTerm(); err != nil {
+		return err
+	}
+	lom := core.AllocLOM("")
+	if pushed, err := j.visit(lom, fqn); !pushed {
+		core.FreeLOM(lom)
+		return err
+	}
+	return nil
+}
+
+func (j *tierJ) visit(lom *core.LOM, fqn string) (pushed bool, _ error) {
+	if err := lom.InitFQN(fqn, &j.bck); err != nil {
+		return false, nil
+	}
+	if !lom.IsHRW() {
+		return false, nil // misplaced or copy (see space cleanup)
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil || !lom.CanMigrate() {
+		return false, nil
+	}
+	atime := lom.AtimeUnix()
+	if j.tier == fs.TierCapacity {
+		if atime+int64(j.config.Tier.PromoteAgeX()) < j.now {
+			return false, nil
+		}
+		return false, j.move(lom)
+	}
+
+	// fast tier
+	if atime+int64(j.config.Tier.DemoteAgeX()) < j.now {
+		return false, j.move(lom)
+	}
+	if j.totalSize <= 0 || (j.curSize >= j.totalSize && atime > j.newest) {
+		return false, nil
+	}
+	heap.Push(j.heap, lom)
+	j.curSize += lom.Lsize()
+	j.newest = max(j.newest, atime)
+	return true, nil
+}
+
+// move to the other tier; returns error only to terminate
+func (j *tierJ) move(lom *core.LOM) error {
+	var (
+		room     *atomic.Int64
+		reserved int64
+	)
+	if j.tier == fs.TierCapacity {
+		mi := lom.OtherTier()
+		if mi == nil {
+			return nil
+		}
+		if room = j.p.room[mi.Path]; room == nil {
+			return nil
+		}
+		reserved = lom.Lsize()
+		if room.Sub(reserved) < 0 {
+			room.Add(reserved)
+			return nil
+		}
+	}
+	if !lom.TryLock(true) {
+		if room != nil {
+			room.Add(reserved)
+		}
+		return nil // NOTE: skipping busy
+	}
+	var (
+		mi   *fs.Mountpath
+		size int64
+		err  = lom.Load(false /*cache it*/, true /*locked*/)
+	)
+	// re-check under lock
+	if err == nil && lom.Mountpath() == j.mi && lom.CanMigrate() {
+		size = lom.Lsize()
+		mi, err = lom.MoveTier(j.buf)
+	}
+	lom.Unlock(true)
+
+	if err == nil && mi != nil {
+		j.count++
+		j.size += size
+		j.p.ini.Xaction.ObjsAdd(1, size)
+		if j.tier == fs.TierFast {
+			j.totalSize -= size
+		}
+		if cmn.Rom.FastV(5, cos.SmoduleSpace) {
+			nlog.Infoln(j.String()+":", "moved", lom.Cname(), "=>", mi.String())
+		}
+		j.throttle()
+		return j.yieldTerm()
+	}
+	if room != nil {
+		room.Add(reserved)
+	}
+	switch {
+	case err == nil || cos.IsNotExist(err) || cos.IsErrNotFound(err):
+		// (nothing to do)
+	case cos.IsErrOOS(err):
+		errV := fmt.Errorf("%s: OOS moving %s: %w", core.T, lom.Cname(), err)
+		err = cmn.NewErrAborted(j.p.ini.Xaction.Name(), "", errV)
+		j.p.ini.Xaction.Abort(err)
+		return err
+	default:
+		j.p.ini.Xaction.AddErr(fmt.Errorf("%s: failed to move %s: %w", j, lom.Cname(), err), 0)
+	}
+	return nil
+}
+
+func (j *tierJ) throttle() {
+	if util := j.mi.GetUtil(); util >= j.config.Disk.DiskUtilHighWM {
+		time.Sleep(fs.Throttle10ms)
+	}
+}
+
+func (j *tierJ) stats() {
+	if j.count == 0 {
+		return
+	}
+	if j.tier == fs.TierFast {
+		j.p.ini.StatsT.Add(stats.TierDemoteCount, j.count)
+		j.p.ini.StatsT.Add(stats.TierDemoteSize, j.size)
+	} else {
+		j.p.ini.StatsT.Add(stats.TierPromoteCount, j.count)
+		j.p.ini.StatsT.Add(stats.TierPromoteSize, j.size)
+	}
+}
+
+func (j *tierJ) yieldTerm() error {
+	xtier := j.p.ini.Xaction
+	select {
+	case errCause := <-xtier.ChanAbort():
+		return cmn.NewErrAborted(xtier.Name(), "", errCause)
+	case <-j.stopCh:
+		return cmn.NewErrAborted(xtier.Name(), "", nil)
+	default:
+		break
+	}
+	if xtier.Finished() {
+		return cmn.NewErrAborted(xtier.Name(), "", nil)
+	}
+	return nil
+}
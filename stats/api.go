@@ -7,6 +7,7 @@ package stats
 
 import (
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -36,8 +37,14 @@ const (
 
 	KindLatency    = "latency" // computed internally over 'periodic.stats_time' (milliseconds)
 	KindThroughput = "bw"      // ditto (MB/s)
+
+	// prometheus histogram (seconds); percentiles computed internally over 'periodic.stats_time' (see hist.go)
+	KindHistogram = "histogram"
 )
 
+// latency percentiles (KindHistogram), e.g.: "get.ns.hist" => "get.ns.p50", "get.ns.p90", "get.ns.p99"
+var Percentiles = [...]int{50, 90, 99}
+
 // static labels
 const (
 	ConstlabNode = "node_id"
@@ -48,6 +55,8 @@ const (
 	VlabBucket    = "bucket"
 	VlabXkind     = "xkind"
 	VlabMountpath = "mountpath"
+	VlabNamespace = "namespace"
+	VlabUser      = "user"
 )
 
 type (
@@ -62,6 +71,10 @@ type (
 		IncWith(metric string, vlabs map[string]string)
 		IncBck(name string, bck *cmn.Bck)
 
+		// per-bucket (and per-user) traffic - targets only (see bcktraffic.go)
+		AddBckTraffic(op int, bck *cmn.Bck, user string, size int64)
+		GetBckTraffic() BckTrafficList
+
 		GetStats() *Node
 
 		ResetStats(errorsOnly bool)
@@ -174,6 +187,24 @@ func LatencyToCounter(latName string) string {
 	return ""
 }
 
+func PercentileName(histName string, p int) string {
+	debug.Assert(strings.HasSuffix(histName, ".hist"), histName)
+	return strings.TrimSuffix(histName, "hist") + "p" + strconv.Itoa(p)
+}
+
+func IsPercentile(name string) bool {
+	i := strings.LastIndexByte(name, '.')
+	if i < 0 || !strings.HasSuffix(name[:i], ".ns") {
+		return false
+	}
+	for _, p := range Percentiles {
+		if name[i+1:] == "p"+strconv.Itoa(p) {
+			return true
+		}
+	}
+	return false
+}
+
 func SizeToThroughputCount(name, kind string) (string, string) {
 	if kind != KindSize {
 		return "", ""
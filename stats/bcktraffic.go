@@ -0,0 +1,245 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"sort"
+	"sync"
+	ratomic "sync/atomic"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+)
+
+// Per-bucket and per-user traffic (e.g., for chargeback):
+// - GET, PUT, DELETE, and list-objects counts, and GET and PUT sizes
+// - tracked by targets upon handling user requests (not counting internal traffic: jobs, rebalance, etc.)
+// - the user is the authenticated principal reported by the redirecting gateway
+//   (empty when authentication is disabled)
+// - bounded cardinality: at most `metrics.max_traffic_series` distinct (bucket, user) pairs
+//   per target; the rest is accounted for under `BckTrafficOther` (bucket name)
+// - exported to Prometheus with "bucket", "namespace", and "user" labels
+// - see also: apc.WhatBckTraffic
+
+// traffic enum (see AddBckTraffic)
+const (
+	TrafficGet = iota
+	TrafficPut
+	TrafficDel
+	TrafficList
+)
+
+const BckTrafficOther = "_other"
+
+// Prometheus metric names (bucket_get_count, etc.)
+const (
+	btGetCount = iota
+	btGetSize
+	btPutCount
+	btPutSize
+	btDelCount
+	btLstCount
+
+	numBtMetrics
+)
+
+var btNames = [numBtMetrics]string{"bucket_get_count", "bucket_get_bytes", "bucket_put_count", "bucket_put_bytes",
+	"bucket_del_count", "bucket_lst_count"}
+
+var btHelp = [numBtMetrics]string{
+	"per-bucket (and per-user) number of GET requests",
+	"per-bucket (and per-user) total GET size (bytes)",
+	"per-bucket (and per-user) number of PUT requests",
+	"per-bucket (and per-user) total PUT size (bytes)",
+	"per-bucket (and per-user) number of DELETE requests",
+	"per-bucket (and per-user) number of list-objects requests",
+}
+
+var btVlabs = []string{VlabBucket, VlabNamespace, VlabUser}
+
+type (
+	// REST API
+	BckTraffic struct {
+		Bucket   string `json:"bucket"`              // provider://name (or BckTrafficOther)
+		Ns       string `json:"namespace,omitempty"` // see cmn.Ns
+		User     string `json:"user,omitempty"`
+		GetCount int64  `json:"get.n,string"`
+		GetSize  int64  `json:"get.size,string"`
+		PutCount int64  `json:"put.n,string"`
+		PutSize  int64  `json:"put.size,string"`
+		DelCount int64  `json:"del.n,string"`
+		LstCount int64  `json:"lst.n,string"`
+	}
+	BckTrafficList []*BckTraffic
+
+	btKey struct {
+		provider, name, nsUUID, nsName, user string
+	}
+	btEntry struct {
+		prom btProm
+		v    BckTraffic
+	}
+	bckTraffic struct {
+		m  map[btKey]*btEntry
+		mu sync.RWMutex
+	}
+)
+
+////////////////
+// bckTraffic //
+////////////////
+
+func (bt *bckTraffic) init() { bt.m = make(map[btKey]*btEntry, 64) }
+
+func (bt *bckTraffic) add(op int, bck *cmn.Bck, user string, size int64) {
+	key := btKey{provider: bck.Provider, name: bck.Name, nsUUID: bck.Ns.UUID, nsName: bck.Ns.Name, user: user}
+	bt.mu.RLock()
+	e, ok := bt.m[key]
+	bt.mu.RUnlock()
+	if !ok {
+		e = bt.get(key, bck)
+	}
+	switch op {
+	case TrafficGet:
+		e.inc(btGetCount, &e.v.GetCount, 1)
+		e.inc(btGetSize, &e.v.GetSize, size)
+	case TrafficPut:
+		e.inc(btPutCount, &e.v.PutCount, 1)
+		e.inc(btPutSize, &e.v.PutSize, size)
+	case TrafficDel:
+		e.inc(btDelCount, &e.v.DelCount, 1)
+	case TrafficList:
+		e.inc(btLstCount, &e.v.LstCount, 1)
+	default:
+		debug.Assert(false, op)
+	}
+}
+
+// slow path: add new entry or, when exceeding the limit, use the "other" one
+func (bt *bckTraffic) get(key btKey, bck *cmn.Bck) *btEntry {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	if e, ok := bt.m[key]; ok {
+		return e
+	}
+	v := BckTraffic{
+		Bucket: apc.ToScheme(bck.Provider) + apc.BckProviderSeparator + bck.Name,
+		Ns:     bck.Ns.String(),
+		User:   key.user,
+	}
+	if len(bt.m) >= cmn.GCO.Get().Metrics.MaxTrafficSeriesX() {
+		key = btKey{name: BckTrafficOther}
+		if e, ok := bt.m[key]; ok {
+			return e
+		}
+		v = BckTraffic{Bucket: BckTrafficOther}
+	}
+	e := &btEntry{v: v}
+	e.prom.init(&e.v)
+	bt.m[key] = e
+	return e
+}
+
+func (bt *bckTraffic) copy() BckTrafficList {
+	bt.mu.RLock()
+	out := make(BckTrafficList, 0, len(bt.m))
+	for _, e := range bt.m {
+		out = append(out, e.load())
+	}
+	bt.mu.RUnlock()
+	out.sort()
+	return out
+}
+
+// (prometheus counters are not affected)
+func (bt *bckTraffic) reset() {
+	bt.mu.Lock()
+	for _, e := range bt.m {
+		for _, counter := range []*int64{&e.v.GetCount, &e.v.GetSize, &e.v.PutCount, &e.v.PutSize, &e.v.DelCount, &e.v.LstCount} {
+			ratomic.StoreInt64(counter, 0) // (concurrent btEntry.inc)
+		}
+	}
+	bt.mu.Unlock()
+}
+
+/////////////
+// Trunner //
+/////////////
+
+func (r *Trunner) AddBckTraffic(op int, bck *cmn.Bck, user string, size int64) {
+	r.bt.add(op, bck, user, size)
+}
+
+// REST API (apc.WhatBckTraffic)
+func (r *Trunner) GetBckTraffic() BckTrafficList { return r.bt.copy() }
+
+func (r *Trunner) ResetStats(errorsOnly bool) {
+	r.runner.ResetStats(errorsOnly)
+	if !errorsOnly {
+		r.bt.reset()
+	}
+}
+
+/////////////
+// btEntry //
+/////////////
+
+func (e *btEntry) inc(idx int, counter *int64, val int64) {
+	ratomic.AddInt64(counter, val)
+	e.prom.add(idx, val)
+}
+
+func (e *btEntry) load() *BckTraffic {
+	v := &BckTraffic{Bucket: e.v.Bucket, Ns: e.v.Ns, User: e.v.User}
+	v.GetCount = ratomic.LoadInt64(&e.v.GetCount)
+	v.GetSize = ratomic.LoadInt64(&e.v.GetSize)
+	v.PutCount = ratomic.LoadInt64(&e.v.PutCount)
+	v.PutSize = ratomic.LoadInt64(&e.v.PutSize)
+	v.DelCount = ratomic.LoadInt64(&e.v.DelCount)
+	v.LstCount = ratomic.LoadInt64(&e.v.LstCount)
+	return v
+}
+
+////////////////////
+// BckTrafficList //
+////////////////////
+
+// Merge adds up (the traffic of) all targets
+func (l *BckTrafficList) Merge(other BckTrafficList) {
+	idx := make(map[[3]string]*BckTraffic, len(*l)+len(other))
+	for _, v := range *l {
+		idx[[3]string{v.Bucket, v.Ns, v.User}] = v
+	}
+	for _, o := range other {
+		k := [3]string{o.Bucket, o.Ns, o.User}
+		v, ok := idx[k]
+		if !ok {
+			v = &BckTraffic{Bucket: o.Bucket, Ns: o.Ns, User: o.User}
+			idx[k] = v
+			*l = append(*l, v)
+		}
+		v.GetCount += o.GetCount
+		v.GetSize += o.GetSize
+		v.PutCount += o.PutCount
+		v.PutSize += o.PutSize
+		v.DelCount += o.DelCount
+		v.LstCount += o.LstCount
+	}
+	l.sort()
+}
+
+func (l BckTrafficList) sort() {
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].Bucket != l[j].Bucket {
+			return l[i].Bucket < l[j].Bucket
+		}
+		if l[i].Ns != l[j].Ns {
+			return l[i].Ns < l[j].Ns
+		}
+		return l[i].User < l[j].User
+	})
+}
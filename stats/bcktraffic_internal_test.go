@@ -0,0 +1,79 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBckTraffic(t *testing.T) {
+	const maxSeries = 4
+	config := cmn.GCO.BeginUpdate()
+	config.Metrics.MaxTrafficSeries = maxSeries
+	cmn.GCO.CommitUpdate(config)
+
+	var (
+		bt   bckTraffic
+		abc  = cmn.Bck{Name: "abc", Provider: apc.AIS}
+		nsbc = cmn.Bck{Name: "abc", Provider: apc.AIS, Ns: cmn.Ns{Name: "ns1"}}
+	)
+	bt.init()
+	bt.add(TrafficGet, &abc, "", 100)
+	bt.add(TrafficGet, &abc, "", 200)
+	bt.add(TrafficPut, &abc, "alice", 1000)
+	bt.add(TrafficDel, &abc, "alice", 0)
+	bt.add(TrafficList, &nsbc, "", 0)
+
+	list := bt.copy()
+	tassert.Fatalf(t, len(list) == 3, "expected 3 series, got %d", len(list))
+	for _, v := range list {
+		tassert.Errorf(t, v.Bucket == "ais://abc", "unexpected bucket %q", v.Bucket)
+		switch {
+		case v.Ns != "":
+			tassert.Errorf(t, v.LstCount == 1 && v.GetCount == 0, "unexpected %+v", v)
+		case v.User == "alice":
+			tassert.Errorf(t, v.PutCount == 1 && v.PutSize == 1000 && v.DelCount == 1, "unexpected %+v", v)
+		default:
+			tassert.Errorf(t, v.GetCount == 2 && v.GetSize == 300 && v.PutCount == 0, "unexpected %+v", v)
+		}
+	}
+
+	// bounded cardinality
+	for i := range 10 {
+		bck := cmn.Bck{Name: "b" + strconv.Itoa(i), Provider: apc.AWS}
+		bt.add(TrafficGet, &bck, "", 1)
+	}
+	list = bt.copy()
+	tassert.Fatalf(t, len(list) == maxSeries+1, "expected %d series, got %d", maxSeries+1, len(list))
+	var other *BckTraffic
+	for _, v := range list {
+		if v.Bucket == BckTrafficOther {
+			other = v
+		}
+	}
+	tassert.Fatalf(t, other != nil, "expected %q series", BckTrafficOther)
+	tassert.Errorf(t, other.GetCount == 9 && other.GetSize == 9, "unexpected %+v", other)
+
+	// merge (as in: all targets)
+	all := BckTrafficList{}
+	all.Merge(list)
+	all.Merge(bt.copy())
+	tassert.Errorf(t, len(all) == len(list), "expected %d series, got %d", len(list), len(all))
+	for i, v := range all {
+		tassert.Errorf(t, v.GetCount == 2*list[i].GetCount && v.Bucket == list[i].Bucket, "merge: %+v vs %+v", v, list[i])
+	}
+
+	// reset
+	bt.reset()
+	for _, v := range bt.copy() {
+		tassert.Errorf(t, v.GetCount == 0 && v.PutSize == 0 && v.LstCount == 0, "expected zeros, got %+v", v)
+	}
+}
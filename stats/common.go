@@ -39,6 +39,7 @@ import (
 // "*.ns"   - KindLatency, KindTotal (nanoseconds)
 // "*.size" - KindSize (bytes)
 // "*.bps"  - KindThroughput, KindComputedThroughput
+// "*.ns.hist" - KindHistogram (nanoseconds), with percentiles reported as "*.ns.p50", etc. (see `Percentiles`)
 //
 // all error counters must have "err_" prefix (see `errPrefix`)
 
@@ -480,6 +481,7 @@ waitStartup:
 				r.ticker.Reset(statsTime)
 				logger.statsTime(statsTime)
 			}
+			checkHistBuckets(config)
 
 			// 2. flush logs (NOTE: stats runner is solely responsible)
 			flushTime := cos.NonZero(config.Log.FlushTime.D(), dfltPeriodicFlushTime)
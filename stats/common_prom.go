@@ -13,6 +13,7 @@ import (
 	ratomic "sync/atomic"
 	"time"
 
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core/meta"
@@ -25,10 +26,11 @@ import (
 type (
 	statsValue struct {
 		iadd       iadd
-		kind       string // enum { KindCounter, ..., KindSpecial }
-		Value      int64  `json:"v,string"`
-		numSamples int64  // (average latency over stats_time)
-		cumulative int64  // REST API
+		hist       *histogram // KindHistogram only
+		kind       string     // enum { KindCounter, ..., KindSpecial }
+		Value      int64      `json:"v,string"`
+		numSamples int64      // (average latency over stats_time)
+		cumulative int64      // REST API
 	}
 	coreStats struct {
 		Tracker   map[string]*statsValue
@@ -86,6 +88,10 @@ func (s *coreStats) copyT(out copyTracker, diskLowUtil ...int64) bool {
 			if throughput := ratomic.SwapInt64(&v.Value, 0); throughput > 0 {
 				out[name] = copyValue{throughput}
 			}
+		case KindHistogram:
+			if v.hist.copyT(name, out) && !ignore(name) {
+				idle = false
+			}
 		case KindCounter, KindSize, KindTotal:
 			var (
 				val     = ratomic.LoadInt64(&v.Value)
@@ -131,6 +137,8 @@ func (s *coreStats) copyCumulative(ctracker copyTracker) {
 			// as statsValue.cumulative _is_ the total size (aka, KindSize)
 			n := name[:len(name)-3] + "size"
 			ctracker[n] = val
+		case KindHistogram:
+			v.hist.copyCumulative(name, ctracker)
 		case KindCounter, KindSize, KindTotal:
 			if val := ratomic.LoadInt64(&v.Value); val > 0 {
 				ctracker[name] = copyValue{val}
@@ -162,6 +170,8 @@ func (s *coreStats) reset(errorsOnly bool) {
 			ratomic.StoreInt64(&v.cumulative, 0)
 		case KindCounter, KindSize, KindComputedThroughput, KindGauge, KindTotal:
 			ratomic.StoreInt64(&v.Value, 0)
+		case KindHistogram:
+			v.hist.reset()
 		default: // KindSpecial - do nothing
 		}
 	}
@@ -200,6 +210,9 @@ func (r *runner) reg(snode *meta.Snode, name, kind string, extra *Extra) {
 		case KindThroughput, KindComputedThroughput:
 			debug.Assert(strings.HasSuffix(name, ".bps"), name)
 			metricName = strings.TrimSuffix(name, ".bps") + "_bps"
+		case KindHistogram:
+			debug.Assert(strings.HasSuffix(name, ".ns.hist"), name)
+			metricName = strings.TrimSuffix(name, ".ns.hist") + "_latency_seconds"
 		default:
 			metricName = name
 		}
@@ -234,6 +247,33 @@ func (r *runner) reg(snode *meta.Snode, name, kind string, extra *Extra) {
 		// ditto (v3.26)
 		v.iadd = throughput{}
 
+	case KindHistogram:
+		// no variable labels (cardinality)
+		debug.Assert(len(extra.VarLabs) == 0, name)
+		config := cmn.GCO.Get()
+		bounds := config.Metrics.LatencyBounds()
+		v.hist = newHistogram(bounds)
+		histBuckets.registered, histBuckets.used = config.Metrics.LatencyBuckets, true
+		buckets := make([]float64, len(bounds))
+		for i, b := range bounds {
+			buckets[i] = b.Seconds()
+		}
+		opts := prometheus.HistogramOpts{
+			Namespace:   "ais",
+			Subsystem:   snode.Type(),
+			Name:        metricName,
+			Help:        help,
+			ConstLabels: constLabs,
+			Buckets:     buckets,
+			// native histogram (when scraped via protobuf)
+			NativeHistogramBucketFactor:     nativeHistFactor,
+			NativeHistogramMaxBucketNumber:  nativeHistMaxBuckets,
+			NativeHistogramMinResetDuration: nativeHistMinReset,
+		}
+		metric := prometheus.NewHistogram(opts)
+		v.iadd = histo{metric}
+		promRegistry.MustRegister(metric)
+
 	default:
 		opts := prometheus.GaugeOpts{Namespace: "ais", Subsystem: snode.Type(), Name: metricName, Help: help, ConstLabels: constLabs}
 		if len(extra.VarLabs) > 0 {
@@ -255,3 +295,43 @@ func (*runner) PromHandler() http.Handler {
 }
 
 func (*runner) closeStatsD() {} // build tag "statsd" stub
+
+//
+// per-bucket traffic (see bcktraffic.go)
+//
+
+var btVecs [numBtMetrics]*prometheus.CounterVec
+
+func regBckTraffic(snode *meta.Snode) {
+	for i, name := range btNames {
+		opts := prometheus.CounterOpts{
+			Namespace:   "ais",
+			Subsystem:   snode.Type(),
+			Name:        name,
+			Help:        btHelp[i],
+			ConstLabels: staticLabs,
+		}
+		btVecs[i] = prometheus.NewCounterVec(opts, btVlabs)
+		promRegistry.MustRegister(btVecs[i])
+	}
+}
+
+type btProm struct {
+	counters [numBtMetrics]prometheus.Counter
+}
+
+func (p *btProm) init(v *BckTraffic) {
+	if btVecs[0] == nil { // (not registered - unit tests)
+		return
+	}
+	labs := prometheus.Labels{VlabBucket: v.Bucket, VlabNamespace: v.Ns, VlabUser: v.User}
+	for i, vec := range btVecs {
+		p.counters[i] = vec.With(labs)
+	}
+}
+
+func (p *btProm) add(idx int, val int64) {
+	if c := p.counters[idx]; c != nil {
+		c.Add(float64(val))
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	ratomic "sync/atomic"
 	"time"
@@ -34,9 +35,10 @@ type (
 			comm string // common part of the metric label (as in: <prefix> . comm . <suffix>)
 			stpr string // StatsD _or_ Prometheus label (depending on build tag)
 		}
-		Value      int64 `json:"v,string"`
-		numSamples int64 // (average latency over stats_time)
-		cumulative int64 // REST API
+		hist       *histogram // KindHistogram only
+		Value      int64      `json:"v,string"`
+		numSamples int64      // (average latency over stats_time)
+		cumulative int64      // REST API
 	}
 
 	coreStats struct {
@@ -117,6 +119,8 @@ func (s *coreStats) add(name string, val int64) {
 		ratomic.AddInt64(&v.cumulative, val)
 	case KindCounter, KindSize, KindTotal:
 		ratomic.AddInt64(&v.Value, val)
+	case KindHistogram:
+		v.hist.observe(val)
 	default:
 		debug.Assert(false, v.kind)
 	}
@@ -168,6 +172,20 @@ func (s *coreStats) copyT(out copyTracker, diskLowUtil ...int64) bool {
 					s.statsdC.AppMetric(metric{Type: statsd.Gauge, Name: v.label.stpr, Value: throughput}, s.sgl)
 				}
 			}
+		case KindHistogram:
+			if !v.hist.copyT(name, out) {
+				break
+			}
+			if !ignore(name) {
+				idle = false
+			}
+			// percentiles (milliseconds) over the last "periodic.stats_time" interval
+			if !s.statsdDisabled() {
+				for _, p := range Percentiles {
+					millis := cos.DivRoundI64(out[PercentileName(name, p)].Value, int64(time.Millisecond))
+					s.statsdC.AppMetric(metric{Type: statsd.Gauge, Name: v.label.stpr + ".p" + strconv.Itoa(p), Value: millis}, s.sgl)
+				}
+			}
 		case KindCounter, KindSize, KindTotal:
 			var (
 				val     = ratomic.LoadInt64(&v.Value)
@@ -230,6 +248,8 @@ func (s *coreStats) copyCumulative(ctracker copyTracker) {
 			// as statsValue.cumulative _is_ the total size (aka, KindSize)
 			n := name[:len(name)-3] + "size"
 			ctracker[n] = val
+		case KindHistogram:
+			v.hist.copyCumulative(name, ctracker)
 		case KindCounter, KindSize, KindTotal:
 			if val := ratomic.LoadInt64(&v.Value); val > 0 {
 				ctracker[name] = copyValue{val}
@@ -261,6 +281,8 @@ func (s *coreStats) reset(errorsOnly bool) {
 			ratomic.StoreInt64(&v.cumulative, 0)
 		case KindCounter, KindSize, KindComputedThroughput, KindGauge, KindTotal:
 			ratomic.StoreInt64(&v.Value, 0)
+		case KindHistogram:
+			v.hist.reset()
 		default: // KindSpecial - do nothing
 		}
 	}
@@ -300,6 +322,13 @@ func (r *runner) reg(snode *meta.Snode, name, kind string, _ *Extra) {
 		debug.Assert(strings.HasSuffix(name, ".bps"), name)
 		v.label.comm = strings.TrimSuffix(name, ".bps")
 		v.label.stpr = f("bps")
+	case KindHistogram:
+		debug.Assert(strings.HasSuffix(name, ".ns.hist"), name)
+		v.label.comm = strings.TrimSuffix(name, ".ns.hist")
+		v.label.stpr = f("ms")
+		config := cmn.GCO.Get()
+		v.hist = newHistogram(config.Metrics.LatencyBounds())
+		histBuckets.registered, histBuckets.used = config.Metrics.LatencyBuckets, true
 	default:
 		debug.Assert(kind == KindGauge || kind == KindSpecial)
 		v.label.comm = name
@@ -317,3 +346,10 @@ func (r *runner) reg(snode *meta.Snode, name, kind string, _ *Extra) {
 func (*runner) PromHandler() http.Handler { return nil }
 
 func (r *runner) closeStatsD() { r.core.statsdC.Close() }
+
+// per-bucket traffic: REST API only (no StatsD)
+type btProm struct{}
+
+func regBckTraffic(*meta.Snode)  {}
+func (*btProm) init(*BckTraffic) {}
+func (*btProm) add(int, int64)   {}
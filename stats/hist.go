@@ -0,0 +1,145 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"sort"
+	ratomic "sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// KindHistogram:
+// - observed latencies (nanoseconds) are counted in fixed buckets (see cmn.MetricsConf.LatencyBuckets)
+// - every 'periodic.stats_time' interval, percentiles (see `Percentiles`) are computed
+//   over the observations made during the interval, and then logged and reported via REST API
+// - with Prometheus, each histogram is also exported as is - classic and native, both
+// - bucket bounds are fixed at registration: changing `metrics.latency_buckets` at runtime
+//   takes effect upon restart (and is logged as such - see checkHistBuckets)
+
+type histogram struct {
+	bounds []int64                 // bucket upper bounds (nanoseconds), in increasing order
+	counts []int64                 // len(bounds) + 1 (the last one being +Inf)
+	prev   []int64                 // counts at the end of the previous interval
+	delta  []int64                 // (scratch)
+	pct    [len(Percentiles)]int64 // computed over the last interval
+}
+
+// `metrics.latency_buckets` as registered vs. the last one warned about
+var histBuckets struct {
+	registered string
+	warned     string
+	used       bool // at least one histogram registered
+}
+
+func newHistogram(bounds []time.Duration) *histogram {
+	h := &histogram{bounds: make([]int64, len(bounds))}
+	for i, b := range bounds {
+		h.bounds[i] = int64(b)
+	}
+	n := len(bounds) + 1
+	h.counts = make([]int64, n)
+	h.prev = make([]int64, n)
+	h.delta = make([]int64, n)
+	return h
+}
+
+func (h *histogram) observe(val int64) {
+	i := sort.Search(len(h.bounds), func(i int) bool { return val <= h.bounds[i] })
+	ratomic.AddInt64(&h.counts[i], 1)
+}
+
+// compute percentiles over the observations since the previous call; returns false if there were none
+// (is called by the stats runner, one call at a time)
+func (h *histogram) interval() bool {
+	var total int64
+	for i := range h.counts {
+		c := ratomic.LoadInt64(&h.counts[i])
+		d := c - h.prev[i]
+		if d < 0 {
+			d = c // (reset)
+		}
+		h.delta[i], h.prev[i] = d, c
+		total += d
+	}
+	for j, p := range Percentiles {
+		var val int64
+		if total > 0 {
+			val = h.quantile(h.delta, total, p)
+		}
+		ratomic.StoreInt64(&h.pct[j], val)
+	}
+	return total > 0
+}
+
+// linear interpolation within the bucket containing the rank (similar to Prometheus `histogram_quantile`);
+// observations exceeding the highest bound are accounted for as the highest bound
+func (h *histogram) quantile(counts []int64, total int64, p int) int64 {
+	var (
+		rank = float64(total) * float64(p) / 100
+		cum  int64
+		last = len(h.bounds) - 1
+	)
+	for i, c := range counts {
+		if c == 0 {
+			continue
+		}
+		if float64(cum+c) < rank {
+			cum += c
+			continue
+		}
+		if i > last {
+			break
+		}
+		var lo int64
+		if i > 0 {
+			lo = h.bounds[i-1]
+		}
+		return lo + int64(float64(h.bounds[i]-lo)*(rank-float64(cum))/float64(c))
+	}
+	return h.bounds[last]
+}
+
+func (h *histogram) copyT(name string, out copyTracker) (busy bool) {
+	busy = h.interval()
+	for j, p := range Percentiles {
+		out[PercentileName(name, p)] = copyValue{ratomic.LoadInt64(&h.pct[j])}
+	}
+	return busy
+}
+
+func (h *histogram) copyCumulative(name string, ctracker copyTracker) {
+	for j, p := range Percentiles {
+		if val := ratomic.LoadInt64(&h.pct[j]); val > 0 {
+			ctracker[PercentileName(name, p)] = copyValue{val}
+		}
+	}
+}
+
+func (h *histogram) reset() {
+	for i := range h.counts {
+		ratomic.StoreInt64(&h.counts[i], 0)
+	}
+	for j := range h.pct {
+		ratomic.StoreInt64(&h.pct[j], 0)
+	}
+}
+
+// (is called periodically by the stats runner)
+func checkHistBuckets(config *cmn.Config) {
+	lb := config.Metrics.LatencyBuckets
+	if !histBuckets.used || lb == histBuckets.registered {
+		histBuckets.warned = ""
+		return
+	}
+	if lb != histBuckets.warned {
+		histBuckets.warned = lb
+		nlog.Warningf("metrics.latency_buckets %q: histogram buckets (currently %q) will change upon restart",
+			lb, histBuckets.registered)
+	}
+}
@@ -0,0 +1,54 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestHistogram(t *testing.T) {
+	var (
+		h   = newHistogram([]time.Duration{time.Millisecond, 10 * time.Millisecond, 100 * time.Millisecond, time.Second})
+		out = make(copyTracker)
+	)
+	tassert.Fatalf(t, !h.copyT(GetLatencyHist, out), "expected idle")
+
+	// 90 fast, 9 slow, 1 very slow
+	for range 90 {
+		h.observe(int64(500 * time.Microsecond))
+	}
+	for range 9 {
+		h.observe(int64(50 * time.Millisecond))
+	}
+	h.observe(int64(time.Minute)) // (exceeds the highest bound)
+
+	tassert.Fatalf(t, h.copyT(GetLatencyHist, out), "expected busy")
+	p50, p90, p99 := out["get.ns.p50"].Value, out["get.ns.p90"].Value, out["get.ns.p99"].Value
+	tassert.Errorf(t, p50 > 0 && p50 <= int64(time.Millisecond), "p50 %v", time.Duration(p50))
+	tassert.Errorf(t, p90 == int64(time.Millisecond), "p90 %v", time.Duration(p90))
+	tassert.Errorf(t, p99 > int64(10*time.Millisecond) && p99 <= int64(100*time.Millisecond), "p99 %v", time.Duration(p99))
+
+	ctracker := make(copyTracker)
+	h.copyCumulative(GetLatencyHist, ctracker)
+	tassert.Errorf(t, len(ctracker) == len(Percentiles), "expected %d percentiles, got %v", len(Percentiles), ctracker)
+
+	// next interval: only the very slow
+	h.observe(int64(time.Minute))
+	h.copyT(GetLatencyHist, out)
+	tassert.Errorf(t, out["get.ns.p50"].Value == int64(time.Second), "expecting the highest bound, got %v", out["get.ns.p50"])
+
+	// reset
+	h.reset()
+	h.observe(int64(5 * time.Millisecond))
+	tassert.Fatalf(t, h.copyT(GetLatencyHist, out), "expected busy")
+	p50 = out["get.ns.p50"].Value
+	tassert.Errorf(t, p50 > int64(time.Millisecond) && p50 <= int64(10*time.Millisecond), "p50 after reset %v", time.Duration(p50))
+
+	tassert.Errorf(t, IsPercentile("aws.get.ns.p99") && !IsPercentile("get.ns") && !IsPercentile("get.ns.total"), "IsPercentile")
+}
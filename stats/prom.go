@@ -29,6 +29,8 @@ type (
 	latency    struct{}
 	throughput struct{}
 
+	histo struct{ prometheus.Histogram }
+
 	counter    struct{ prometheus.Counter }
 	counterVec struct{ *prometheus.CounterVec }
 	gauge      struct{ prometheus.Gauge }
@@ -39,6 +41,7 @@ type (
 var (
 	_ iadd = (*latency)(nil)
 	_ iadd = (*throughput)(nil)
+	_ iadd = (*histo)(nil)
 
 	_ iadd = (*counter)(nil)
 	_ iadd = (*counterVec)(nil)
@@ -85,6 +88,27 @@ func (v throughput) addWith(parent *statsValue, nv cos.NamedVal64) {
 	v.add(parent, nv.Value)
 }
 
+//
+// histogram: internal (percentiles) and Prometheus (seconds) -----
+//
+
+// native histograms: max relative bucket width, max number of buckets, and min time between resets
+// (when exceeding the max number)
+const (
+	nativeHistFactor     = 1.1
+	nativeHistMaxBuckets = 160
+	nativeHistMinReset   = time.Hour
+)
+
+func (v histo) add(parent *statsValue, val int64) {
+	parent.hist.observe(val)
+	v.Observe(float64(val) / float64(time.Second))
+}
+
+func (v histo) addWith(parent *statsValue, nv cos.NamedVal64) {
+	v.add(parent, nv.Value)
+}
+
 //
 // Prometheus ---------------------------------
 // in re: datapath performance vs Prometheus counters:
@@ -150,6 +174,9 @@ func (gaugeVec) add(*statsValue, int64)             { debug.Assert(false) }
 func (gaugeVec) set(*statsValue, int64)             { debug.Assert(false) }
 func (latency) set(*statsValue, int64)              { debug.Assert(false) }
 func (throughput) set(*statsValue, int64)           { debug.Assert(false) }
+func (histo) inc(*statsValue)                       { debug.Assert(false) }
+func (histo) incWith(*statsValue, cos.NamedVal64)   { debug.Assert(false) }
+func (histo) set(*statsValue, int64)                { debug.Assert(false) }
 
 // coreStats
 
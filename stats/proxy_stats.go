@@ -18,6 +18,11 @@ import (
 
 const numProxyStats = 24 // approx. initial
 
+// proxy-only
+const (
+	AuditDroppedCount = "audit.dropped.n" // audit records dropped when the queue is full (see cmn/audit)
+)
+
 // NOTE: currently, proxy's stats == common and hardcoded
 
 type Prunner struct {
@@ -43,6 +48,11 @@ func (r *Prunner) Init(p core.Node) *atomic.Bool {
 	r.core.init(numProxyStats)
 
 	r.regCommon(p.Snode()) // common metrics
+	r.reg(p.Snode(), AuditDroppedCount, KindCounter,
+		&Extra{
+			Help: "total number of audit records dropped (queue full)",
+		},
+	)
 
 	r.core.statsTime = cmn.GCO.Get().Periodic.StatsTime.D()
 	r.ctracker = make(copyTracker, numProxyStats)
@@ -90,3 +100,7 @@ func (r *Prunner) statsTime(newval time.Duration) {
 }
 
 func (*Prunner) standingBy() bool { return false }
+
+// per-bucket traffic: targets only
+func (*Prunner) AddBckTraffic(int, *cmn.Bck, string, int64) {}
+func (*Prunner) GetBckTraffic() BckTrafficList              { return nil }
@@ -0,0 +1,108 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Slow requests:
+// - targets time individual GET phases (see ReqPhases)
+// - requests that take longer than `log.slow_request_time` are logged with their phase breakdown
+//   and kept in a fixed-size (per target) ring buffer, most recent first
+// - see also: apc.URLPathDebugSlowReqs
+
+const SlowReqRingSize = 256
+
+type (
+	// nanoseconds; not all request processing is accounted for - phases do not necessarily add up to `SlowReq.Total`
+	ReqPhases struct {
+		Lock    int64 `json:"lock,string,omitempty"`    // namelock wait (including rlock => wlock upgrade)
+		Load    int64 `json:"load,string,omitempty"`    // LOM load (metadata)
+		Backend int64 `json:"backend,string,omitempty"` // remote backend: cold GET, version check
+		Cksum   int64 `json:"cksum,string,omitempty"`   // checksum validation (warm GET)
+		Read    int64 `json:"read,string,omitempty"`    // disk read
+		Write   int64 `json:"write,string,omitempty"`   // write to the client (network)
+	}
+
+	// REST API
+	SlowReq struct {
+		Time    int64     `json:"time,string"` // start time (Unix nanoseconds)
+		Method  string    `json:"method"`
+		Cname   string    `json:"cname"` // bucket/object
+		User    string    `json:"user,omitempty"`
+		Err     string    `json:"err,omitempty"`
+		Size    int64     `json:"size,string"`  // bytes transmitted
+		Total   int64     `json:"total,string"` // nanoseconds
+		Phases  ReqPhases `json:"phases"`
+		Node    string    `json:"node,omitempty"` // (filled-in by the client)
+		Status  int       `json:"status,omitempty"`
+		ColdGET bool      `json:"cold,omitempty"`
+	}
+	SlowReqList []*SlowReq
+
+	SlowReqs struct {
+		ring [SlowReqRingSize]SlowReq
+		next int
+		cnt  int
+		mu   sync.Mutex
+	}
+)
+
+func (p *ReqPhases) String() string {
+	var (
+		sb    strings.Builder
+		names = [...]string{"lock", "load", "backend", "cksum", "read", "write"}
+		vals  = [...]int64{p.Lock, p.Load, p.Backend, p.Cksum, p.Read, p.Write}
+	)
+	sb.Grow(80)
+	for i, v := range vals {
+		if v == 0 {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(names[i])
+		sb.WriteByte(' ')
+		sb.WriteString(time.Duration(v).String())
+	}
+	return sb.String()
+}
+
+func (sr *SlowReq) String() string {
+	s := "slow " + sr.Method + " " + sr.Cname + ": " + time.Duration(sr.Total).String() + " [" + sr.Phases.String() + "]"
+	if sr.Err != "" {
+		s += " err: " + sr.Err
+	}
+	return s
+}
+
+//////////////
+// SlowReqs //
+//////////////
+
+func (s *SlowReqs) Add(sr *SlowReq) {
+	s.mu.Lock()
+	s.ring[s.next] = *sr
+	s.next = (s.next + 1) % SlowReqRingSize
+	s.cnt = min(s.cnt+1, SlowReqRingSize)
+	s.mu.Unlock()
+}
+
+// most recent first
+func (s *SlowReqs) Get() SlowReqList {
+	s.mu.Lock()
+	out := make(SlowReqList, 0, s.cnt)
+	for i := 1; i <= s.cnt; i++ {
+		sr := s.ring[(s.next-i+SlowReqRingSize)%SlowReqRingSize]
+		out = append(out, &sr)
+	}
+	s.mu.Unlock()
+	return out
+}
@@ -0,0 +1,47 @@
+// Package stats provides methods and functionality to register, track, log,
+// and StatsD-notify statistics that, for the most part, include "counter" and "latency" kinds.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package stats
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestSlowReqs(t *testing.T) {
+	var s SlowReqs
+	tassert.Fatalf(t, len(s.Get()) == 0, "expected empty")
+
+	for i := range 3 {
+		s.Add(&SlowReq{Time: int64(i), Method: "GET", Cname: "ais://nnn/obj"})
+	}
+	list := s.Get()
+	tassert.Fatalf(t, len(list) == 3, "expected 3 entries, got %d", len(list))
+	tassert.Errorf(t, list[0].Time == 2 && list[2].Time == 0, "expected most recent first: %d, %d", list[0].Time, list[2].Time)
+
+	// wrap around
+	for i := 3; i < SlowReqRingSize+10; i++ {
+		s.Add(&SlowReq{Time: int64(i)})
+	}
+	list = s.Get()
+	tassert.Fatalf(t, len(list) == SlowReqRingSize, "expected %d entries, got %d", SlowReqRingSize, len(list))
+	for i, v := range list {
+		exp := int64(SlowReqRingSize + 10 - 1 - i)
+		tassert.Fatalf(t, v.Time == exp, "entry %d: expected %d, got %d", i, exp, v.Time)
+	}
+
+	// log line
+	sr := &SlowReq{
+		Method: "GET",
+		Cname:  "ais://nnn/obj",
+		Total:  int64(3 * time.Second),
+		Phases: ReqPhases{Lock: int64(2 * time.Second), Write: int64(time.Second)},
+	}
+	str := sr.String()
+	tassert.Errorf(t, strings.Contains(str, "lock 2s, write 1s") && !strings.Contains(str, "load"), "unexpected %q", str)
+}
@@ -40,11 +40,24 @@ const (
 	CleanupStoreCount = "cleanup.store.n"
 	CleanupStoreSize  = "cleanup.store.size"
 
+	// tiered mountpaths (see space/tier.go)
+	TierDemoteCount  = "tier.demote.n"
+	TierDemoteSize   = "tier.demote.size"
+	TierPromoteCount = "tier.promote.n"
+	TierPromoteSize  = "tier.promote.size"
+
 	VerChangeCount = "ver.change.n"
 	VerChangeSize  = "ver.change.size"
 
+	// async bucket replication (see repl package)
+	ReplEnqueueCount = "repl.enq.n"
+	ReplCount        = "repl.n"
+	ReplSize         = "repl.size"
+	ReplLagTotal     = "repl.lag.ns.total"
+
 	// errors
 	ErrPutCksumCount = errPrefix + "put.cksum.n"
+	ErrReplCount     = errPrefix + "repl.n"
 
 	ErrFSHCCount = errPrefix + "fshc.n"
 
@@ -69,6 +82,11 @@ const (
 	RatelimPutRetryCount        = "ratelim.retry.put.n"
 	RatelimPutRetryLatencyTotal = "ratelim.retry.put.ns.total"
 
+	// admission control (see cmn.AdmissionConf)
+	AdmitQueueCount        = "admit.queue.n"
+	AdmitQueueLatencyTotal = "admit.queue.ns.total"
+	ErrAdmitCount          = errPrefix + "admit.n"
+
 	AppendLatency     = "append.ns"
 	GetRedirLatency   = "get.redir.ns"
 	PutRedirLatency   = "put.redir.ns"
@@ -76,6 +94,12 @@ const (
 	HeadLatency       = "head.ns"
 	HeadLatencyTotal  = "head.ns.total"
 
+	// KindHistogram
+	GetLatencyHist     = "get.ns.hist"
+	GetColdLatencyHist = "get.cold.ns.hist" // end-to-end cold GET (see also: per-backend histograms in ais/backend/common)
+	PutLatencyHist     = "put.ns.hist"
+	AppendLatencyHist  = "append.ns.hist"
+
 	// Dsort
 	DsortCreationReqCount    = "dsort.creation.req.n"
 	DsortCreationRespCount   = "dsort.creation.resp.n"
@@ -110,6 +134,8 @@ const (
 	LcacheEvictedCount   = core.LcacheEvictedCount
 	LcacheErrCount       = core.LcacheErrCount
 	LcacheFlushColdCount = core.LcacheFlushColdCount
+	LcacheMdxHitCount    = core.LcacheMdxHitCount
+	LcacheMdxMissCount   = core.LcacheMdxMissCount
 
 	// variable label used for prometheus disk metrics
 	diskMetricLabel = "disk"
@@ -134,7 +160,8 @@ type (
 		cs     struct {
 			last int64 // mono.Nano
 		}
-		ioErrs  int64 // sum values of (ioErrNames) counters
+		bt      bckTraffic // per-bucket traffic
+		ioErrs  int64      // sum values of (ioErrNames) counters
 		standby bool
 	}
 )
@@ -174,6 +201,8 @@ func (r *Trunner) Init() *atomic.Bool {
 	r.ctracker = make(copyTracker, numTargetStats) // these two are allocated once and only used in serial context
 	r.lines = make([]string, 0, 16)
 
+	r.bt.init()
+
 	r.disk.stats = make(cos.AllDiskStats, 16)
 	r.disk.metrics = make(map[string]dmetric, 16)
 
@@ -283,6 +312,27 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 		},
 	)
 
+	r.reg(snode, TierDemoteCount, KindCounter,
+		&Extra{
+			Help: "tiered mountpaths: number of objects demoted from fast to capacity tier",
+		},
+	)
+	r.reg(snode, TierDemoteSize, KindSize,
+		&Extra{
+			Help: "tiered mountpaths: total size (bytes) of objects demoted from fast to capacity tier",
+		},
+	)
+	r.reg(snode, TierPromoteCount, KindCounter,
+		&Extra{
+			Help: "tiered mountpaths: number of objects promoted from capacity to fast tier",
+		},
+	)
+	r.reg(snode, TierPromoteSize, KindSize,
+		&Extra{
+			Help: "tiered mountpaths: total size (bytes) of objects promoted from capacity to fast tier",
+		},
+	)
+
 	// out-of-band (x 3)
 	r.reg(snode, VerChangeCount, KindCounter,
 		&Extra{
@@ -333,6 +383,29 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			VarLabs: BckVlabs,
 		},
 	)
+
+	// histograms
+	r.reg(snode, GetLatencyHist, KindHistogram,
+		&Extra{
+			Help: "GET: latency distribution (seconds)",
+		},
+	)
+	r.reg(snode, GetColdLatencyHist, KindHistogram,
+		&Extra{
+			Help: "cold GET: latency distribution (seconds), including the time to execute remote GET and store new object in-cluster",
+		},
+	)
+	r.reg(snode, PutLatencyHist, KindHistogram,
+		&Extra{
+			Help: "PUT: latency distribution (seconds)",
+		},
+	)
+	r.reg(snode, AppendLatencyHist, KindHistogram,
+		&Extra{
+			Help: "APPEND(object): latency distribution (seconds)",
+		},
+	)
+
 	r.reg(snode, GetRedirLatency, KindLatency,
 		&Extra{
 			Help: "GET: average gateway-to-target HTTP redirect latency (milliseconds) over the last periodic.stats_time interval",
@@ -371,6 +444,32 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 		},
 	)
 
+	// replication
+	r.reg(snode, ReplEnqueueCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of journaled PUT, APPEND, and DELETE events (the difference with repl.n is the current backlog)",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ReplCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of events shipped to the destination bucket",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ReplSize, KindSize,
+		&Extra{
+			Help:    "replication: total cumulative size (bytes) of objects shipped to the destination bucket",
+			VarLabs: BckVlabs,
+		},
+	)
+	r.reg(snode, ReplLagTotal, KindTotal,
+		&Extra{
+			Help:    "replication: total cumulative lag (nanoseconds) between journaling and shipping (divide by repl.n to get average lag)",
+			VarLabs: BckVlabs,
+		},
+	)
+
 	// errors
 	r.reg(snode, ErrPutCksumCount, KindCounter,
 		&Extra{
@@ -378,6 +477,12 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			VarLabs: BckXlabs,
 		},
 	)
+	r.reg(snode, ErrReplCount, KindCounter,
+		&Extra{
+			Help:    "replication: number of failed attempts to ship journaled events (all failed attempts are retried)",
+			VarLabs: BckVlabs,
+		},
+	)
 	r.reg(snode, ErrFSHCCount, KindCounter,
 		&Extra{
 			Help:    "number of times filesystem health checker (FSHC) was triggered by an I/O error or errors",
@@ -436,6 +541,16 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "intra-cluster streaming communications: total cumulative size (bytes) of all received objects",
 		},
 	)
+	r.reg(snode, cos.StreamsOutObjLatency, KindHistogram,
+		&Extra{
+			Help: "intra-cluster streaming communications: distribution of the time (seconds) to send an object",
+		},
+	)
+	r.reg(snode, cos.StreamsInObjLatency, KindHistogram,
+		&Extra{
+			Help: "intra-cluster streaming communications: distribution of the time (seconds) to receive and handle an object",
+		},
+	)
 
 	r.reg(snode, DloadSize, KindSize,
 		&Extra{
@@ -476,6 +591,23 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 		},
 	)
 
+	// admission control
+	r.reg(snode, AdmitQueueCount, KindCounter,
+		&Extra{
+			Help: "admission control: number of lower-priority requests queued (delayed) due to high disk utilization and/or memory pressure",
+		},
+	)
+	r.reg(snode, AdmitQueueLatencyTotal, KindTotal,
+		&Extra{
+			Help: "admission control: total time (nanoseconds) spent by queued requests waiting to be admitted",
+		},
+	)
+	r.reg(snode, ErrAdmitCount, KindCounter,
+		&Extra{
+			Help: "admission control: number of requests rejected with 503 (Retry-After) due to target overload",
+		},
+	)
+
 	// dsort
 	r.reg(snode, DsortCreationReqCount, KindCounter,
 		&Extra{
@@ -564,6 +696,19 @@ func (r *Trunner) RegMetrics(snode *meta.Snode) {
 			Help: "number of times a LOM from cache was written to stable storage (core, internal)",
 		},
 	)
+	r.reg(snode, LcacheMdxHitCount, KindCounter,
+		&Extra{
+			Help: "number of object metadata loads served by the persistent metadata index (core, internal)",
+		},
+	)
+	r.reg(snode, LcacheMdxMissCount, KindCounter,
+		&Extra{
+			Help: "number of persistent metadata index misses, including stale entries (core, internal)",
+		},
+	)
+
+	// per-bucket traffic (not tracked by `r.core`)
+	regBckTraffic(snode)
 }
 
 func (r *Trunner) RegDiskMetrics(snode *meta.Snode, disk string) {
@@ -0,0 +1,80 @@
+//go:build oteltracing
+
+// Package tracing offers support for distributed tracing utilizing OpenTelemetry (OTEL).
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package tracing
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// otlpFile is an http.RoundTripper that "exports" OTLP/HTTP requests into a local file:
+// - one OTLP/JSON-encoded ExportTraceServiceRequest per line
+//   (same format as OTEL collector's file exporter - can be replayed via `otlpjsonfile` receiver)
+// - for offline testing and debugging
+
+type otlpFile struct {
+	fh *os.File
+	mu sync.Mutex
+}
+
+// interface guard
+var _ http.RoundTripper = (*otlpFile)(nil)
+
+func newOtlpFile(fqn string) (*otlpFile, error) {
+	fh, err := os.OpenFile(fqn, os.O_CREATE|os.O_APPEND|os.O_WRONLY, cos.PermRWR)
+	if err != nil {
+		return nil, err
+	}
+	nlog.Infoln("exporting traces to", fqn)
+	return &otlpFile{fh: fh}, nil
+}
+
+func (f *otlpFile) RoundTrip(req *http.Request) (*http.Response, error) {
+	var msg coltracepb.ExportTraceServiceRequest
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err == nil {
+		err = proto.Unmarshal(b, &msg)
+	}
+	if err == nil {
+		b, err = protojson.Marshal(&msg)
+	}
+	if err == nil {
+		f.mu.Lock()
+		_, err = f.fh.Write(append(b, '\n'))
+		f.mu.Unlock()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"Content-Type": []string{"application/x-protobuf"}},
+		Body:       io.NopCloser(bytes.NewReader(nil)),
+		Request:    req,
+	}, nil
+}
+
+func (f *otlpFile) close() {
+	f.mu.Lock()
+	cos.Close(f.fh)
+	f.mu.Unlock()
+}
@@ -2,17 +2,20 @@
 
 // Package tracing offers support for distributed tracing utilizing OpenTelemetry (OTEL).
 /*
- * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2024-2025, NVIDIA CORPORATION. All rights reserved.
  */
 package tracing
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/core/meta"
 )
 
+type Span struct{}
+
 func IsEnabled() bool { return false }
 
 func Init(*cmn.TracingConf, *meta.Snode, any, string) {}
@@ -22,3 +25,17 @@ func Shutdown() {}
 func NewTraceableHandler(handler http.Handler, _ string) http.Handler { return handler }
 
 func NewTraceableClient(client *http.Client) *http.Client { return client }
+
+func StartSpan(ctx context.Context, _ string, _ ...string) (context.Context, Span) {
+	return ctx, Span{}
+}
+
+func (Span) End(error) {}
+
+func Traceparent(context.Context) string { return "" }
+
+func FromTraceparent(ctx context.Context, _ string) context.Context { return ctx }
+
+func Inject(context.Context, http.Header) {}
+
+func Detached(context.Context) context.Context { return context.Background() }
@@ -2,7 +2,7 @@
 
 // Package tracing offers support for distributed tracing utilizing OpenTelemetry (OTEL).
 /*
- * Copyright (c) 2024, NVIDIA CORPORATION. All rights reserved.
+ * Copyright (c) 2024-2025, NVIDIA CORPORATION. All rights reserved.
  */
 package tracing
 
@@ -12,6 +12,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/env"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -22,14 +23,31 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
-var tp *trace.TracerProvider
+const (
+	tracerName = "github.com/NVIDIA/aistore"
+
+	traceparent    = "traceparent" // W3C (see propagation.TraceContext)
+	hdrTraceparent = "Traceparent" // (canonical)
+)
+
+type Span struct {
+	s oteltrace.Span
+}
+
+var (
+	tp    *trace.TracerProvider
+	ofile *otlpFile // when exporting to a local file
+)
 
 func loadAccessToken(tokenFilePath string) string {
 	cos.AssertMsg(tokenFilePath != "", "token filepath cannot be empty")
@@ -45,6 +63,10 @@ func newExporter(conf *cmn.TracingConf) (trace.SpanExporter, error) {
 		headers = map[string]string{conf.ExporterAuth.TokenHeader: token}
 	}
 
+	if conf.ExporterProtocol == cmn.TraceExporterHTTP {
+		return newHTTPExporter(conf, headers)
+	}
+
 	options := []otlptracegrpc.Option{
 		otlptracegrpc.WithHeaders(headers),
 		otlptracegrpc.WithEndpoint(conf.ExporterEndpoint),
@@ -57,6 +79,40 @@ func newExporter(conf *cmn.TracingConf) (trace.SpanExporter, error) {
 	return otlptracegrpc.New(context.Background(), options...)
 }
 
+// OTLP/HTTP; the endpoint is one of:
+// - host:port
+// - http(s)://host:port[/path]
+// - file:///path/to/file (OTLP/JSON lines, see otlpFile)
+func newHTTPExporter(conf *cmn.TracingConf, headers map[string]string) (trace.SpanExporter, error) {
+	var (
+		endpoint = conf.ExporterEndpoint
+		options  = []otlptracehttp.Option{otlptracehttp.WithHeaders(headers)}
+	)
+	switch {
+	case strings.HasPrefix(endpoint, cmn.TraceFileScheme):
+		var err error
+		ofile, err = newOtlpFile(strings.TrimPrefix(endpoint, cmn.TraceFileScheme))
+		if err != nil {
+			return nil, err
+		}
+		options = append(options,
+			otlptracehttp.WithEndpoint("localhost"), // (not used)
+			otlptracehttp.WithInsecure(),
+			otlptracehttp.WithHTTPClient(&http.Client{Transport: ofile}),
+		)
+	case strings.Contains(endpoint, "://"):
+		options = append(options, otlptracehttp.WithEndpointURL(endpoint),
+			otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: true}))
+	default:
+		options = append(options, otlptracehttp.WithEndpoint(endpoint),
+			otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: true}))
+		if conf.SkipVerify {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+	}
+	return otlptracehttp.New(context.Background(), options...)
+}
+
 // newResource returns a resource describing this application.
 func newResource(conf *cmn.TracingConf, snode *meta.Snode, version string) *resource.Resource {
 	servicePrefix := strings.TrimSuffix(conf.ServiceNamePrefix, "-")
@@ -134,13 +190,27 @@ func Shutdown() {
 		cos.ExitLog(err)
 	}
 	tp = nil
+	if ofile != nil {
+		ofile.close()
+		ofile = nil
+	}
 }
 
 func NewTraceableHandler(handler http.Handler, operation string) http.Handler {
-	if IsEnabled() {
-		return otelhttp.NewHandler(handler, operation)
+	if !IsEnabled() {
+		return handler
 	}
-	return handler
+	h := otelhttp.NewHandler(handler, operation)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// proxy => target redirect: the client that follows the redirect
+		// may not be trace-aware - hence, trace context in the URL query
+		if r.Header.Get(hdrTraceparent) == "" && strings.Contains(r.URL.RawQuery, apc.QparamTraceparent+"=") {
+			if v := r.URL.Query().Get(apc.QparamTraceparent); v != "" {
+				r.Header.Set(hdrTraceparent, v)
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
 }
 
 func NewTraceableClient(client *http.Client) *http.Client {
@@ -149,3 +219,66 @@ func NewTraceableClient(client *http.Client) *http.Client {
 	}
 	return client
 }
+
+// start child span (e.g., xaction work) with optional string attributes: key, value, key, value, ...
+func StartSpan(ctx context.Context, name string, kvs ...string) (context.Context, Span) {
+	if !IsEnabled() {
+		return ctx, Span{}
+	}
+	var opts []oteltrace.SpanStartOption
+	if len(kvs) > 1 {
+		attrs := make([]attribute.KeyValue, 0, len(kvs)/2)
+		for i := 0; i+1 < len(kvs); i += 2 {
+			attrs = append(attrs, attribute.String(kvs[i], kvs[i+1]))
+		}
+		opts = append(opts, oteltrace.WithAttributes(attrs...))
+	}
+	ctx, s := tp.Tracer(tracerName).Start(ctx, name, opts...)
+	return ctx, Span{s}
+}
+
+func (sp Span) End(err error) {
+	if sp.s == nil {
+		return
+	}
+	if err != nil {
+		sp.s.RecordError(err)
+		sp.s.SetStatus(codes.Error, err.Error())
+	}
+	sp.s.End()
+}
+
+// W3C trace context of the current span, if any
+// (to propagate via URL query and intra-cluster transport - see apc.QparamTraceparent and transport.ObjHdr)
+func Traceparent(ctx context.Context) string {
+	if !IsEnabled() || !oteltrace.SpanContextFromContext(ctx).IsValid() {
+		return ""
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier[traceparent]
+}
+
+// the reverse of the above
+func FromTraceparent(ctx context.Context, tparent string) context.Context {
+	if tparent == "" || !IsEnabled() {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{traceparent: tparent})
+}
+
+// to propagate via (intra-cluster) request headers
+func Inject(ctx context.Context, hdr http.Header) {
+	if IsEnabled() {
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(hdr))
+	}
+}
+
+// context that carries the current span but not the caller's deadline and cancellation
+// (e.g., backend calls that must not be interrupted when the client goes away)
+func Detached(ctx context.Context) context.Context {
+	if !IsEnabled() {
+		return context.Background()
+	}
+	return oteltrace.ContextWithSpan(context.Background(), oteltrace.SpanFromContext(ctx))
+}
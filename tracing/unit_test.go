@@ -10,10 +10,16 @@ package tracing_test
 // go test -v -tags="debug oteltracing"
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/tracing"
@@ -153,4 +159,101 @@ var _ = Describe("Tracing", func() {
 			Expect(len(exporter.GetSpans())).To(BeEquivalentTo(0))
 		})
 	})
+
+	Describe("Propagation", func() {
+		const tparent = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+		AfterEach(func() {
+			tracing.Shutdown()
+		})
+		It("should continue redirected request's trace (via query)", func() {
+			exporter := tracetest.NewInMemoryExporter()
+			tracing.Init(&cmn.TracingConf{
+				ExporterEndpoint:   "dummy",
+				Enabled:            true,
+				SamplerProbability: 1.0,
+			}, dummySnode, exporter, aisVersion)
+
+			server := httptest.NewServer(tracing.NewTraceableHandler(newTestHandler, "testendpoint"))
+			defer server.Close()
+
+			// (not http.DefaultClient - may have been made traceable by the Client tests)
+			client := &http.Client{}
+			_, err := client.Get(server.URL + "?" + apc.QparamTraceparent + "=" + tparent)
+			Expect(err).NotTo(HaveOccurred())
+
+			tracing.ForceFlush()
+
+			Expect(len(exporter.GetSpans())).To(BeEquivalentTo(1))
+			span := exporter.GetSpans()[0]
+			Expect(span.SpanContext.TraceID().String()).To(Equal("0af7651916cd43dd8448eb211c80319c"))
+			Expect(span.Parent.SpanID().String()).To(Equal("b7ad6b7169203331"))
+		})
+
+		It("should round-trip traceparent (e.g., via transport header)", func() {
+			exporter := tracetest.NewInMemoryExporter()
+			tracing.Init(&cmn.TracingConf{
+				ExporterEndpoint:   "dummy",
+				Enabled:            true,
+				SamplerProbability: 1.0,
+			}, dummySnode, exporter, aisVersion)
+
+			ctx, span := tracing.StartSpan(tracing.FromTraceparent(context.Background(), tparent), "send", "k", "v")
+			tp := tracing.Traceparent(ctx)
+			Expect(tp).NotTo(BeEmpty())
+			span.End(nil)
+
+			_, child := tracing.StartSpan(tracing.FromTraceparent(context.Background(), tp), "recv")
+			child.End(io.ErrUnexpectedEOF)
+
+			tracing.ForceFlush()
+
+			spans := exporter.GetSpans()
+			Expect(len(spans)).To(BeEquivalentTo(2))
+			Expect(spans[1].SpanContext.TraceID()).To(Equal(spans[0].SpanContext.TraceID()))
+			Expect(spans[1].Parent.SpanID()).To(Equal(spans[0].SpanContext.SpanID()))
+			Expect(spans[1].Status.Description).To(Equal(io.ErrUnexpectedEOF.Error()))
+		})
+
+		It("should be no-op when tracing disabled", func() {
+			ctx, span := tracing.StartSpan(context.Background(), "noop")
+			span.End(nil)
+			Expect(tracing.Traceparent(ctx)).To(BeEmpty())
+			Expect(tracing.FromTraceparent(ctx, tparent)).To(Equal(ctx))
+		})
+	})
+
+	Describe("File exporter", func() {
+		It("should export OTLP/JSON lines into a local file", func() {
+			fqn := filepath.Join(GinkgoT().TempDir(), "traces.json")
+			tracing.Init(&cmn.TracingConf{
+				ExporterEndpoint:   cmn.TraceFileScheme + fqn,
+				ExporterProtocol:   cmn.TraceExporterHTTP,
+				Enabled:            true,
+				SamplerProbability: 1.0,
+			}, dummySnode, nil, aisVersion)
+			Expect(tracing.IsEnabled()).To(BeTrue())
+
+			for range 3 {
+				_, span := tracing.StartSpan(context.Background(), "test")
+				span.End(nil)
+			}
+			tracing.ForceFlush()
+			tracing.Shutdown()
+
+			fh, err := os.Open(fqn)
+			Expect(err).NotTo(HaveOccurred())
+			defer fh.Close()
+
+			var lines int
+			scanner := bufio.NewScanner(fh)
+			for scanner.Scan() {
+				var v map[string]any
+				Expect(json.Unmarshal(scanner.Bytes(), &v)).To(Succeed())
+				Expect(v).To(HaveKey("resourceSpans"))
+				lines++
+			}
+			Expect(lines).To(BeNumerically(">", 0))
+		})
+	})
 })
@@ -83,6 +83,7 @@ type (
 		Opaque   []byte       // custom control (optional)
 		ObjAttrs cmn.ObjAttrs // attributes/metadata of the object that's being transmitted
 		Opcode   int          // (see reserved range above)
+		Trace    string       // W3C trace context (optional; see tracing.Traceparent)
 	}
 	// object to transmit
 	Obj struct {
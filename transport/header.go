@@ -48,6 +48,9 @@ func insObjHeader(hbuf []byte, hdr *ObjHdr, usePDU bool) (off int) {
 	off = insBytes(off, hbuf, hdr.Opaque)
 	off = insString(off, hbuf, hdr.Demux)
 	off = insAttrs(off, hbuf, &hdr.ObjAttrs)
+	if hdr.Trace != "" {
+		off = insString(off, hbuf, hdr.Trace) // optional trailer (see ExtObjHeader)
+	}
 	word1 := uint64(off - sizeProtoHdr)
 	if usePDU {
 		word1 |= pduStreamFl
@@ -149,6 +152,9 @@ func ExtObjHeader(body []byte, hlen int) (hdr ObjHdr) {
 	off, hdr.Opaque = extBytes(off, body)
 	off, hdr.Demux = extString(off, body)
 	off, hdr.ObjAttrs = extAttrs(off, body)
+	if off < hlen {
+		off, hdr.Trace = extString(off, body)
+	}
 	debug.Assertf(off == hlen, "off %d, hlen %d", off, hlen)
 	return
 }
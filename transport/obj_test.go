@@ -405,6 +405,7 @@ func TestObjAttrs(t *testing.T) {
 		cos.AssertMsg(hdr.Bck.IsAIS(), "expecting ais bucket")
 		cos.Assertf(reflect.DeepEqual(testAttrs[idx], hdr.ObjAttrs),
 			"attrs are not equal: %v; %v;", testAttrs[idx], hdr.ObjAttrs)
+		cos.Assertf(hdr.Trace == testTrace(idx), "trace: %q vs %q", hdr.Trace, testTrace(idx))
 
 		written, err := io.Copy(io.Discard, objReader)
 		cos.Assert(err == nil)
@@ -431,6 +432,7 @@ func TestObjAttrs(t *testing.T) {
 				},
 				ObjAttrs: attrs,
 				Opaque:   []byte{byte(idx)},
+				Trace:    testTrace(byte(idx)),
 			}
 		)
 		slab, err := memsys.PageMM().GetSlab(memsys.PageSize)
@@ -450,6 +452,14 @@ func TestObjAttrs(t *testing.T) {
 	}
 }
 
+// optional trace context (every other object)
+func testTrace(idx byte) string {
+	if idx%2 == 0 {
+		return ""
+	}
+	return "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+}
+
 func receive10G(hdr *transport.ObjHdr, objReader io.Reader, err error) error {
 	cos.Assert(err == nil || cos.IsEOF(err))
 	written, _ := io.Copy(io.Discard, objReader)
@@ -6,6 +6,7 @@
 package transport
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"math"
@@ -25,6 +26,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tracing"
 
 	onexxh "github.com/OneOfOne/xxhash"
 	"github.com/pierrec/lz4/v4"
@@ -279,14 +281,21 @@ func (it *iterator) rxObj(loghdr string, hlen int) (err error) {
 		}
 		err = eofOK(err)
 		size, off := obj.hdr.ObjAttrs.Size, obj.off
-		if errCb := h.recv(&obj.hdr, obj, err); errCb != nil {
-			err = errCb
+		started := mono.NanoTime()
+		if obj.hdr.Trace == "" {
+			if errCb := h.recv(&obj.hdr, obj, err); errCb != nil {
+				err = errCb
+			}
+		} else {
+			err = it.recvTraced(obj, err)
 		}
+		elapsed := mono.SinceNano(started)
 		debug.DeadBeefSmall(it.hbuf[:hlen])
 		// stats
 		if err == nil {
 			it.stats.incNum()                   // 1. this stream stats
 			g.tstats.Inc(cos.StreamsInObjCount) // 2. stats/target_stats.go
+			g.tstats.Add(cos.StreamsInObjLatency, elapsed)
 
 			if size >= 0 {
 				g.tstats.Add(cos.StreamsInObjSize, size)
@@ -303,6 +312,17 @@ func (it *iterator) rxObj(loghdr string, hlen int) (err error) {
 	return err
 }
 
+// continue the sender's trace (see ObjHdr.Trace)
+func (it *iterator) recvTraced(obj *objReader, err error) error {
+	ctx := tracing.FromTraceparent(context.Background(), obj.hdr.Trace)
+	_, span := tracing.StartSpan(ctx, "transport.recv", "stream", obj.loghdr, "object", obj.hdr.Cname())
+	if errCb := it.handler.recv(&obj.hdr, obj, err); errCb != nil {
+		err = errCb
+	}
+	span.End(err)
+	return err
+}
+
 func eofOK(err error) error {
 	if err == io.EOF {
 		err = nil
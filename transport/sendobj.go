@@ -13,6 +13,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/memsys"
 
@@ -38,9 +39,10 @@ type (
 		frameChecksum bool        // true: checksum lz4 frames
 	}
 	sendoff struct {
-		obj Obj
-		off int64
-		ins int // in-send enum
+		obj     Obj
+		off     int64
+		started int64 // mono time: dequeued to send
+		ins     int   // in-send enum
 	}
 	cmpl struct {
 		err error
@@ -227,6 +229,7 @@ repeat:
 			return 0, err
 		}
 		s.sendoff.obj = *obj
+		s.sendoff.started = mono.NanoTime()
 		obj = &s.sendoff.obj
 		if obj.Hdr.isIdleTick() {
 			if len(s.workCh) > 0 {
@@ -328,6 +331,7 @@ func (s *Stream) eoObj(err error) {
 	// target stats
 	g.tstats.Inc(cos.StreamsOutObjCount)
 	g.tstats.Add(cos.StreamsOutObjSize, objSize)
+	g.tstats.Add(cos.StreamsOutObjLatency, mono.SinceNano(s.sendoff.started))
 exit:
 	if err != nil {
 		nlog.Errorln(err)
@@ -127,6 +127,7 @@ var Table = map[string]Descriptor{
 	// (one bucket) | (all buckets)
 	apc.ActLRU:          {DisplayName: "lru-eviction", Scope: ScopeGB, Startable: true},
 	apc.ActStoreCleanup: {DisplayName: "cleanup", Scope: ScopeGB, Startable: true},
+	apc.ActTierMigrate:  {DisplayName: "tier-migration", Scope: ScopeG, Startable: true},
 	apc.ActSummaryBck: {
 		DisplayName: "summary",
 		Scope:       ScopeGB,
@@ -270,6 +271,12 @@ var Table = map[string]Descriptor{
 
 	apc.ActGetBatch: {Scope: ScopeGB, Startable: false, Metasync: false, Idles: true}, // apc.Moss
 
+	// async bucket replication: re-journal source content to fix drift (see `repl` package)
+	apc.ActReplResync: {DisplayName: "resync-replication", Scope: ScopeB, Startable: true},
+
+	// compute missing checksums (see core/lcksum.go)
+	apc.ActRecomputeCksum: {DisplayName: "recompute-checksums", Scope: ScopeB, Access: apc.AccessRW, Startable: true},
+
 	// cache management, internal usage
 	apc.ActLoadLomCache: {DisplayName: "warm-up-metadata", Scope: ScopeB, Startable: true},
 }
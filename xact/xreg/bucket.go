@@ -81,6 +81,14 @@ func RenewBckLoadLomCache(uuid string, bck *meta.Bck) RenewRes {
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{UUID: uuid})
 }
 
+func RenewRecomputeCksum(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActRecomputeCksum, bck, Args{UUID: uuid})
+}
+
+func RenewReplResync(uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActReplResync, bck, Args{UUID: uuid})
+}
+
 func RenewPutMirror(lom *core.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{Custom: lom})
 }
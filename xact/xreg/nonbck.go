@@ -44,6 +44,11 @@ func RenewStoreCleanup(id, ctlmsg string) RenewRes {
 	return dreg.renew(e, nil)
 }
 
+func RenewTierMigrate(id string) RenewRes {
+	e := dreg.nonbckXacts[apc.ActTierMigrate].New(Args{UUID: id}, nil)
+	return dreg.renew(e, nil)
+}
+
 func RenewDownloader(xid string, bck *meta.Bck) RenewRes {
 	e := dreg.nonbckXacts[apc.ActDownload].New(Args{UUID: xid, Custom: bck}, nil)
 	return dreg.renew(e, nil)
@@ -591,7 +591,7 @@ func (j *jogger) do(archtask *archtask) {
 // 3. error
 func (wi *archwi) beginAppend() (lmfh cos.LomReader, err error) {
 	msg := wi.msg
-	if msg.Mime == archive.ExtTar {
+	if msg.Mime == archive.ExtTar && !wi.archlom.IsPacked() && !wi.archlom.IsCompressed() && !wi.archlom.IsDeduped() {
 		// (special)
 		err = wi.openTarForAppend()
 		if err == nil /*can append*/ || err != archive.ErrTarIsEmpty /*fail XactArch.Begin*/ {
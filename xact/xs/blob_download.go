@@ -25,6 +25,7 @@ import (
 	"github.com/NVIDIA/aistore/cmn/oom"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/memsys"
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/sys"
@@ -161,9 +162,41 @@ func RenewBlobDl(xid string, params *core.BlobParams, oa *cmn.ObjAttrs) xreg.Ren
 		pre.numWorkers = a
 	}
 
+	// regular lom save (custom writer not present)
+	if params.WriteSGL == nil {
+		if err := pre.create(); err != nil {
+			return xreg.RenewRes{Err: err}
+		}
+	}
+
 	return xreg.RenewBucketXact(apc.ActBlobDl, lom.Bck(), xreg.Args{UUID: xid, Custom: pre})
 }
 
+// create workfile or, depending on the size and bucket config, chunk writer
+// (the caller cleans up upon failure to renew)
+func (r *XactBlobDl) create() error {
+	var (
+		lom    = r.args.Lom
+		chunks = &lom.Bprops().Chunks
+		wfqn   = fs.CSM.Gen(lom, fs.WorkfileType, "blob-dl")
+	)
+	if chunks.Chunked(r.fullSize) && lom.CanChunk() {
+		cw, err := lom.CreateChunked(wfqn, chunks.ChunkSizeX())
+		if err != nil {
+			return err
+		}
+		r.args.Lmfh = cw
+	} else {
+		lmfh, err := lom.CreateWork(wfqn)
+		if err != nil {
+			return err
+		}
+		r.args.Lmfh = lmfh
+	}
+	r.args.Wfqn = wfqn
+	return nil
+}
+
 //
 // blobFactory
 //
@@ -394,10 +427,16 @@ fin:
 		debug.AssertNoErr(errN)
 	} else {
 		// finalize r.args.Lom
+		cw, chunked := r.args.Lmfh.(*core.ChunkWriter)
 		if err == nil && r.args.Lom.IsFeatureSet(feat.FsyncPUT) {
 			err = r.args.Lmfh.Sync()
 		}
-		cos.Close(r.args.Lmfh)
+		switch {
+		case !chunked:
+			cos.Close(r.args.Lmfh)
+		case err == nil:
+			err = cw.Close() // (stores chunk manifest)
+		}
 
 		if err == nil {
 			if r.fullSize != r.woff {
@@ -417,8 +456,10 @@ fin:
 			// stats
 			tstats := core.T.StatsUpdater()
 			tstats.IncWith(r.bp.MetricName(stats.GetCount), r.vlabs)
+			delta := mono.SinceNano(now)
 			tstats.AddWith(
-				cos.NamedVal64{Name: r.bp.MetricName(stats.GetLatencyTotal), Value: mono.SinceNano(now), VarLabs: r.vlabs},
+				cos.NamedVal64{Name: r.bp.MetricName(stats.GetLatencyTotal), Value: delta, VarLabs: r.vlabs},
+				cos.NamedVal64{Name: r.bp.MetricName(stats.GetLatencyHist), Value: delta},
 			)
 
 			r.ObjsAdd(1, 0)
@@ -426,6 +467,9 @@ fin:
 			if errRemove := cos.RemoveFile(r.args.Wfqn); errRemove != nil && !cos.IsNotExist(errRemove) {
 				nlog.Errorln("nested err:", errRemove)
 			}
+			if chunked {
+				cw.Abort()
+			}
 			if err != cmn.ErrXactUserAbort {
 				r.Abort(err)
 			}
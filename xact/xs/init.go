@@ -35,6 +35,7 @@ func Treg(coi COI) {
 
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
+	xreg.RegBckXact(&recksumFactory{})
 
 	gcoi = coi
 	xreg.RegBckXact(&tcbFactory{kind: apc.ActCopyBck})
@@ -7,6 +7,7 @@ package xs
 
 import (
 	"archive/tar"
+	"context"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -26,6 +27,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tracing"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/transport/bundle"
 	"github.com/NVIDIA/aistore/xact"
@@ -326,10 +328,12 @@ func (r *XactMoss) asm(req *apc.MossReq, w http.ResponseWriter, basewi *basewi)
 // send all requested local data => DT (tsi)
 // (phase 2)
 
-func (r *XactMoss) Send(req *apc.MossReq, smap *meta.Smap, tsi *meta.Snode, wid string) error {
+func (r *XactMoss) Send(ctx context.Context, req *apc.MossReq, smap *meta.Smap, tsi *meta.Snode, wid string) error {
 	// to receive opAbort
 	bundle.SDM.RegRecv(r)
 
+	tp := tracing.Traceparent(ctx) // DT to continue the trace (see transport.ObjHdr)
+
 	// send all
 	r.IncPending()
 	defer r.DecPending()
@@ -356,9 +360,9 @@ func (r *XactMoss) Send(req *apc.MossReq, smap *meta.Smap, tsi *meta.Snode, wid
 		lom.Lock(false)
 
 		if in.ArchPath == "" {
-			err = r._sendreg(tsi, lom, wid, nameInArch, i)
+			err = r._sendreg(tsi, lom, wid, nameInArch, tp, i)
 		} else {
-			err = r._sendarch(tsi, lom, wid, nameInArch, in.ArchPath, i)
+			err = r._sendarch(tsi, lom, wid, nameInArch, in.ArchPath, tp, i)
 		}
 		if err != nil {
 			return err
@@ -371,7 +375,7 @@ func (r *XactMoss) Send(req *apc.MossReq, smap *meta.Smap, tsi *meta.Snode, wid
 	return nil
 }
 
-func (r *XactMoss) _sendreg(tsi *meta.Snode, lom *core.LOM, wid, nameInArch string, index int) error {
+func (r *XactMoss) _sendreg(tsi *meta.Snode, lom *core.LOM, wid, nameInArch, tp string, index int) error {
 	var (
 		oah      = lom.ObjAttrs()
 		roc, err = lom.NewDeferROC(false /*loaded*/)
@@ -398,6 +402,7 @@ func (r *XactMoss) _sendreg(tsi *meta.Snode, lom *core.LOM, wid, nameInArch stri
 		hdr.ObjAttrs.CopyFrom(oah, true /*skip cksum*/)
 		hdr.Demux = r.ID()
 		hdr.Opaque = opaque
+		hdr.Trace = tp
 	}
 
 	o.Callback, o.CmplArg = r.regSent, opaque
@@ -411,7 +416,7 @@ func (r *XactMoss) regSent(_ *transport.ObjHdr, _ io.ReadCloser, arg any, _ erro
 	r.smm.Free(opaque)
 }
 
-func (r *XactMoss) _sendarch(tsi *meta.Snode, lom *core.LOM, wid, nameInArch, archpath string, index int) error {
+func (r *XactMoss) _sendarch(tsi *meta.Snode, lom *core.LOM, wid, nameInArch, archpath, tp string, index int) error {
 	var (
 		roc     cos.ReadOpenCloser
 		oah     cos.SimpleOAH
@@ -449,6 +454,7 @@ func (r *XactMoss) _sendarch(tsi *meta.Snode, lom *core.LOM, wid, nameInArch, ar
 		hdr.ObjAttrs.Size = oah.Size
 		hdr.Demux = r.ID()
 		hdr.Opaque = opaque
+		hdr.Trace = tp
 	}
 	o.Callback = r.archSent
 	o.CmplArg = &struct {
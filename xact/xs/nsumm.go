@@ -21,6 +21,7 @@ import (
 	"github.com/NVIDIA/aistore/core/meta"
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/quota"
 	"github.com/NVIDIA/aistore/sys"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
@@ -264,6 +265,8 @@ func (r *XactNsumm) initRes(res *cmn.BsummResult, bck *meta.Bck) {
 	res.Bck = bck.Clone()
 	res.TotalSize.Disks = r.totalDiskSize
 	res.ObjSize.Min = math.MaxInt64
+	res.Quota.Size = int64(bck.Props.Quota.Size)
+	res.Quota.Objects = bck.Props.Quota.Objects
 }
 
 func (r *XactNsumm) String() string { return r._str }
@@ -298,6 +301,8 @@ func (r *XactNsumm) cloneRes(dst, src *cmn.BsummResult) {
 
 	dst.ObjCount.Present = ratomic.LoadUint64(&src.ObjCount.Present)
 	dst.TotalSize.PresentObjs = ratomic.LoadUint64(&src.TotalSize.PresentObjs)
+	dst.TotalSize.StoredObjs = ratomic.LoadUint64(&src.TotalSize.StoredObjs)
+	dst.TotalSize.DedupSaved = ratomic.LoadUint64(&src.TotalSize.DedupSaved)
 
 	if r.listRemote {
 		dst.ObjCount.Remote = ratomic.LoadUint64(&src.ObjCount.Remote)
@@ -317,6 +322,16 @@ func (r *XactNsumm) cloneRes(dst, src *cmn.BsummResult) {
 		r.totalDiskSize, " vs ", src.TotalSize.Disks)
 	dst.TotalSize.Disks = r.totalDiskSize
 	dst.UsedPct = cos.DivRoundU64(dst.TotalSize.OnDisk*100, r.totalDiskSize)
+
+	// quota usage: as tracked by this target or, if not (yet) tracked, as computed
+	dst.Quota = src.Quota
+	if dst.Quota.Size > 0 || dst.Quota.Objects > 0 {
+		if size, objs, ok := quota.Usage(src.Bck.Props.BID); ok {
+			dst.Quota.UsedSize, dst.Quota.UsedObjs = size, objs
+		} else {
+			dst.Quota.UsedSize, dst.Quota.UsedObjs = int64(dst.TotalSize.PresentObjs), int64(dst.ObjCount.Present)
+		}
+	}
 }
 
 func (r *XactNsumm) visitObj(lom *core.LOM, _ []byte) error {
@@ -339,6 +354,14 @@ func (r *XactNsumm) visitObj(lom *core.LOM, _ []byte) error {
 		ratomic.CompareAndSwapInt64(&res.ObjSize.Max, cmax, size)
 	}
 	ratomic.AddUint64(&res.TotalSize.PresentObjs, uint64(size))
+	if lom.IsDeduped() {
+		// this object's share of the content (see core/ldedup.go)
+		share, _ := lom.DedupShare()
+		ratomic.AddUint64(&res.TotalSize.StoredObjs, uint64(share))
+		ratomic.AddUint64(&res.TotalSize.DedupSaved, uint64(size-share))
+	} else {
+		ratomic.AddUint64(&res.TotalSize.StoredObjs, uint64(lom.StoredSize()))
+	}
 
 	// generic stats (same as base.LomAdd())
 	r.ObjsAdd(1, size)
@@ -8,13 +8,19 @@ package xs
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/mono"
 	"github.com/NVIDIA/aistore/cmn/nlog"
 	"github.com/NVIDIA/aistore/core"
 	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
@@ -40,6 +46,27 @@ type (
 		Args *xreg.ResArgs
 		xact.Base
 	}
+
+	// rebalance & resilver throttling, as per (runtime-configurable) cmn.RebalanceConf:
+	// - max bandwidth (bytes per second) per target
+	// - yield to foreground GETs when their average latency exceeds the configured limit
+	// - time-of-day window (automatic rebalance only)
+	Throttle struct {
+		xctn   *xact.Base
+		tstats cos.StatsUpdater
+		lat    struct {
+			ts    int64 // mono time of the last sample
+			total int64 // cumulative GET latency (ns)
+			cnt   int64 // cumulative GET count
+			avg   time.Duration
+		}
+		bw struct {
+			ts    int64   // mono time of the last refill
+			avail float64 // bytes; negative when in debt
+		}
+		mu   sync.Mutex
+		auto bool // automatic (ie., not user-requested) rebalance
+	}
 )
 
 // interface guard
@@ -180,3 +207,118 @@ func (xres *Resilver) Snap() (snap *core.Snap) {
 	snap.IdleX = xres.IsIdle()
 	return
 }
+
+//////////////
+// Throttle //
+//////////////
+
+const (
+	thrLatSampleIval = time.Second      // GET latency sampling interval
+	thrMaxYield      = 10 * time.Second // max yield per Acquire (to guarantee progress)
+	thrWindowPoll    = time.Minute      // poll (config, time of day) when outside the window
+)
+
+func NewThrottle(xctn *xact.Base, tstats cos.StatsUpdater, auto bool) *Throttle {
+	now := mono.NanoTime()
+	thr := &Throttle{xctn: xctn, tstats: tstats, auto: auto}
+	thr.lat.ts = now
+	thr.lat.total, thr.lat.cnt = tstats.Get(stats.GetLatencyTotal), tstats.Get(stats.GetCount)
+	thr.bw.ts = now
+	return thr
+}
+
+// is called prior to sending (rebalance) or copying (resilver) `size` bytes;
+// blocks as needed; returns non-nil error only when the xaction gets aborted
+func (thr *Throttle) Acquire(size int64) error {
+	conf := &cmn.GCO.Get().Rebalance
+
+	// 1. time-of-day window
+	if thr.auto && conf.Window != "" {
+		if err := thr.window(); err != nil {
+			return err
+		}
+		conf = &cmn.GCO.Get().Rebalance
+	}
+	// 2. foreground latency
+	if conf.MaxGetLatency > 0 {
+		if err := thr.yield(conf.MaxGetLatency.D()); err != nil {
+			return err
+		}
+	}
+	// 3. bandwidth
+	if conf.Bandwidth > 0 {
+		if sleep := thr.debit(float64(conf.Bandwidth), size); sleep > 0 {
+			return thr.xctn.AbortedAfter(sleep)
+		}
+	}
+	return nil
+}
+
+func (thr *Throttle) window() error {
+	for i := 0; ; i++ {
+		conf := &cmn.GCO.Get().Rebalance
+		if conf.InWindow(time.Now()) {
+			return nil
+		}
+		if i == 0 {
+			nlog.Infoln(thr.xctn.Name(), "outside rebalance window [", conf.Window, "] - pausing")
+		}
+		if err := thr.xctn.AbortedAfter(thrWindowPoll); err != nil {
+			return err
+		}
+	}
+}
+
+func (thr *Throttle) yield(maxLat time.Duration) error {
+	var total time.Duration
+	for total < thrMaxYield {
+		avg := thr.avgLatency()
+		if avg <= maxLat {
+			break
+		}
+		if total == 0 && cmn.Rom.FastV(4, cos.SmoduleReb) {
+			nlog.Infoln(thr.xctn.Name(), "yielding: GET latency", avg, "exceeds", maxLat)
+		}
+		if err := thr.xctn.AbortedAfter(fs.Throttle100ms); err != nil {
+			return err
+		}
+		total += fs.Throttle100ms
+	}
+	return nil
+}
+
+// average foreground GET latency over the last (approx.) sampling interval
+func (thr *Throttle) avgLatency() time.Duration {
+	thr.mu.Lock()
+	defer thr.mu.Unlock()
+	now := mono.NanoTime()
+	if time.Duration(now-thr.lat.ts) < thrLatSampleIval {
+		return thr.lat.avg
+	}
+	total, cnt := thr.tstats.Get(stats.GetLatencyTotal), thr.tstats.Get(stats.GetCount)
+	if n := cnt - thr.lat.cnt; n > 0 {
+		thr.lat.avg = time.Duration((total - thr.lat.total) / n)
+	} else {
+		thr.lat.avg = 0 // idle
+	}
+	thr.lat.ts, thr.lat.total, thr.lat.cnt = now, total, cnt
+	return thr.lat.avg
+}
+
+// token bucket (in bytes) that holds at most one second worth of bandwidth;
+// returns the time to sleep to pay back the debt, if any
+func (thr *Throttle) debit(bps float64, size int64) time.Duration {
+	thr.mu.Lock()
+	now := mono.NanoTime()
+	elapsed := time.Duration(now - thr.bw.ts)
+	thr.bw.ts = now
+	thr.bw.avail = min(thr.bw.avail+bps*elapsed.Seconds(), bps)
+	thr.bw.avail -= float64(size)
+	avail := thr.bw.avail
+	thr.mu.Unlock()
+
+	if avail >= 0 {
+		return 0
+	}
+	return time.Duration(-avail / bps * float64(time.Second))
+}
@@ -0,0 +1,109 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"sync"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+	"github.com/NVIDIA/aistore/core"
+	"github.com/NVIDIA/aistore/core/meta"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// x-recompute-cksum: visit all objects in a bucket and compute the checksums
+// each is missing given bucket's `checksum.type` and `checksum.extra`
+// (see core/lcksum.go)
+
+type (
+	recksumFactory struct {
+		xreg.RenewBase
+		xctn *xactRecksum
+	}
+	xactRecksum struct {
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ core.Xact      = (*xactRecksum)(nil)
+	_ xreg.Renewable = (*recksumFactory)(nil)
+)
+
+////////////////////
+// recksumFactory //
+////////////////////
+
+func (*recksumFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	return &recksumFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+}
+
+func (p *recksumFactory) Start() error {
+	p.xctn = newXactRecksum(p.UUID(), p.Bck)
+	return nil
+}
+
+func (*recksumFactory) Kind() string     { return apc.ActRecomputeCksum }
+func (p *recksumFactory) Get() core.Xact { return p.xctn }
+
+func (*recksumFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+/////////////////
+// xactRecksum //
+/////////////////
+
+func newXactRecksum(uuid string, bck *meta.Bck) (r *xactRecksum) {
+	r = &xactRecksum{}
+	mpopts := &mpather.JgroupOpts{
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActRecomputeCksum, "" /*ctlmsg*/, bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *xactRecksum) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	r.BckJog.Run()
+	nlog.Infoln(r.Name())
+	err := r.BckJog.Wait()
+	if err != nil {
+		r.AddErr(err)
+	}
+	r.Finish()
+}
+
+func (r *xactRecksum) visitObj(lom *core.LOM, _ []byte) error {
+	size, err := lom.RecomputeCksums()
+	switch {
+	case err == nil:
+		if size > 0 {
+			r.ObjsAdd(1, size)
+		}
+	case cos.IsNotExist(err) || cmn.IsErrObjNought(err):
+		// removed in the meantime
+	default:
+		r.AddErr(err, 4, cos.SmoduleXs)
+	}
+	return nil
+}
+
+func (r *xactRecksum) Snap() (snap *core.Snap) {
+	snap = &core.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
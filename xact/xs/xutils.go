@@ -44,6 +44,7 @@ func rgetstats(bp core.Backend, vlabs map[string]string, size, started int64) {
 	tstats.AddWith(
 		cos.NamedVal64{Name: bp.MetricName(stats.GetLatencyTotal), Value: delta, VarLabs: vlabs},
 		cos.NamedVal64{Name: bp.MetricName(stats.GetSize), Value: size, VarLabs: vlabs},
+		cos.NamedVal64{Name: bp.MetricName(stats.GetLatencyHist), Value: delta},
 	)
 }
 